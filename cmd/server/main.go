@@ -19,6 +19,7 @@ import (
 	"context"
 	"math/rand"
 	"net/http"
+	"strings"
 
 	"hopSpotAPI/internal/config"
 	"hopSpotAPI/internal/database"
@@ -30,8 +31,10 @@ import (
 	"hopSpotAPI/internal/service"
 	"hopSpotAPI/pkg/cache"
 	"hopSpotAPI/pkg/logger"
+	"hopSpotAPI/pkg/mail"
 	"hopSpotAPI/pkg/notification"
 	"hopSpotAPI/pkg/storage"
+	"hopSpotAPI/pkg/utils"
 	"hopSpotAPI/pkg/weather"
 )
 
@@ -87,6 +90,23 @@ func main() {
 		logger.Info().Msg("Firebase not configured - push notifications disabled")
 	}
 
+	// JWT Keys
+	jwtKeys, err := utils.LoadJWTKeys(cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to load JWT keys")
+	}
+	logger.Info().Str("algorithm", cfg.JWTAlgorithm).Str("kid", jwtKeys.ActiveKeyID()).Msg("JWT signing configured")
+
+	// Mail Sender
+	mailSender, err := mail.NewSender(*cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to create mail sender")
+	}
+	logger.Info().Str("driver", cfg.MailDriver).Msg("Mail sender configured")
+	if strings.EqualFold(cfg.MailDriver, "log") && cfg.GinMode != "debug" && cfg.GinMode != "test" {
+		logger.Warn().Msg("MAIL_DRIVER is log - verification and password reset mails are not delivered")
+	}
+
 	// Redis Client Setup
 	redisClient := cache.NewRedisClient(*cfg)
 	if redisClient != nil {
@@ -105,6 +125,20 @@ func main() {
 	favoriteRepo := repository.NewFavoriteRepository(db)
 	activityRepo := repository.NewActivityRepository(db)
 	notificationRepo := repository.NewNotificationRepository(db)
+	reviewRepo := repository.NewSpotReviewRepository(db)
+	amenityRepo := repository.NewAmenityRepository(db)
+	followRepo := repository.NewFollowRepository(db)
+	securityEventRepo := repository.NewSecurityEventRepository(db)
+	auditEventRepo := repository.NewAuditEventRepository(db)
+	accountLockoutRepo := repository.NewAccountLockoutRepository(db)
+	passwordResetTokenRepo := repository.NewPasswordResetTokenRepository(db)
+	emailTokenRepo := repository.NewEmailTokenRepository(db)
+	recoveryCodeRepo := repository.NewRecoveryCodeRepository(db)
+	twoFactorChallengeRepo := repository.NewTwoFactorChallengeRepository(db)
+	externalIdentityRepo := repository.NewExternalIdentityRepository(db)
+	oidcAuthRequestRepo := repository.NewOIDCAuthRequestRepository(db)
+	personalAccessTokenRepo := repository.NewPersonalAccessTokenRepository(db)
+	accountRepo := repository.NewAccountRepository(db)
 
 	// Bootstrap: Create initial invitation code if no users exist
 	userCount, err := userRepo.Count(context.Background())
@@ -130,16 +164,28 @@ func main() {
 	}
 
 	// Services
-	authService := service.NewAuthService(userRepo, invitationRepo, refreshTokenRepo, *cfg)
-	userService := service.NewUserService(userRepo, *cfg)
-	notificationService := service.NewNotificationService(fcmClient, userRepo)
+	auditService := service.NewAuditService(auditEventRepo, *cfg)
+	tokenVersionService := service.NewTokenVersionService(userRepo, redisClient, cfg.JWTExpire)
+	accountLockoutService := service.NewAccountLockoutService(accountLockoutRepo, securityEventRepo, *cfg)
+	emailVerificationService := service.NewEmailVerificationService(userRepo, emailTokenRepo, passwordResetTokenRepo, mailSender, *cfg)
+	twoFactorService := service.NewTwoFactorService(userRepo, recoveryCodeRepo, twoFactorChallengeRepo, *cfg)
+	oidcService := service.NewOIDCService(userRepo, externalIdentityRepo, oidcAuthRequestRepo, *cfg)
+	personalAccessTokenService := service.NewPersonalAccessTokenService(personalAccessTokenRepo, userRepo)
+	invitationService := service.NewInvitationService(invitationRepo, userRepo, *cfg)
+	authService := service.NewAuthService(userRepo, invitationRepo, refreshTokenRepo, securityEventRepo, emailVerificationService, twoFactorService, oidcService, tokenVersionService, accountLockoutService, auditService, jwtKeys, *cfg)
+	userService := service.NewUserService(userRepo, spotRepo, visitRepo, favoriteRepo, activityRepo, accountRepo, refreshTokenRepo, minioClient, tokenVersionService, *cfg)
+	notificationService := service.NewNotificationService(fcmClient, userRepo, notificationRepo)
 	activityService := service.NewActivityService(activityRepo, photoRepo, minioClient)
-	spotService := service.NewSpotService(spotRepo, photoRepo, visitRepo, favoriteRepo, activityRepo, notificationRepo, minioClient, notificationService, activityService)
+	spotService := service.NewSpotService(spotRepo, photoRepo, visitRepo, favoriteRepo, activityRepo, notificationRepo, reviewRepo, amenityRepo, minioClient, notificationService, activityService, auditService, cfg.DuplicateSpotRadius)
 	visitService := service.NewVisitService(visitRepo, photoRepo, minioClient, activityService)
-	adminService := service.NewAdminService(userRepo, invitationRepo)
-	photoService := service.NewPhotoService(photoRepo, spotRepo, minioClient)
+	adminService := service.NewAdminService(userRepo, invitationRepo, tokenVersionService, accountLockoutService, auditService)
+	photoService := service.NewPhotoService(photoRepo, spotRepo, minioClient, auditService)
 	weatherService := service.NewWeatherService(weatherClient, redisClient, cfg.WeatherCacheTTL)
 	favoriteService := service.NewFavoriteService(favoriteRepo, spotRepo, photoRepo, minioClient, activityService)
+	reviewService := service.NewReviewService(reviewRepo, spotRepo)
+	amenityService := service.NewAmenityService(amenityRepo)
+	followService := service.NewFollowService(followRepo, userRepo, notificationService)
+	passwordResetService := service.NewPasswordResetService(userRepo, passwordResetTokenRepo, refreshTokenRepo, mailSender, tokenVersionService, *cfg)
 
 	// Handlers
 	authHandler := handler.NewAuthHandler(authService)
@@ -151,16 +197,28 @@ func main() {
 	weatherHandler := handler.NewWeatherHandler(weatherService)
 	favoriteHandler := handler.NewFavoriteHandler(favoriteService)
 	activityHandler := handler.NewActivityHandler(activityService)
+	reviewHandler := handler.NewReviewHandler(reviewService)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	amenityHandler := handler.NewAmenityHandler(amenityService)
+	followHandler := handler.NewFollowHandler(followService)
+	passwordResetHandler := handler.NewPasswordResetHandler(passwordResetService)
+	emailVerificationHandler := handler.NewEmailVerificationHandler(emailVerificationService)
+	twoFactorHandler := handler.NewTwoFactorHandler(twoFactorService)
+	jwksHandler := handler.NewJWKSHandler(jwtKeys)
+	oidcHandler := handler.NewOIDCHandler(authService, oidcService)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(personalAccessTokenService)
+	invitationHandler := handler.NewInvitationHandler(invitationService)
+	auditHandler := handler.NewAuditHandler(auditService)
 
 	// Middlewares
-	authMiddleware := middleware.NewAuthMiddleware(cfg.JWTSecret)
+	authMiddleware := middleware.NewAuthMiddleware(jwtKeys, tokenVersionService, personalAccessTokenService, cfg.RequireAdminTwoFactor)
 	globalRateLimiter := middleware.NewRateLimitMiddleware(redisClient, cfg.RateLimitGlobal)
 	loginRateLimiter := middleware.NewRateLimitMiddleware(redisClient, cfg.RateLimitLogin)
 
 	// Router
 	r := router.Setup(authHandler, userHandler, spotHandler,
 		visitHandler, adminHandler, photoHandler, weatherHandler,
-		favoriteHandler, activityHandler, authMiddleware, globalRateLimiter, loginRateLimiter)
+		favoriteHandler, activityHandler, reviewHandler, notificationHandler, amenityHandler, followHandler, passwordResetHandler, emailVerificationHandler, twoFactorHandler, jwksHandler, oidcHandler, personalAccessTokenHandler, invitationHandler, auditHandler, authMiddleware, globalRateLimiter, loginRateLimiter)
 
 	// Server mit Graceful Shutdown
 	srv := &http.Server{
@@ -170,6 +228,7 @@ func main() {
 
 	logger.Info().Str("port", cfg.Port).Msg("Server starting")
 	go startServer(srv)
+	go purgeAuditEvents(auditService)
 	waitForShutdown(srv, db, redisClient)
 }
 
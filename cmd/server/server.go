@@ -8,6 +8,7 @@ import (
 	"syscall"
 	"time"
 
+	"hopSpotAPI/internal/service"
 	"hopSpotAPI/pkg/cache"
 	"hopSpotAPI/pkg/logger"
 
@@ -63,3 +64,22 @@ func closeDatabase(db *gorm.DB) {
 
 	logger.Info().Msg("Database connection closed")
 }
+
+// auditPurgeInterval is how often audit events past the retention are removed
+const auditPurgeInterval = 24 * time.Hour
+
+// purgeAuditEvents removes expired audit events at startup and then once a day
+func purgeAuditEvents(auditService service.AuditService) {
+	ticker := time.NewTicker(auditPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		removed, err := auditService.PurgeExpired(context.Background())
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to purge audit events")
+		} else if removed > 0 {
+			logger.Info().Int64("removed", removed).Msg("Expired audit events purged")
+		}
+		<-ticker.C
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -11,18 +12,24 @@ import (
 
 type Config struct {
 	// Server
-	Port string
+	Port    string
+	GinMode string // "debug" and "test" count as development
 
 	// Logging
 	LogLevel  string
 	LogFormat string // "JSON" or "CONSOLE"
 
 	// JWT
-	JWTSecret          string
+	JWTAlgorithm       string // "HS256" (shared secret), "RS256" or "EdDSA"
+	JWTSecret          string // HS256 only
+	JWTKeysDir         string // RS256/EdDSA: directory with one "<kid>.pem" file per key
+	JWTActiveKeyID     string // RS256/EdDSA: kid new tokens are signed with, other keys only verify
 	JWTExpire          time.Duration
 	JWTIssuer          string
 	JWTAudience        string
 	RefreshTokenExpire time.Duration
+	MaxSessionsPerUser int           // 0 = unlimited, oldest session is revoked when exceeded
+	SessionMaxLifetime time.Duration // absolute session lifetime regardless of refreshes, 0 = unlimited
 
 	// Database
 	DBHost     string
@@ -53,6 +60,64 @@ type Config struct {
 	// Rate Limiting
 	RateLimitGlobal int // Requests per hour per IP
 	RateLimitLogin  int // Login attempts per hour per IP
+
+	// Account Lockout
+	LoginLockoutThreshold int           // failed logins per account before it is locked, 0 disables the lockout
+	LoginLockoutBase      time.Duration // first lock, doubled with every further failure
+	LoginLockoutMax       time.Duration // upper bound of a single lock
+	LoginFailureWindow    time.Duration // failures older than this are forgotten
+
+	// Spots
+	DuplicateSpotRadius float64 // Meters within which a new spot counts as a duplicate
+
+	// Invitations
+	InvitationQuota  int           // uses of invitation codes a regular user can hand out, 0 disables user invitations
+	InvitationExpire time.Duration // lifetime of invitation codes created by regular users
+
+	// Audit Log
+	AuditRetention time.Duration // audit events older than this are removed, 0 keeps them forever
+
+	// Account Deletion
+	AccountDeletionSpotPolicy  string // "anonymize" keeps spots of deleted accounts without author, "reassign" hands them to the owner below
+	AccountDeletionSpotOwnerID uint   // user receiving the spots and photos with the reassign policy
+
+	// Mail
+	MailDriver   string // "smtp", "file" or "log" (default, mails only end up in the log)
+	MailFrom     string
+	MailFileDir  string // target directory of the file driver
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+
+	// Password Reset
+	PasswordResetURL    string // link in the reset mail, the token is appended as query parameter
+	PasswordResetExpire time.Duration
+
+	// Email Verification
+	EmailConfirmURL    string // link in verification and email change mails, the token is appended
+	EmailConfirmExpire time.Duration
+
+	// Two-Factor Authentication
+	TwoFactorIssuer          string        // shown in authenticator apps
+	TwoFactorChallengeExpire time.Duration // time between password and code step of a login
+	RequireAdminTwoFactor    bool          // staff needs 2FA enabled for privileged permissions
+
+	// OpenID Connect
+	OIDCProviders         []OIDCProviderConfig
+	OIDCAuthRequestExpire time.Duration // time to complete the login at the provider
+}
+
+// OIDCProviderConfig configures an external login provider like Google or Apple.
+// Each provider listed in OIDC_PROVIDERS is read from OIDC_<NAME>_* variables.
+type OIDCProviderConfig struct {
+	Name         string // used in routes, e.g. "google"
+	IssuerURL    string // discovery is read from <issuer>/.well-known/openid-configuration
+	ClientID     string
+	ClientSecret string // empty for public clients
+	RedirectURL  string // redirect URI of the web flow
+	Scopes       []string
+	Audiences    []string // further accepted client IDs, e.g. of the mobile apps
 }
 
 func Load() *Config {
@@ -70,6 +135,16 @@ func Load() *Config {
 		refreshDays = 90
 	}
 
+	maxSessions, err := strconv.Atoi(getEnv("MAX_SESSIONS_PER_USER", "10"))
+	if err != nil {
+		maxSessions = 10
+	}
+
+	sessionLifetimeDays, err := strconv.Atoi(getEnv("SESSION_MAX_LIFETIME_DAYS", "180"))
+	if err != nil {
+		sessionLifetimeDays = 180
+	}
+
 	// Redis
 	redisDB, err := strconv.Atoi(getEnv("REDIS_DB", "0"))
 	if err != nil {
@@ -92,8 +167,85 @@ func Load() *Config {
 		rateLimitLogin = 10
 	}
 
+	// Account Lockout
+	lockoutThreshold, err := strconv.Atoi(getEnv("LOGIN_LOCKOUT_THRESHOLD", "5"))
+	if err != nil {
+		lockoutThreshold = 5
+	}
+
+	lockoutBaseSeconds, err := strconv.Atoi(getEnv("LOGIN_LOCKOUT_BASE_SECONDS", "30"))
+	if err != nil {
+		lockoutBaseSeconds = 30
+	}
+
+	lockoutMaxMinutes, err := strconv.Atoi(getEnv("LOGIN_LOCKOUT_MAX_MINUTES", "60"))
+	if err != nil {
+		lockoutMaxMinutes = 60
+	}
+
+	failureWindowHours, err := strconv.Atoi(getEnv("LOGIN_FAILURE_WINDOW_HOURS", "24"))
+	if err != nil {
+		failureWindowHours = 24
+	}
+
+	// Spots
+	duplicateSpotRadius, err := strconv.ParseFloat(getEnv("DUPLICATE_SPOT_RADIUS_METERS", "15"), 64)
+	if err != nil {
+		duplicateSpotRadius = 15
+	}
+
+	// Invitations
+	invitationQuota, err := strconv.Atoi(getEnv("INVITATION_QUOTA", "5"))
+	if err != nil {
+		invitationQuota = 5
+	}
+
+	invitationExpireDays, err := strconv.Atoi(getEnv("INVITATION_EXPIRE_DAYS", "14"))
+	if err != nil {
+		invitationExpireDays = 14
+	}
+
+	// Audit Log
+	auditRetentionDays, err := strconv.Atoi(getEnv("AUDIT_RETENTION_DAYS", "365"))
+	if err != nil || auditRetentionDays < 0 {
+		auditRetentionDays = 365
+	}
+
+	// Account Deletion
+	spotOwnerID, err := strconv.ParseUint(getEnv("ACCOUNT_DELETION_SPOT_OWNER_ID", "0"), 10, 64)
+	if err != nil {
+		spotOwnerID = 0
+	}
+
+	// Password Reset
+	resetMinutes, err := strconv.Atoi(getEnv("PASSWORD_RESET_EXPIRE_MINUTES", "60"))
+	if err != nil {
+		resetMinutes = 60
+	}
+
+	// Email Verification
+	emailConfirmHours, err := strconv.Atoi(getEnv("EMAIL_CONFIRM_EXPIRE_HOURS", "48"))
+	if err != nil {
+		emailConfirmHours = 48
+	}
+
+	// Two-Factor Authentication
+	challengeMinutes, err := strconv.Atoi(getEnv("TWO_FACTOR_CHALLENGE_EXPIRE_MINUTES", "5"))
+	if err != nil {
+		challengeMinutes = 5
+	}
+
+	// OpenID Connect
+	oidcRequestMinutes, err := strconv.Atoi(getEnv("OIDC_AUTH_REQUEST_EXPIRE_MINUTES", "10"))
+	if err != nil {
+		oidcRequestMinutes = 10
+	}
+
+	ginMode := getEnv("GIN_MODE", "")
+
 	return &Config{
-		Port: getEnv("PORT", "8080"),
+		Port:    getEnv("PORT", "8080"),
+		GinMode: ginMode,
 
 		// Logging
 		LogLevel:  getEnv("LOG_LEVEL", "INFO"),
@@ -107,13 +259,17 @@ func Load() *Config {
 		DBName:     getEnv("DB_NAME", ""),
 
 		// JWT
+		JWTAlgorithm:       getEnv("JWT_ALGORITHM", "HS256"),
 		JWTSecret:          getEnv("JWT_SECRET", ""),
+		JWTKeysDir:         getEnv("JWT_KEYS_DIR", "./keys"),
+		JWTActiveKeyID:     getEnv("JWT_ACTIVE_KID", ""),
 		JWTExpire:          time.Duration(jwtSeconds) * time.Second,
 		JWTAudience:        getEnv("JWT_AUDIENCE", "yourapp.com"),
 		JWTIssuer:          getEnv("JWT_ISSUER", "yourapp.com"),
 		RefreshTokenExpire: time.Duration(refreshDays) * 24 * time.Hour,
+		MaxSessionsPerUser: maxSessions,
+		SessionMaxLifetime: time.Duration(sessionLifetimeDays) * 24 * time.Hour,
 
-		// MinIO
 		// MinIO
 		MinioEndpoint:       getEnv("MINIO_ENDPOINT", ""),
 		MinioPublicEndpoint: getEnv("MINIO_PUBLIC_ENDPOINT", ""),
@@ -136,7 +292,71 @@ func Load() *Config {
 		// Rate Limiting
 		RateLimitGlobal: rateLimitGlobal,
 		RateLimitLogin:  rateLimitLogin,
+
+		// Account Lockout
+		LoginLockoutThreshold: lockoutThreshold,
+		LoginLockoutBase:      time.Duration(lockoutBaseSeconds) * time.Second,
+		LoginLockoutMax:       time.Duration(lockoutMaxMinutes) * time.Minute,
+		LoginFailureWindow:    time.Duration(failureWindowHours) * time.Hour,
+
+		// Spots
+		DuplicateSpotRadius: duplicateSpotRadius,
+
+		// Invitations
+		InvitationQuota:  invitationQuota,
+		InvitationExpire: time.Duration(invitationExpireDays) * 24 * time.Hour,
+
+		// Audit Log
+		AuditRetention: time.Duration(auditRetentionDays) * 24 * time.Hour,
+
+		// Account Deletion
+		AccountDeletionSpotPolicy:  getEnv("ACCOUNT_DELETION_SPOT_POLICY", "anonymize"),
+		AccountDeletionSpotOwnerID: uint(spotOwnerID),
+
+		// Mail
+		MailDriver:   getEnv("MAIL_DRIVER", "log"),
+		MailFrom:     getEnv("MAIL_FROM", "HopSpot <no-reply@hopspot.local>"),
+		MailFileDir:  getEnv("MAIL_FILE_DIR", "./mails"),
+		SMTPHost:     getEnv("SMTP_HOST", ""),
+		SMTPPort:     getEnv("SMTP_PORT", "587"),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+
+		// Password Reset
+		PasswordResetURL:    getEnv("PASSWORD_RESET_URL", "hopspot://reset-password"),
+		PasswordResetExpire: time.Duration(resetMinutes) * time.Minute,
+
+		// Email Verification
+		EmailConfirmURL:    getEnv("EMAIL_CONFIRM_URL", "hopspot://confirm-email"),
+		EmailConfirmExpire: time.Duration(emailConfirmHours) * time.Hour,
+
+		// Two-Factor Authentication
+		TwoFactorIssuer:          getEnv("TWO_FACTOR_ISSUER", "HopSpot"),
+		TwoFactorChallengeExpire: time.Duration(challengeMinutes) * time.Minute,
+		RequireAdminTwoFactor:    getEnv("REQUIRE_ADMIN_2FA", "false") == "true",
+
+		// OpenID Connect
+		OIDCProviders:         loadOIDCProviders(),
+		OIDCAuthRequestExpire: time.Duration(oidcRequestMinutes) * time.Minute,
+	}
+}
+
+func loadOIDCProviders() []OIDCProviderConfig {
+	var providers []OIDCProviderConfig
+	for _, name := range splitList(getEnv("OIDC_PROVIDERS", "")) {
+		name = strings.ToLower(name)
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		providers = append(providers, OIDCProviderConfig{
+			Name:         name,
+			IssuerURL:    getEnv(prefix+"ISSUER_URL", ""),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", ""),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "openid email profile")),
+			Audiences:    splitList(getEnv(prefix+"AUDIENCES", "")),
+		})
 	}
+	return providers
 }
 
 func (c *Config) Validate() error {
@@ -156,11 +376,15 @@ func (c *Config) Validate() error {
 		missing = append(missing, "DB_NAME")
 	}
 
-	// JWT (required)
-	if c.JWTSecret == "" {
-		missing = append(missing, "JWT_SECRET")
-	} else if len(c.JWTSecret) < 32 {
-		return fmt.Errorf("JWT_SECRET must be at least 32 characters (got %d)", len(c.JWTSecret))
+	// JWT (required, the secret only for HS256)
+	if c.JWTAlgorithm == "HS256" {
+		if c.JWTSecret == "" {
+			missing = append(missing, "JWT_SECRET")
+		} else if len(c.JWTSecret) < 32 {
+			return fmt.Errorf("JWT_SECRET must be at least 32 characters (got %d)", len(c.JWTSecret))
+		}
+	} else if c.JWTActiveKeyID == "" {
+		missing = append(missing, "JWT_ACTIVE_KID")
 	}
 
 	// MinIO (required)
@@ -174,6 +398,28 @@ func (c *Config) Validate() error {
 		missing = append(missing, "MINIO_SECRET_KEY")
 	}
 
+	// Account Deletion (the reassign policy needs an owner)
+	switch c.AccountDeletionSpotPolicy {
+	case "anonymize":
+	case "reassign":
+		if c.AccountDeletionSpotOwnerID == 0 {
+			missing = append(missing, "ACCOUNT_DELETION_SPOT_OWNER_ID")
+		}
+	default:
+		return fmt.Errorf("ACCOUNT_DELETION_SPOT_POLICY must be anonymize or reassign (got %q)", c.AccountDeletionSpotPolicy)
+	}
+
+	// OpenID Connect (per configured provider)
+	for _, provider := range c.OIDCProviders {
+		prefix := "OIDC_" + strings.ToUpper(provider.Name) + "_"
+		if provider.IssuerURL == "" {
+			missing = append(missing, prefix+"ISSUER_URL")
+		}
+		if provider.ClientID == "" {
+			missing = append(missing, prefix+"CLIENT_ID")
+		}
+	}
+
 	if len(missing) > 0 {
 		return fmt.Errorf("missing required environment variables: %v", missing)
 	}
@@ -187,3 +433,14 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// splitList splits a comma separated value and drops empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
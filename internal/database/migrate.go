@@ -12,22 +12,187 @@ import (
 func Migrate(db *gorm.DB) error {
 	logger.Info().Msg("Migrating database...")
 
+	// PostGIS is needed for the spot location column
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS postgis").Error; err != nil {
+		return fmt.Errorf("failed to enable postgis: %w", err)
+	}
+
 	err := db.AutoMigrate(
 		&domain.User{},
+		&domain.Amenity{},
 		&domain.Spot{},
 		&domain.Photo{},
 		&domain.Notification{},
 		&domain.InvitationCode{},
+		&domain.InvitationRedemption{},
 		&domain.Visit{},
 		&domain.RefreshToken{},
+		&domain.RotatedRefreshToken{},
+		&domain.SecurityEvent{},
+		&domain.AuditEvent{},
+		&domain.AccountLockout{},
+		&domain.PasswordResetToken{},
+		&domain.EmailToken{},
+		&domain.RecoveryCode{},
+		&domain.TwoFactorChallenge{},
+		&domain.ExternalIdentity{},
+		&domain.OIDCAuthRequest{},
+		&domain.PersonalAccessToken{},
 		&domain.Favorite{},
 		&domain.Activity{},
+		&domain.SpotReview{},
+		&domain.Follow{},
 	)
 
 	if err != nil {
 		return fmt.Errorf("migration failed: %w", err)
 	}
 
+	if err := migrateSpotRatingsToReviews(db); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	if err := migrateSpotLocation(db); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	if err := seedLegacyAmenities(db); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	if err := migrateSpotAmenityFlags(db); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	if err := backfillRefreshTokenLastUsed(db); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	if err := migrateInvitationRedemptions(db); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
 	logger.Info().Msg("Migrations completed successfully")
 	return nil
 }
+
+// migrateSpotRatingsToReviews converts the legacy creator-owned spots.rating column
+// into a review of the creator and drops the column afterwards (runs only once)
+func migrateSpotRatingsToReviews(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&domain.Spot{}, "rating") {
+		return nil
+	}
+
+	logger.Info().Msg("Migrating legacy spot ratings to reviews...")
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			INSERT INTO spot_reviews (spot_id, user_id, stars, comment, created_at, updated_at)
+			SELECT id, created_by, rating, '', created_at, updated_at
+			FROM spots
+			WHERE rating IS NOT NULL
+			ON CONFLICT (user_id, spot_id) DO NOTHING`).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec(`
+			UPDATE spots SET average_rating = r.average, review_count = r.count
+			FROM (
+				SELECT spot_id, AVG(stars) AS average, COUNT(*) AS count
+				FROM spot_reviews
+				GROUP BY spot_id
+			) r
+			WHERE spots.id = r.spot_id`).Error; err != nil {
+			return err
+		}
+
+		return tx.Migrator().DropColumn(&domain.Spot{}, "rating")
+	})
+}
+
+// migrateSpotLocation adds the geography column derived from latitude/longitude
+// and its GiST index. The column is generated, so it never goes out of sync.
+func migrateSpotLocation(db *gorm.DB) error {
+	if err := db.Exec(`
+		ALTER TABLE spots ADD COLUMN IF NOT EXISTS location geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography) STORED`).Error; err != nil {
+		return err
+	}
+
+	return db.Exec("CREATE INDEX IF NOT EXISTS idx_spots_location ON spots USING GIST (location)").Error
+}
+
+// seedLegacyAmenities makes sure the amenities behind has_toilet/has_trash_bin exist
+func seedLegacyAmenities(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO amenities (key, name, icon, created_at, updated_at)
+		VALUES (?, 'Toilet', 'toilet', NOW(), NOW()), (?, 'Trash bin', 'trash_bin', NOW(), NOW())
+		ON CONFLICT (key) DO NOTHING`,
+		domain.AmenityKeyToilet, domain.AmenityKeyTrashBin).Error
+}
+
+// migrateSpotAmenityFlags converts the legacy has_toilet/has_trash_bin columns
+// into amenity tags and drops the columns afterwards (runs only once)
+func migrateSpotAmenityFlags(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&domain.Spot{}, "has_toilet") {
+		return nil
+	}
+
+	logger.Info().Msg("Migrating legacy spot amenity flags to amenities...")
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		flags := map[string]string{
+			"has_toilet":    domain.AmenityKeyToilet,
+			"has_trash_bin": domain.AmenityKeyTrashBin,
+		}
+
+		for column, key := range flags {
+			if err := tx.Exec(fmt.Sprintf(`
+				INSERT INTO spot_amenities (spot_id, amenity_id)
+				SELECT spots.id, amenities.id
+				FROM spots, amenities
+				WHERE spots.%s = TRUE AND amenities.key = ?
+				ON CONFLICT DO NOTHING`, column), key).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Migrator().DropColumn(&domain.Spot{}, column); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// backfillRefreshTokenLastUsed sets last_used_at for tokens issued before sessions were tracked
+func backfillRefreshTokenLastUsed(db *gorm.DB) error {
+	return db.Exec("UPDATE refresh_tokens SET last_used_at = updated_at WHERE last_used_at IS NULL").Error
+}
+
+// migrateInvitationRedemptions converts the legacy single-use invitation_codes.redeemed_by column
+// into redemption records and drops the column afterwards (runs only once)
+func migrateInvitationRedemptions(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&domain.InvitationCode{}, "redeemed_by") {
+		return nil
+	}
+
+	logger.Info().Msg("Migrating legacy invitation redemptions...")
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			INSERT INTO invitation_redemptions (invitation_code_id, user_id, created_at)
+			SELECT id, redeemed_by, updated_at
+			FROM invitation_codes
+			WHERE redeemed_by IS NOT NULL
+			ON CONFLICT (user_id) DO NOTHING`).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("UPDATE invitation_codes SET use_count = 1 WHERE redeemed_by IS NOT NULL").Error; err != nil {
+			return err
+		}
+
+		return tx.Migrator().DropColumn(&domain.InvitationCode{}, "redeemed_by")
+	})
+}
@@ -0,0 +1,20 @@
+package domain
+
+// AccountData is everything stored about a user, collected for the personal data export.
+// It is not a table.
+type AccountData struct {
+	User               User
+	Spots              []Spot // created by the user
+	Photos             []Photo
+	Visits             []Visit
+	Favorites          []Favorite
+	Reviews            []SpotReview
+	Activities         []Activity
+	Notifications      []Notification
+	Follows            []Follow // edges in both directions, without blocks of other users
+	Sessions           []RefreshToken
+	ExternalIdentities []ExternalIdentity
+	AccessTokens       []PersonalAccessToken
+	SecurityEvents     []SecurityEvent
+	InvitationCodes    []InvitationCode // created by the user
+}
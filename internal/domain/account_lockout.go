@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// AccountLockout counts consecutive failed logins of a user.
+// The row is removed by a successful login or an admin unlock.
+type AccountLockout struct {
+	UserID         uint       `gorm:"primaryKey;autoIncrement:false" json:"userId"`
+	FailedAttempts int        `gorm:"not null;default:0" json:"failedAttempts"`
+	LastFailedAt   time.Time  `gorm:"not null" json:"lastFailedAt"`
+	LockedUntil    *time.Time `json:"lockedUntil,omitempty"`
+
+	// Relation
+	User User `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+func (l *AccountLockout) IsLocked() bool {
+	return l.LockedUntil != nil && time.Now().Before(*l.LockedUntil)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+)
+
+// Amenity keys backing the legacy has_toilet / has_trash_bin fields
+const (
+	AmenityKeyToilet   = "toilet"
+	AmenityKeyTrashBin = "trash_bin"
+)
+
+// Amenity is an admin-managed spot attribute (shade, view, picnic table, ...)
+type Amenity struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Key       string    `gorm:"type:varchar(50);uniqueIndex;not null" json:"key"`
+	Name      string    `gorm:"type:varchar(100);not null" json:"name"`
+	Icon      string    `gorm:"type:varchar(50)" json:"icon"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// IsLegacyAmenity reports whether an older app version still relies on the amenity
+func IsLegacyAmenity(key string) bool {
+	return key == AmenityKeyToilet || key == AmenityKeyTrashBin
+}
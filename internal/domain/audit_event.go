@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"reflect"
+	"time"
+)
+
+// Audit actions
+const (
+	AuditActionUserUpdated           = "user.updated"
+	AuditActionUserDeleted           = "user.deleted"
+	AuditActionUserUnlocked          = "user.unlocked"
+	AuditActionUserDeactivated       = "user.deactivated" // cascading deactivation of an invite tree
+	AuditActionInvitationCodeCreated = "invitation_code.created"
+	AuditActionInvitationCodeDeleted = "invitation_code.deleted"
+	AuditActionSpotUpdated           = "spot.updated"
+	AuditActionSpotDeleted           = "spot.deleted"
+	AuditActionSpotMerged            = "spot.merged" // the source spot was merged into the target
+	AuditActionPhotoDeleted          = "photo.deleted"
+	AuditActionLogin                 = "auth.login"
+	AuditActionLoginFailed           = "auth.login_failed"
+	AuditActionRegister              = "auth.register"
+	AuditActionRefreshTokenReuse     = "auth.refresh_token_reuse"
+)
+
+// Audit target types
+const (
+	AuditTargetUser           = "user"
+	AuditTargetInvitationCode = "invitation_code"
+	AuditTargetSpot           = "spot"
+	AuditTargetPhoto          = "photo"
+)
+
+// AuditEvent records who did what to which resource. Events are only appended,
+// they are removed by the retention job after AUDIT_RETENTION_DAYS.
+type AuditEvent struct {
+	ID         uint                   `gorm:"primaryKey" json:"id"`
+	ActorID    *uint                  `gorm:"index" json:"actorId"` // nil if nobody is logged in, e.g. a failed login
+	Action     string                 `gorm:"type:varchar(50);not null;index" json:"action"`
+	TargetType string                 `gorm:"type:varchar(30);index:idx_audit_target,priority:1" json:"targetType"`
+	TargetID   *uint                  `gorm:"index:idx_audit_target,priority:2" json:"targetId"`
+	Changes    map[string]AuditChange `gorm:"type:text;serializer:json" json:"changes,omitempty"` // changed fields only
+	IPAddress  string                 `gorm:"type:varchar(45)" json:"ipAddress"`
+	UserAgent  string                 `gorm:"type:varchar(255)" json:"userAgent"`
+	CreatedAt  time.Time              `gorm:"type:timestamptz;index" json:"createdAt"`
+
+	// Relation - deleted actors included
+	Actor *User `gorm:"foreignKey:ActorID;references:ID" json:"actor,omitempty"`
+}
+
+// AuditChange is the value of a field before and after the action, nil if it didn't exist
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditDiff keeps the fields whose values differ, nil for a missing side
+func AuditDiff(before, after map[string]any) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+	for field, value := range before {
+		if next, ok := after[field]; !ok || !reflect.DeepEqual(value, next) {
+			changes[field] = AuditChange{Before: value, After: after[field]}
+		}
+	}
+	for field, value := range after {
+		if _, ok := before[field]; !ok {
+			changes[field] = AuditChange{After: value}
+		}
+	}
+	return changes
+}
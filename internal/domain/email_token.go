@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Email token purposes
+const (
+	EmailTokenPurposeVerify = "verify" // confirm the address of the account
+	EmailTokenPurposeChange = "change" // confirm a new address
+)
+
+// EmailToken is a single-use token sent to an email address. Only the hash is stored.
+type EmailToken struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"userId"`
+	Purpose   string     `gorm:"type:varchar(20);not null" json:"purpose"`
+	Email     string     `gorm:"type:varchar(255);not null" json:"email"` // address the token was sent to
+	TokenHash string     `gorm:"type:varchar(255);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expiresAt"`
+	UsedAt    *time.Time `json:"usedAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+
+	// Relation
+	User User `gorm:"foreignKey:UserID;references:ID" json:"user"`
+}
+
+func (t *EmailToken) IsValid() bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt)
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// ExternalIdentity links a user to an account at an OpenID Connect provider
+type ExternalIdentity struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	UserID      uint       `gorm:"not null;uniqueIndex:idx_external_identity_user_provider" json:"userId"`
+	Provider    string     `gorm:"type:varchar(50);not null;uniqueIndex:idx_external_identity_subject;uniqueIndex:idx_external_identity_user_provider" json:"provider"`
+	Subject     string     `gorm:"type:varchar(255);not null;uniqueIndex:idx_external_identity_subject" json:"-"` // "sub" claim, stable per provider
+	Email       string     `gorm:"type:varchar(255)" json:"email"`                                                // address at the provider when linked
+	LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+
+	// Relation
+	User User `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// OIDCAuthRequest holds the state of an authorization code flow until the callback.
+// Only the hash of the state is stored, nonce and PKCE verifier never leave the server.
+type OIDCAuthRequest struct {
+	ID           uint       `gorm:"primaryKey" json:"id"`
+	Provider     string     `gorm:"type:varchar(50);not null" json:"provider"`
+	StateHash    string     `gorm:"type:varchar(255);not null;uniqueIndex" json:"-"`
+	Nonce        string     `gorm:"type:varchar(255);not null" json:"-"`
+	CodeVerifier string     `gorm:"type:varchar(255);not null" json:"-"`
+	ExpiresAt    time.Time  `gorm:"not null" json:"expiresAt"`
+	UsedAt       *time.Time `json:"usedAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+func (r *OIDCAuthRequest) IsValid() bool {
+	return r.UsedAt == nil && time.Now().Before(r.ExpiresAt)
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+)
+
+type FollowStatus string
+
+const (
+	FollowStatusPending  FollowStatus = "pending"  // Friend request waiting for the followee
+	FollowStatusAccepted FollowStatus = "accepted" // Accepted friendships exist in both directions
+	FollowStatusBlocked  FollowStatus = "blocked"  // Follower has blocked the followee
+)
+
+// Follow is a directed edge in the friend graph
+type Follow struct {
+	ID         uint         `gorm:"primaryKey" json:"id"`
+	FollowerID uint         `gorm:"not null;uniqueIndex:idx_follow_pair,priority:1" json:"followerId"`
+	FolloweeID uint         `gorm:"not null;uniqueIndex:idx_follow_pair,priority:2;index:idx_follow_followee,priority:1" json:"followeeId"`
+	Status     FollowStatus `gorm:"type:varchar(20);not null;default:'pending';index:idx_follow_followee,priority:2" json:"status"`
+	CreatedAt  time.Time    `json:"createdAt"`
+	UpdatedAt  time.Time    `json:"updatedAt"`
+
+	// Relations - loaded with Preload
+	Follower User `gorm:"foreignKey:FollowerID;references:ID" json:"follower,omitempty"`
+	Followee User `gorm:"foreignKey:FolloweeID;references:ID" json:"followee,omitempty"`
+}
@@ -1,17 +1,44 @@
 package domain
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
 type InvitationCode struct {
 	*gorm.Model
-	Code       string `gorm:"unique;type:varchar(100)" json:"code"`
-	Comment    string `gorm:"type:varchar(255)" json:"comment"`
-	CreatedBy  *uint  `gorm:"default:null" json:"createdBy,omitempty"`
-	RedeemedBy *uint  `gorm:"type:int;default:null" json:"redeemedBy,omitempty"`
+	Code      string `gorm:"unique;type:varchar(100)" json:"code"`
+	Comment   string `gorm:"type:varchar(255)" json:"comment"`
+	CreatedBy *uint  `gorm:"default:null;index" json:"createdBy,omitempty"`
+
+	// A code can be redeemed MaxUses times until it expires (nil = never)
+	MaxUses   int        `gorm:"not null;default:1" json:"maxUses"`
+	UseCount  int        `gorm:"not null;default:0" json:"useCount"` // maintained by the repository together with the redemptions
+	ExpiresAt *time.Time `gorm:"default:null" json:"expiresAt,omitempty"`
+	// Role of users registering with the code, nil for the default role
+	Role *Role `gorm:"type:varchar(20);default:null" json:"role,omitempty"`
 
 	// Relations - loaded with Preload
-	Creator  *User `gorm:"foreignKey:CreatedBy;references:ID" json:"creator,omitempty"`
-	Redeemer *User `gorm:"foreignKey:RedeemedBy;references:ID" json:"redeemer,omitempty"`
+	Creator     *User                  `gorm:"foreignKey:CreatedBy;references:ID" json:"creator,omitempty"`
+	Redemptions []InvitationRedemption `gorm:"foreignKey:InvitationCodeID;references:ID" json:"redemptions,omitempty"`
+}
+
+func (c *InvitationCode) IsExpired() bool {
+	return c.ExpiresAt != nil && !time.Now().Before(*c.ExpiresAt)
+}
+
+func (c *InvitationCode) IsUsedUp() bool {
+	return c.UseCount >= c.MaxUses
+}
+
+// InvitationRedemption records a user who registered with an invitation code
+type InvitationRedemption struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	InvitationCodeID uint      `gorm:"not null;index" json:"invitationCodeId"`
+	UserID           uint      `gorm:"not null;uniqueIndex" json:"userId"` // an account is created with one code
+	CreatedAt        time.Time `json:"createdAt"`
+
+	// Relation
+	User User `gorm:"foreignKey:UserID;references:ID" json:"user"`
 }
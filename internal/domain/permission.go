@@ -0,0 +1,89 @@
+package domain
+
+import "slices"
+
+// Permission allows an action beyond the own content. Roles are mapped to permissions in rolePermissions.
+type Permission string
+
+const (
+	PermissionSpotUpdateAny    Permission = "spot.update.any"
+	PermissionSpotDeleteAny    Permission = "spot.delete.any"
+	PermissionSpotMerge        Permission = "spot.merge"
+	PermissionSpotImport       Permission = "spot.import"
+	PermissionPhotoUpdateAny   Permission = "photo.update.any" // choose the main photo of spots of others
+	PermissionPhotoDeleteAny   Permission = "photo.delete.any"
+	PermissionReviewUpdateAny  Permission = "review.update.any"
+	PermissionReviewDeleteAny  Permission = "review.delete.any"
+	PermissionVisitDeleteAny   Permission = "visit.delete.any"
+	PermissionAmenityManage    Permission = "amenity.manage"
+	PermissionInvitationCreate Permission = "invitation.create" // invite friends within the quota
+	PermissionInvitationManage Permission = "invitation.manage" // all codes, no quota
+	PermissionUserManage       Permission = "user.manage"       // roles, deactivation and deletion of accounts
+	PermissionAuditRead        Permission = "audit.read"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleUser: {
+		PermissionInvitationCreate,
+	},
+	RoleModerator: {
+		PermissionSpotUpdateAny,
+		PermissionSpotDeleteAny,
+		PermissionSpotMerge,
+		PermissionPhotoUpdateAny,
+		PermissionPhotoDeleteAny,
+		PermissionReviewDeleteAny,
+		PermissionInvitationCreate,
+	},
+	RoleAdmin: {
+		PermissionSpotUpdateAny,
+		PermissionSpotDeleteAny,
+		PermissionSpotMerge,
+		PermissionSpotImport,
+		PermissionPhotoUpdateAny,
+		PermissionPhotoDeleteAny,
+		PermissionReviewUpdateAny,
+		PermissionReviewDeleteAny,
+		PermissionVisitDeleteAny,
+		PermissionAmenityManage,
+		PermissionInvitationCreate,
+		PermissionInvitationManage,
+		PermissionUserManage,
+		PermissionAuditRead,
+	},
+}
+
+// Can reports whether the role has the permission, unknown roles have none
+func (r Role) Can(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
+
+// Principal is the user a request acts for, set by the auth middleware
+type Principal struct {
+	UserID uint
+	Role   Role
+	// TwoFactor is set when the session passed 2FA
+	TwoFactor bool
+	// RequireTwoFactor makes privileged permissions depend on TwoFactor, see config.RequireAdminTwoFactor
+	RequireTwoFactor bool
+}
+
+// Can reports whether the principal may use the permission.
+// With RequireTwoFactor staff without 2FA keeps only the permissions of regular users.
+func (p Principal) Can(permission Permission) bool {
+	if !p.Role.Can(permission) {
+		return false
+	}
+	return !p.RequireTwoFactor || p.TwoFactor || !permission.IsPrivileged()
+}
+
+// CanActOn is the ownership check: owners may act on their own resources,
+// everyone else needs the permission for resources of others
+func (p Principal) CanActOn(ownerID uint, permission Permission) bool {
+	return ownerID == p.UserID || p.Can(permission)
+}
+
+// IsPrivileged is true for permissions regular users don't have
+func (p Permission) IsPrivileged() bool {
+	return !RoleUser.Can(p)
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// PersonalAccessTokenPrefix marks personal access tokens, so they can be told apart from JWTs
+const PersonalAccessTokenPrefix = "hsp_"
+
+type Scope string
+
+const (
+	ScopeSpotsRead   Scope = "spots:read"   // spots, photos, reviews and amenities
+	ScopeSpotsWrite  Scope = "spots:write"  // create and change spots, photos and reviews
+	ScopeVisitsRead  Scope = "visits:read"  // own visits
+	ScopeVisitsWrite Scope = "visits:write" // log and delete visits
+	ScopeAdmin       Scope = "admin"        // admin routes, only for staff
+)
+
+func (s Scope) IsValid() bool {
+	switch s {
+	case ScopeSpotsRead, ScopeSpotsWrite, ScopeVisitsRead, ScopeVisitsWrite, ScopeAdmin:
+		return true
+	}
+	return false
+}
+
+// PersonalAccessToken lets scripts and integrations call the API without a login session.
+// Only the hash is stored, the token is shown once on creation.
+type PersonalAccessToken struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	UserID      uint       `gorm:"not null;index" json:"userId"`
+	Name        string     `gorm:"type:varchar(100);not null" json:"name"`
+	TokenHash   string     `gorm:"type:varchar(255);not null;uniqueIndex" json:"-"`
+	TokenPrefix string     `gorm:"type:varchar(20);not null" json:"tokenPrefix"` // first characters, to recognize a token
+	Scopes      []Scope    `gorm:"type:text;serializer:json;not null" json:"scopes"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"` // nil never expires
+	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+
+	// Relation
+	User User `gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+func (t *PersonalAccessToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().After(*t.ExpiresAt)
+}
+
+func (t *PersonalAccessToken) HasScope(scope Scope) bool {
+	return slices.Contains(t.Scopes, scope)
+}
@@ -6,12 +6,24 @@ import (
 	"gorm.io/gorm"
 )
 
+// RefreshToken is a login session. The row keeps its ID across rotations;
+// the hashes it rotated away from are kept as RotatedRefreshToken, together
+// they form the token family used for reuse detection.
 type RefreshToken struct {
 	*gorm.Model
 	UserID    uint      `gorm:"not null;index" json:"userId"`
 	TokenHash string    `gorm:"type:varchar(255);not null;uniqueIndex" json:"-"`
 	ExpiresAt time.Time `gorm:"not null;index" json:"expiresAt"`
 	IsRevoked bool      `gorm:"default:false" json:"isRevoked"`
+	// TwoFactorVerified is set when the session was started with the second factor
+	TwoFactorVerified bool `gorm:"default:false" json:"twoFactorVerified"`
+
+	// Device information of the session
+	DeviceName string    `gorm:"type:varchar(100)" json:"deviceName"`
+	Platform   string    `gorm:"type:varchar(50)" json:"platform"`
+	UserAgent  string    `gorm:"type:varchar(255)" json:"userAgent"`
+	IPAddress  string    `gorm:"type:varchar(45)" json:"ipAddress"`
+	LastUsedAt time.Time `gorm:"index" json:"lastUsedAt"`
 
 	// Relation
 	User User `gorm:"foreignKey:UserID;references:ID" json:"user"`
@@ -24,3 +36,18 @@ func (r *RefreshToken) IsExpired() bool {
 func (r *RefreshToken) IsValid() bool {
 	return !r.IsExpired() && !r.IsRevoked
 }
+
+// ExceedsLifetime reports whether the session is older than the absolute lifetime.
+// A zero lifetime means sessions can be extended forever.
+func (r *RefreshToken) ExceedsLifetime(lifetime time.Duration) bool {
+	return lifetime > 0 && time.Now().After(r.CreatedAt.Add(lifetime))
+}
+
+// RotatedRefreshToken is a token of a family that was already exchanged.
+// Presenting it again means the token was stolen (or replayed).
+type RotatedRefreshToken struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	FamilyID  uint      `gorm:"not null;index" json:"familyId"` // refresh_tokens.id
+	TokenHash string    `gorm:"type:varchar(255);not null;uniqueIndex" json:"-"`
+	RotatedAt time.Time `gorm:"not null" json:"rotatedAt"`
+}
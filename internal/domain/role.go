@@ -3,14 +3,20 @@ package domain
 type Role string
 
 const (
-	RoleUser  Role = "user"
-	RoleAdmin Role = "admin"
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator" // cleans up content, can't manage users
+	RoleAdmin     Role = "admin"
 )
 
 func (r Role) IsValid() bool {
 	switch r {
-	case RoleUser, RoleAdmin:
+	case RoleUser, RoleModerator, RoleAdmin:
 		return true
 	}
 	return false
 }
+
+// IsStaff is true for roles with permissions on the content or accounts of others
+func (r Role) IsStaff() bool {
+	return r == RoleModerator || r == RoleAdmin
+}
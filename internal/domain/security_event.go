@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Security event types
+const (
+	SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+	SecurityEventAccountLocked     = "account_locked"
+	SecurityEventAccountUnlocked   = "account_unlocked"
+)
+
+// SecurityEvent records suspicious activity on an account
+type SecurityEvent struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"not null;index" json:"userId"`
+	Type      string    `gorm:"type:varchar(50);not null;index" json:"type"`
+	IPAddress string    `gorm:"type:varchar(45)" json:"ipAddress"`
+	UserAgent string    `gorm:"type:varchar(255)" json:"userAgent"`
+	Details   string    `gorm:"type:varchar(255)" json:"details"`
+	CreatedAt time.Time `gorm:"type:timestamptz;index" json:"createdAt"`
+}
@@ -12,11 +12,35 @@ type Spot struct {
 	Description string    `gorm:"type:text" json:"description"`
 	Latitude    float64   `gorm:"type:float;index:idx_location,priority:1" json:"latitude"`
 	Longitude   float64   `gorm:"type:float;index:idx_location,priority:2" json:"longitude"`
-	Rating      *int      `gorm:"type:int;default:null;index" json:"rating,omitempty"`
-	HasToilet   bool      `gorm:"type:boolean" json:"has_toilet"`
-	HasTrashBin bool      `gorm:"type:boolean" json:"has_trash_bin"`
 	CreatedBy   uint      `gorm:"type:int;not null;index" json:"createdBy"`
 
+	// Aggregated from SpotReview - maintained by the review repository
+	AverageRating *float64 `gorm:"type:float;default:null;index" json:"averageRating,omitempty"`
+	ReviewCount   int64    `gorm:"type:int;not null;default:0" json:"reviewCount"`
+
+	// Distance to the search point in meters - only set by geo queries, not a column
+	Distance *float64 `gorm:"->;-:migration" json:"-"`
+
 	// Relations - loaded with Preload
-	Creator User `gorm:"foreignKey:CreatedBy;references:ID" json:"creator"`
+	Creator   User      `gorm:"foreignKey:CreatedBy;references:ID" json:"creator"`
+	Amenities []Amenity `gorm:"many2many:spot_amenities" json:"amenities,omitempty"`
+}
+
+// HasAmenity reports whether the spot is tagged with the amenity key (Amenities must be loaded)
+func (s *Spot) HasAmenity(key string) bool {
+	for _, amenity := range s.Amenities {
+		if amenity.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// AmenityKeys returns the keys of the loaded amenities
+func (s *Spot) AmenityKeys() []string {
+	keys := make([]string, len(s.Amenities))
+	for i, amenity := range s.Amenities {
+		keys[i] = amenity.Key
+	}
+	return keys
 }
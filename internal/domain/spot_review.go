@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"time"
+)
+
+type SpotReview struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	SpotID    uint      `gorm:"not null;uniqueIndex:idx_review_user_spot,priority:2;index" json:"spotId"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_review_user_spot,priority:1" json:"userId"`
+	Stars     int       `gorm:"type:int;not null" json:"stars"`
+	Comment   string    `gorm:"type:text" json:"comment"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Relations - loaded with Preload
+	Spot Spot `gorm:"foreignKey:SpotID;references:ID" json:"spot,omitempty"`
+	User User `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+}
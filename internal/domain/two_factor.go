@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// RecoveryCode is a one-time code to log in without the authenticator app. Only the hash is stored.
+type RecoveryCode struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"userId"`
+	CodeHash  string     `gorm:"type:varchar(255);not null;index" json:"-"`
+	UsedAt    *time.Time `json:"usedAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// TwoFactorChallenge links the password step of a login to the code step. Only the hash is stored.
+type TwoFactorChallenge struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"userId"`
+	TokenHash string     `gorm:"type:varchar(255);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expiresAt"`
+	Attempts  int        `gorm:"not null;default:0" json:"attempts"` // failed codes entered
+	UsedAt    *time.Time `json:"usedAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+
+	// Relation
+	User User `gorm:"foreignKey:UserID;references:ID" json:"user"`
+}
+
+func (c *TwoFactorChallenge) IsValid(maxAttempts int) bool {
+	return c.UsedAt == nil && c.Attempts < maxAttempts && time.Now().Before(c.ExpiresAt)
+}
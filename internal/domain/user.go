@@ -1,6 +1,10 @@
 package domain
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type User struct {
 	*gorm.Model
@@ -10,4 +14,27 @@ type User struct {
 	Role         Role    `gorm:"type:varchar(20);not null;default:'user'" json:"role"`
 	FcmToken     *string `gorm:"type:varchar(255)" json:"fcm_token"`
 	IsActive     bool    `gorm:"type:boolean" json:"is_active"`
+
+	// TokenVersion is embedded in access tokens, raising it invalidates all issued access tokens
+	TokenVersion uint `gorm:"not null;default:0" json:"-"`
+
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+
+	// Two-factor authentication, the secret is set during setup and active once TOTPEnabledAt is set
+	TOTPSecret    *string    `gorm:"type:varchar(64)" json:"-"`
+	TOTPEnabledAt *time.Time `json:"totp_enabled_at"`
+	TOTPLastStep  int64      `gorm:"not null;default:0" json:"-"` // last accepted time step, prevents code reuse
+}
+
+// HasPassword is false for accounts created by an external login
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+func (u *User) IsTwoFactorEnabled() bool {
+	return u.TOTPEnabledAt != nil && u.TOTPSecret != nil
 }
@@ -1,6 +1,8 @@
 package requests
 
 import (
+	"time"
+
 	"hopSpotAPI/internal/domain"
 )
 
@@ -8,12 +10,14 @@ type ListUsersRequest struct {
 	Page     int    `form:"page,default=1" binding:"min=1"`
 	Limit    int    `form:"limit,default=50" binding:"min=1,max=100"`
 	IsActive *bool  `form:"is_active"`
-	Role     string `form:"role" binding:"omitempty,oneof=user admin"`
+	Role     string `form:"role" binding:"omitempty,oneof=user moderator admin"`
 	Search   string `form:"search"`
+
+	EmailVerified *bool `form:"email_verified"`
 }
 
 type AdminUpdateUserRequest struct {
-	Role     *domain.Role `json:"role" binding:"omitempty,oneof=user admin"`
+	Role     *domain.Role `json:"role" binding:"omitempty,oneof=user moderator admin"`
 	IsActive *bool        `json:"is_active"`
 }
 
@@ -24,5 +28,28 @@ type ListInvitationCodesRequest struct {
 }
 
 type CreateInvitationCodeRequest struct {
-	Comment string `json:"comment" binding:"max=255"`
+	Comment       string       `json:"comment" binding:"max=255"`
+	MaxUses       *int         `json:"max_uses" binding:"omitempty,min=1,max=1000"`         // omitted is single-use
+	ExpiresInDays *int         `json:"expires_in_days" binding:"omitempty,min=1,max=365"`   // omitted never expires
+	Role          *domain.Role `json:"role" binding:"omitempty,oneof=user moderator admin"` // omitted is the default role
+}
+
+type InviteTreeRequest struct {
+	Depth int `form:"depth,default=3" binding:"min=1,max=10"` // levels below the roots
+}
+
+type DeactivateUserRequest struct {
+	Recursive bool `json:"recursive"` // also the users invited by the invitees, down to the leaves
+	DryRun    bool `json:"dry_run"`   // only list the affected users
+}
+
+type ListAuditEventsRequest struct {
+	Page       int        `form:"page,default=1" binding:"min=1"`
+	Limit      int        `form:"limit,default=50" binding:"min=1,max=100"`
+	ActorID    *uint      `form:"actor_id"`
+	TargetType string     `form:"target_type" binding:"omitempty,oneof=user invitation_code spot photo"`
+	TargetID   *uint      `form:"target_id"`
+	Action     string     `form:"action" binding:"max=50"`
+	From       *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"` // RFC 3339, inclusive
+	To         *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`   // RFC 3339, exclusive
 }
@@ -0,0 +1,13 @@
+package requests
+
+type CreateAmenityRequest struct {
+	Key  string `json:"key" binding:"required,min=1,max=50"` // lowercase letters, digits and underscores
+	Name string `json:"name" binding:"required,min=1,max=100"`
+	Icon string `json:"icon" binding:"omitempty,max=50"`
+}
+
+// UpdateAmenityRequest - the key is fixed, apps filter by it
+type UpdateAmenityRequest struct {
+	Name *string `json:"name" binding:"omitempty,min=1,max=100"`
+	Icon *string `json:"icon" binding:"omitempty,max=50"`
+}
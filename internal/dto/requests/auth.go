@@ -1,15 +1,23 @@
 package requests
 
+import (
+	"context"
+
+	"hopSpotAPI/internal/domain"
+)
+
 type RegisterRequest struct {
 	Email          string `json:"email" binding:"required,email,max=255"`
 	Password       string `json:"password" binding:"required,min=8,max=100"`
 	DisplayName    string `json:"display_name" binding:"required,min=1,max=100"`
 	InvitationCode string `json:"invitation_code" binding:"required"`
+	ClientInfo
 }
 
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
+	ClientInfo
 }
 
 type RefreshFCMTokenRequest struct {
@@ -18,8 +26,90 @@ type RefreshFCMTokenRequest struct {
 
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
+	ClientInfo
 }
 
 type LogoutRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
+
+// ClientInfo describes the device a session is created on.
+// UserAgent and IPAddress are taken from the request context by the handler.
+type ClientInfo struct {
+	DeviceName string `json:"device_name" binding:"omitempty,max=100"` // e.g. "Pixel 8"
+	Platform   string `json:"platform" binding:"omitempty,max=50"`     // e.g. "android", "ios"
+	UserAgent  string `json:"-"`
+	IPAddress  string `json:"-"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8,max=100"`
+}
+
+type ConfirmEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type LoginTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required,max=20"` // TOTP or recovery code
+	ClientInfo
+}
+
+// TwoFactorSetupRequest confirms with the password,
+// accounts without password (external login only) retype their email instead
+type TwoFactorSetupRequest struct {
+	Password string `json:"password"`
+	Email    string `json:"email"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required,max=20"`
+}
+
+// DisableTwoFactorRequest confirms like TwoFactorSetupRequest, plus a code
+type DisableTwoFactorRequest struct {
+	Password string `json:"password"`
+	Email    string `json:"email"`
+	Code     string `json:"code" binding:"required,max=20"` // TOTP or recovery code
+}
+
+// OIDCCredentials prove an external account, either by the authorization code of
+// the web flow or by an ID token a native sign-in SDK obtained
+type OIDCCredentials struct {
+	Code    string `json:"code" binding:"required_without=IDToken,max=2048"`
+	State   string `json:"state" binding:"required_with=Code,max=255"` // from the authorize step
+	IDToken string `json:"id_token" binding:"required_without=Code,max=8192"`
+	Nonce   string `json:"nonce" binding:"required_with=IDToken,max=255"` // from the nonce step, the ID token was requested with it
+}
+
+type OIDCLoginRequest struct {
+	OIDCCredentials
+	InvitationCode string `json:"invitation_code"`                          // only needed for new accounts
+	DisplayName    string `json:"display_name" binding:"omitempty,max=100"` // defaults to the name at the provider
+	ClientInfo
+}
+
+type CreatePersonalAccessTokenRequest struct {
+	Name          string         `json:"name" binding:"required,max=100"`
+	Scopes        []domain.Scope `json:"scopes" binding:"required,min=1"`
+	ExpiresInDays *int           `json:"expires_in_days" binding:"omitempty,min=1,max=365"` // omitted never expires
+}
+
+type clientInfoKey struct{}
+
+// ContextWithClientInfo stores the user agent and IP of the request, so services can record them without a parameter
+func ContextWithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientInfoFromContext returns the client info stored by the ClientInfo middleware, empty if there is none
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info
+}
@@ -0,0 +1,6 @@
+package requests
+
+type ListFriendsRequest struct {
+	Page  int `form:"page,default=1" binding:"min=1"`
+	Limit int `form:"limit,default=50" binding:"min=1,max=100"`
+}
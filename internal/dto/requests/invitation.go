@@ -0,0 +1,7 @@
+package requests
+
+// CreateInvitationRequest is an invitation of a regular user, it expires after the configured time
+type CreateInvitationRequest struct {
+	Comment string `json:"comment" binding:"max=255"`
+	MaxUses *int   `json:"max_uses" binding:"omitempty,min=1,max=100"` // omitted is single-use, counts against the quota
+}
@@ -0,0 +1,7 @@
+package requests
+
+type ListNotificationsRequest struct {
+	Page       int  `form:"page,default=1" binding:"min=1"`
+	Limit      int  `form:"limit,default=50" binding:"min=1,max=100"`
+	UnreadOnly bool `form:"unread_only"`
+}
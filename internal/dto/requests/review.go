@@ -0,0 +1,16 @@
+package requests
+
+type CreateReviewRequest struct {
+	Stars   int     `json:"stars" binding:"required,min=1,max=5"`
+	Comment *string `json:"comment" binding:"omitempty,max=2000"`
+}
+
+type UpdateReviewRequest struct {
+	Stars   *int    `json:"stars" binding:"omitempty,min=1,max=5"`
+	Comment *string `json:"comment" binding:"omitempty,max=2000"`
+}
+
+type ListReviewsRequest struct {
+	Page  int `form:"page,default=1" binding:"min=1"`
+	Limit int `form:"limit,default=50" binding:"min=1,max=100"`
+}
@@ -1,13 +1,15 @@
 package requests
 
 type CreateSpotRequest struct {
-	Name        string  `json:"name" binding:"required,min=1,max=255"`
-	Latitude    float64 `json:"latitude" binding:"required,min=-90,max=90"`
-	Longitude   float64 `json:"longitude" binding:"required,min=-180,max=180"`
-	Description *string `json:"description" binding:"omitempty,max=5000"`
-	Rating      *int    `json:"rating" binding:"omitempty,min=1,max=5"`
-	HasToilet   bool    `json:"has_toilet"`
-	HasTrashBin bool    `json:"has_trash_bin"`
+	Name        string   `json:"name" binding:"required,min=1,max=255"`
+	Latitude    float64  `json:"latitude" binding:"required,min=-90,max=90"`
+	Longitude   float64  `json:"longitude" binding:"required,min=-180,max=180"`
+	Description *string  `json:"description" binding:"omitempty,max=5000"`
+	Rating      *int     `json:"rating" binding:"omitempty,min=1,max=5"`                 // Stored as the creator's review
+	Amenities   []string `json:"amenities" binding:"omitempty,max=50,dive,min=1,max=50"` // Amenity keys
+	HasToilet   bool     `json:"has_toilet"`                                             // Deprecated: use amenities
+	HasTrashBin bool     `json:"has_trash_bin"`                                          // Deprecated: use amenities
+	Force       bool     `json:"-"`                                                      // Set from ?force=true, skips the duplicate check
 }
 
 type UpdateSpotRequest struct {
@@ -15,9 +17,10 @@ type UpdateSpotRequest struct {
 	Latitude    *float64 `json:"latitude" binding:"omitempty,min=-90,max=90"`
 	Longitude   *float64 `json:"longitude" binding:"omitempty,min=-180,max=180"`
 	Description *string  `json:"description" binding:"omitempty,max=5000"`
-	Rating      *int     `json:"rating" binding:"omitempty,min=1,max=5"`
-	HasToilet   *bool    `json:"has_toilet"`
-	HasTrashBin *bool    `json:"has_trash_bin"`
+	Amenities   []string `json:"amenities" binding:"omitempty,max=50,dive,min=1,max=50"` // Replaces all amenity keys if set
+	HasToilet   *bool    `json:"has_toilet"`                                             // Deprecated: use amenities
+	HasTrashBin *bool    `json:"has_trash_bin"`                                          // Deprecated: use amenities
+	Force       bool     `json:"-"`                                                      // Set from ?force=true, skips the duplicate check
 }
 
 type ListSpotsRequest struct {
@@ -25,11 +28,34 @@ type ListSpotsRequest struct {
 	Limit       int      `form:"limit,default=50" binding:"min=1,max=100"`
 	SortBy      string   `form:"sort_by,default=created_at" binding:"omitempty,oneof=name rating created_at distance"` // Supported sort values: distance
 	SortOrder   string   `form:"sort_order,default=desc" binding:"omitempty,oneof=asc desc"`
-	HasToilet   *bool    `form:"has_toilet"`
-	HasTrashBin *bool    `form:"has_trash_bin"`
-	MinRating   *int     `form:"min_rating" binding:"omitempty,min=1,max=5"`
+	Amenities   string   `form:"amenities"`                                  // Comma-separated amenity keys, spot must have all
+	HasToilet   *bool    `form:"has_toilet"`                                 // Deprecated: use amenities
+	HasTrashBin *bool    `form:"has_trash_bin"`                              // Deprecated: use amenities
+	MinRating   *int     `form:"min_rating" binding:"omitempty,min=1,max=5"` // Minimum average review rating
 	Search      string   `form:"search"`
 	Lat         *float64 `form:"lat"`
 	Lon         *float64 `form:"lon"`
 	Radius      *int     `form:"radius"` // in meters
+	Cursor      string   `form:"cursor"` // next_cursor of the previous page, replaces page
+}
+
+type NearestSpotsRequest struct {
+	Lat   *float64 `form:"lat" binding:"required,min=-90,max=90"`
+	Lon   *float64 `form:"lon" binding:"required,min=-180,max=180"`
+	Limit int      `form:"limit,default=10" binding:"min=1,max=50"`
+}
+
+type MapSpotsRequest struct {
+	BBox string `form:"bbox" binding:"required"` // minLon,minLat,maxLon,maxLat
+	Zoom int    `form:"zoom" binding:"min=0,max=22"`
+}
+
+type ExportSpotsRequest struct {
+	ListSpotsRequest
+	Format string `form:"format,default=geojson" binding:"omitempty,oneof=geojson gpx kml"`
+}
+
+type MergeSpotsRequest struct {
+	SourceID uint `json:"source_id" binding:"required"` // Deleted after the merge
+	TargetID uint `json:"target_id" binding:"required"` // Receives visits, photos, favorites, reviews and activities
 }
@@ -4,7 +4,22 @@ type UpdateProfileRequest struct {
 	DisplayName *string `json:"display_name" binding:"omitempty,min=1,max=100"`
 }
 
+// ChangeEmailRequest confirms with the password,
+// accounts without password (external login only) retype their current email instead
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email" binding:"required,email,max=255"`
+	Password string `json:"password"`
+	Email    string `json:"email"`
+}
+
 type ChangePasswordRequest struct {
 	OldPassword string `json:"old_password" binding:"required"`
 	NewPassword string `json:"new_password" binding:"required,min=8,max=100"`
 }
+
+// DeleteAccountRequest confirms the deletion with the password,
+// accounts without password (external login only) retype their email instead
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+	Email    string `json:"email"`
+}
@@ -8,16 +8,94 @@ type PaginatedUsersResponse struct {
 }
 
 type InvitationCodeResponse struct {
-	ID         uint          `json:"id"`
-	Code       string        `json:"code"`
-	Comment    string        `json:"comment,omitempty"`
-	CreatedBy  UserResponse  `json:"created_by"`
-	RedeemedBy *UserResponse `json:"redeemed_by,omitempty"`
-	CreatedAt  time.Time     `json:"created_at"`
-	RedeemedAt *time.Time    `json:"redeemed_at,omitempty"`
+	ID          uint                           `json:"id"`
+	Code        string                         `json:"code"`
+	Comment     string                         `json:"comment,omitempty"`
+	Role        *string                        `json:"role,omitempty"` // given to users registering with the code
+	MaxUses     int                            `json:"max_uses"`
+	UseCount    int                            `json:"use_count"`
+	ExpiresAt   *time.Time                     `json:"expires_at,omitempty"`
+	CreatedBy   UserResponse                   `json:"created_by"`
+	Redemptions []InvitationRedemptionResponse `json:"redemptions"`
+	RedeemedBy  *UserResponse                  `json:"redeemed_by,omitempty"` // Deprecated: first redemption
+	CreatedAt   time.Time                      `json:"created_at"`
+	RedeemedAt  *time.Time                     `json:"redeemed_at,omitempty"` // Deprecated: first redemption
+}
+
+type InvitationRedemptionResponse struct {
+	User       UserResponse `json:"user"`
+	RedeemedAt time.Time    `json:"redeemed_at"`
 }
 
 type PaginatedInvitationCodesResponse struct {
 	Codes      []InvitationCodeResponse `json:"codes"`
 	Pagination PaginationResponse       `json:"pagination"`
 }
+
+type InviteTreeResponse struct {
+	Roots []InviteTreeNodeResponse `json:"roots"`
+	Depth int                      `json:"depth"`
+}
+
+// InviteTreeUserResponse is a user and their place in the invitation tree
+type InviteTreeUserResponse struct {
+	ID          uint      `json:"id"`
+	Email       string    `json:"email"`
+	DisplayName string    `json:"display_name"`
+	Role        string    `json:"role"`
+	IsActive    bool      `json:"is_active"`
+	IsDeleted   bool      `json:"is_deleted"`
+	CreatedAt   time.Time `json:"created_at"`
+	InvitedBy   *uint     `json:"invited_by"`
+	Depth       int       `json:"depth"`
+}
+
+// InviteTreeNodeResponse is a user with the users they invited
+type InviteTreeNodeResponse struct {
+	InviteTreeUserResponse
+
+	SpotCount   int64 `json:"spot_count"`
+	PhotoCount  int64 `json:"photo_count"`
+	ReviewCount int64 `json:"review_count"`
+
+	Branch   InviteBranchResponse     `json:"branch"`
+	Invitees []InviteTreeNodeResponse `json:"invitees"`
+}
+
+// InviteBranchResponse sums up a user and everyone below them within the loaded depth
+type InviteBranchResponse struct {
+	Users       int   `json:"users"`
+	ActiveUsers int   `json:"active_users"`
+	Depth       int   `json:"depth"` // levels below the user
+	Spots       int64 `json:"spots"`
+	Photos      int64 `json:"photos"`
+	Reviews     int64 `json:"reviews"`
+}
+
+type DeactivateUsersResponse struct {
+	DryRun      bool                     `json:"dry_run"`
+	Deactivated []InviteTreeUserResponse `json:"deactivated"` // in a dry run the users that would be deactivated
+	Skipped     []InviteTreeUserResponse `json:"skipped"`     // admins below the root and the acting admin
+}
+
+type AuditEventResponse struct {
+	ID         uint                           `json:"id"`
+	Actor      *UserResponse                  `json:"actor,omitempty"` // omitted for events without logged in user
+	Action     string                         `json:"action"`
+	TargetType string                         `json:"target_type,omitempty"`
+	TargetID   *uint                          `json:"target_id,omitempty"`
+	Changes    map[string]AuditChangeResponse `json:"changes,omitempty"`
+	IPAddress  string                         `json:"ip_address,omitempty"`
+	UserAgent  string                         `json:"user_agent,omitempty"`
+	CreatedAt  time.Time                      `json:"created_at"`
+}
+
+type AuditChangeResponse struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type PaginatedAuditEventsResponse struct {
+	Events     []AuditEventResponse `json:"events"`
+	Pagination PaginationResponse   `json:"pagination"`
+}
@@ -0,0 +1,13 @@
+package responses
+
+type AmenityResponse struct {
+	ID   uint   `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Icon string `json:"icon,omitempty"`
+}
+
+// UnknownAmenitiesResponse lists the amenity keys that are not in the catalogue
+type UnknownAmenitiesResponse struct {
+	Keys []string `json:"keys"`
+}
@@ -11,10 +11,78 @@ type UserResponse struct {
 	Role        string    `json:"role"`
 	IsActive    bool      `json:"is_active"`
 	CreatedAt   time.Time `json:"created_at"`
+
+	EmailVerifiedAt  *time.Time `json:"email_verified_at"`
+	TwoFactorEnabled bool       `json:"two_factor_enabled"`
 }
 
 type LoginResponse struct {
 	User         UserResponse `json:"user"`
 	Token        string       `json:"token"`
 	RefreshToken string       `json:"refresh_token"`
+
+	// Set instead of the tokens when the password step of a login needs a 2FA code
+	TwoFactorRequired bool   `json:"two_factor_required,omitempty"`
+	ChallengeToken    string `json:"challenge_token,omitempty"`
+}
+
+type TwoFactorSetupResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// URI, rendered as QR code by the app
+}
+
+// RecoveryCodesResponse is the only time the recovery codes are shown
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// SessionResponse is a device the user is logged in on
+type SessionResponse struct {
+	ID         uint      `json:"id"`
+	DeviceName string    `json:"device_name"`
+	Platform   string    `json:"platform"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"` // session of the requesting access token
+}
+
+type OIDCProviderResponse struct {
+	Name string `json:"name"`
+}
+
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorization_url"` // opened in the browser
+	State            string `json:"state"`             // returned with the code to the redirect URL
+}
+
+// OIDCNonceResponse is the nonce a native sign-in SDK requests the ID token with
+type OIDCNonceResponse struct {
+	Nonce string `json:"nonce"` // sent back with the ID token, valid once
+}
+
+// ExternalIdentityResponse is an account at an OpenID Connect provider linked to the user
+type ExternalIdentityResponse struct {
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+// PersonalAccessTokenResponse describes a token, the secret is only part of the creation response
+type PersonalAccessTokenResponse struct {
+	ID          uint       `json:"id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// CreatedPersonalAccessTokenResponse is the only time the token is shown
+type CreatedPersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	Token string `json:"token"`
 }
@@ -9,14 +9,17 @@ type FavoriteResponse struct {
 }
 
 type FavoriteSpotResponse struct {
-	ID           uint    `json:"id"`
-	Name         string  `json:"name"`
-	Latitude     float64 `json:"latitude"`
-	Longitude    float64 `json:"longitude"`
-	Rating       *int    `json:"rating,omitempty"`
-	HasToilet    bool    `json:"has_toilet"`
-	HasTrashBin  bool    `json:"has_trash_bin"`
-	MainPhotoURL *string `json:"main_photo_url,omitempty"`
+	ID            uint     `json:"id"`
+	Name          string   `json:"name"`
+	Latitude      float64  `json:"latitude"`
+	Longitude     float64  `json:"longitude"`
+	Rating        *int     `json:"rating,omitempty"` // Rounded average, kept for older app versions
+	AverageRating *float64 `json:"average_rating,omitempty"`
+	ReviewCount   int64    `json:"review_count"`
+	Amenities     []string `json:"amenities"`     // Amenity keys
+	HasToilet     bool     `json:"has_toilet"`    // Deprecated: computed from amenities
+	HasTrashBin   bool     `json:"has_trash_bin"` // Deprecated: computed from amenities
+	MainPhotoURL  *string  `json:"main_photo_url,omitempty"`
 }
 
 type PaginatedFavoritesResponse struct {
@@ -0,0 +1,32 @@
+package responses
+
+import "time"
+
+// FollowUserResponse is the public part of a user in the friend graph
+type FollowUserResponse struct {
+	ID          uint   `json:"id"`
+	DisplayName string `json:"display_name"`
+}
+
+// FollowResponse is a follow edge, e.g. a friend request
+type FollowResponse struct {
+	ID        uint               `json:"id"`
+	User      FollowUserResponse `json:"user"`   // the other user
+	Status    string             `json:"status"` // pending, accepted
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type FriendResponse struct {
+	User  FollowUserResponse `json:"user"`
+	Since time.Time          `json:"since"`
+}
+
+type PaginatedFriendsResponse struct {
+	Friends    []FriendResponse   `json:"friends"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+type PaginatedFollowRequestsResponse struct {
+	Requests   []FollowResponse   `json:"requests"`
+	Pagination PaginationResponse `json:"pagination"`
+}
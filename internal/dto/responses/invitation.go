@@ -0,0 +1,27 @@
+package responses
+
+import "time"
+
+// InvitationResponse is an invitation code issued by the requesting user.
+// Invited users are only shown with their public profile.
+type InvitationResponse struct {
+	ID           uint                 `json:"id"`
+	Code         string               `json:"code"`
+	Comment      string               `json:"comment,omitempty"`
+	MaxUses      int                  `json:"max_uses"`
+	UseCount     int                  `json:"use_count"`
+	ExpiresAt    *time.Time           `json:"expires_at,omitempty"`
+	InvitedUsers []FollowUserResponse `json:"invited_users"`
+	CreatedAt    time.Time            `json:"created_at"`
+}
+
+type InvitationQuotaResponse struct {
+	Limit     *int  `json:"limit"` // nil for unlimited (admins)
+	Used      int64 `json:"used"`
+	Remaining *int  `json:"remaining"`
+}
+
+type UserInvitationsResponse struct {
+	Invitations []InvitationResponse    `json:"invitations"`
+	Quota       InvitationQuotaResponse `json:"quota"`
+}
@@ -0,0 +1,24 @@
+package responses
+
+import "time"
+
+type NotificationResponse struct {
+	ID            uint       `json:"id"`
+	Type          string     `json:"type"`
+	Title         string     `json:"title"`
+	Message       string     `json:"message"`
+	RelatedSpotID *uint      `json:"related_spot_id,omitempty"`
+	RelatedUserID *uint      `json:"related_user_id,omitempty"`
+	IsRead        bool       `json:"is_read"`
+	SentAt        time.Time  `json:"sent_at"`
+	ReadAt        *time.Time `json:"read_at,omitempty"`
+}
+
+type PaginatedNotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Pagination    PaginationResponse     `json:"pagination"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCount int64 `json:"unread_count"`
+}
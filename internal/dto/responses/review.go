@@ -0,0 +1,23 @@
+package responses
+
+import "time"
+
+type ReviewResponse struct {
+	ID        uint               `json:"id"`
+	SpotID    uint               `json:"spot_id"`
+	User      ReviewUserResponse `json:"user"`
+	Stars     int                `json:"stars"`
+	Comment   string             `json:"comment,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type ReviewUserResponse struct {
+	ID          uint   `json:"id"`
+	DisplayName string `json:"display_name"`
+}
+
+type PaginatedReviewsResponse struct {
+	Reviews    []ReviewResponse   `json:"reviews"`
+	Pagination PaginationResponse `json:"pagination"`
+}
@@ -11,33 +11,105 @@ type PaginationResponse struct {
 }
 
 type SpotResponse struct {
-	ID           uint         `json:"id"`
-	Name         string       `json:"name"`
-	Latitude     float64      `json:"latitude"`
-	Longitude    float64      `json:"longitude"`
-	Description  *string      `json:"description,omitempty"`
-	Rating       *int         `json:"rating,omitempty"`
-	HasToilet    bool         `json:"has_toilet"`
-	HasTrashBin  bool         `json:"has_trash_bin"`
-	MainPhotoURL *string      `json:"main_photo_url,omitempty"`
-	CreatedBy    UserResponse `json:"created_by"`
-	CreatedAt    time.Time    `json:"created_at"`
-	UpdatedAt    time.Time    `json:"updated_at"`
+	ID            uint              `json:"id"`
+	Name          string            `json:"name"`
+	Latitude      float64           `json:"latitude"`
+	Longitude     float64           `json:"longitude"`
+	Description   *string           `json:"description,omitempty"`
+	Rating        *int              `json:"rating,omitempty"` // Rounded average, kept for older app versions
+	AverageRating *float64          `json:"average_rating,omitempty"`
+	ReviewCount   int64             `json:"review_count"`
+	Amenities     []AmenityResponse `json:"amenities"`
+	HasToilet     bool              `json:"has_toilet"`    // Deprecated: computed from amenities
+	HasTrashBin   bool              `json:"has_trash_bin"` // Deprecated: computed from amenities
+	MainPhotoURL  *string           `json:"main_photo_url,omitempty"`
+	CreatedBy     UserResponse      `json:"created_by"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
 }
 
 type SpotListResponse struct {
-	ID           uint     `json:"id"`
-	Name         string   `json:"name"`
-	Latitude     float64  `json:"latitude"`
-	Longitude    float64  `json:"longitude"`
-	Rating       *int     `json:"rating,omitempty"`
-	HasToilet    bool     `json:"has_toilet"`
-	HasTrashBin  bool     `json:"has_trash_bin"`
-	MainPhotoURL *string  `json:"main_photo_url,omitempty"`
-	Distance     *float64 `json:"distance,omitempty"` // Falls Koordinaten mitgegeben
+	ID            uint     `json:"id"`
+	Name          string   `json:"name"`
+	Latitude      float64  `json:"latitude"`
+	Longitude     float64  `json:"longitude"`
+	Rating        *int     `json:"rating,omitempty"` // Rounded average, kept for older app versions
+	AverageRating *float64 `json:"average_rating,omitempty"`
+	ReviewCount   int64    `json:"review_count"`
+	Amenities     []string `json:"amenities"`     // Amenity keys
+	HasToilet     bool     `json:"has_toilet"`    // Deprecated: computed from amenities
+	HasTrashBin   bool     `json:"has_trash_bin"` // Deprecated: computed from amenities
+	MainPhotoURL  *string  `json:"main_photo_url,omitempty"`
+	Distance      *float64 `json:"distance,omitempty"` // Falls Koordinaten mitgegeben
 }
 
 type PaginatedSpotsResponse struct {
 	Spots      []SpotListResponse `json:"spots"`
 	Pagination PaginationResponse `json:"pagination"`
+	NextCursor *string            `json:"next_cursor,omitempty"` // Set if more spots may follow
+}
+
+// DuplicateSpotsResponse lists the existing spots that block a create/update
+type DuplicateSpotsResponse struct {
+	Radius float64                 `json:"radius"` // in meters
+	Spots  []DuplicateSpotResponse `json:"spots"`
+}
+
+type DuplicateSpotResponse struct {
+	ID        uint    `json:"id"`
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Distance  float64 `json:"distance"` // in meters
+}
+
+// SpotMapResponse contains either clusters (low zoom) or markers (high zoom)
+type SpotMapResponse struct {
+	Mode      string                `json:"mode"` // clusters, markers
+	Zoom      int                   `json:"zoom"`
+	Clusters  []SpotClusterResponse `json:"clusters,omitempty"`
+	Markers   []SpotMarkerResponse  `json:"markers,omitempty"`
+	Truncated bool                  `json:"truncated,omitempty"` // More markers than the limit in the viewport
+}
+
+type SpotClusterResponse struct {
+	Count     int64   `json:"count"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	SpotID    *uint   `json:"spot_id,omitempty"` // Falls nur ein Spot im Cluster
+}
+
+type SpotMarkerResponse struct {
+	ID            uint     `json:"id"`
+	Latitude      float64  `json:"latitude"`
+	Longitude     float64  `json:"longitude"`
+	ThumbnailURL  *string  `json:"thumbnail_url,omitempty"`
+	AverageRating *float64 `json:"average_rating,omitempty"`
+}
+
+// Import result per feature
+const (
+	ImportStatusCreated   = "created"
+	ImportStatusDuplicate = "duplicate"
+	ImportStatusInvalid   = "invalid"
+	ImportStatusFailed    = "failed"
+)
+
+type ImportSpotsResponse struct {
+	Format     string                `json:"format"`
+	Total      int                   `json:"total"`
+	Created    int                   `json:"created"`
+	Duplicates int                   `json:"duplicates"`
+	Invalid    int                   `json:"invalid"`
+	Failed     int                   `json:"failed"`
+	Results    []ImportFeatureResult `json:"results"`
+}
+
+type ImportFeatureResult struct {
+	Index       int    `json:"index"`
+	Name        string `json:"name"`
+	Status      string `json:"status"` // created, duplicate, invalid, failed
+	SpotID      *uint  `json:"spot_id,omitempty"`
+	DuplicateOf *uint  `json:"duplicate_of,omitempty"`
+	Message     string `json:"message,omitempty"`
 }
@@ -0,0 +1,68 @@
+package responses
+
+import "time"
+
+// PublicProfileResponse is the profile other users see - never contains email or role
+type PublicProfileResponse struct {
+	ID               uint               `json:"id"`
+	DisplayName      string             `json:"display_name"`
+	MemberSince      time.Time          `json:"member_since"`
+	Stats            UserStatsResponse  `json:"stats"`
+	RecentActivities []ActivityResponse `json:"recent_activities"`
+}
+
+type UserStatsResponse struct {
+	SpotsCreated int64 `json:"spots_created"`
+	VisitsLogged int64 `json:"visits_logged"`
+	SpotsVisited int64 `json:"spots_visited"` // distinct spots
+	Favorites    int64 `json:"favorites"`
+}
+
+// AccountExportResponse is the data.json of the personal data export.
+// The original images of the photos are in the archive as photos/<id>.jpg.
+type AccountExportResponse struct {
+	ExportedAt         time.Time                     `json:"exported_at"`
+	Profile            UserResponse                  `json:"profile"`
+	Spots              []SpotResponse                `json:"spots"`
+	Photos             []PhotoResponse               `json:"photos"`
+	Visits             []VisitResponse               `json:"visits"`
+	Favorites          []ExportFavoriteResponse      `json:"favorites"`
+	Reviews            []ReviewResponse              `json:"reviews"`
+	Activities         []ActivityResponse            `json:"activities"`
+	Notifications      []NotificationResponse        `json:"notifications"`
+	Follows            []ExportFollowResponse        `json:"follows"`
+	Sessions           []SessionResponse             `json:"sessions"`
+	ExternalIdentities []ExternalIdentityResponse    `json:"external_identities"`
+	AccessTokens       []PersonalAccessTokenResponse `json:"access_tokens"`
+	SecurityEvents     []ExportSecurityEventResponse `json:"security_events"`
+	InvitationCodes    []ExportInvitationResponse    `json:"invitation_codes"`
+}
+
+type ExportFavoriteResponse struct {
+	SpotID    uint      `json:"spot_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ExportFollowResponse struct {
+	FollowerID uint      `json:"follower_id"`
+	FolloweeID uint      `json:"followee_id"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ExportSecurityEventResponse struct {
+	Type      string    `json:"type"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Details   string    `json:"details,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportInvitationResponse leaves out who redeemed the code, that is data of other users
+type ExportInvitationResponse struct {
+	Code      string    `json:"code"`
+	Comment   string    `json:"comment,omitempty"`
+	MaxUses   int       `json:"max_uses"`
+	UseCount  int       `json:"use_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
 	"hopSpotAPI/internal/service"
 	"hopSpotAPI/pkg/apperror"
 
@@ -29,6 +30,7 @@ func NewActivityHandler(activityService service.ActivityService) *ActivityHandle
 //	@Param			page		query		int		false	"Page number"
 //	@Param			limit		query		int		false	"Number of items per page"
 //	@Param			action_type	query		string	false	"Filter by action type (bench_created, visit_added, favorite_added)"
+//	@Param			scope		query		string	false	"Feed scope (all, friends)"
 //	@Success		200			{object}	responses.PaginatedActivitiesResponse
 //	@Failure		400			{object}	apperror.ErrorResponse
 //	@Router			/api/v1/activities [get]
@@ -39,6 +41,12 @@ func (h *ActivityHandler) List(c *gin.Context) {
 		return
 	}
 
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
 	// Defaults
 	if req.Page <= 0 {
 		req.Page = 1
@@ -47,7 +55,7 @@ func (h *ActivityHandler) List(c *gin.Context) {
 		req.Limit = 50
 	}
 
-	response, err := h.activityService.List(c.Request.Context(), &req)
+	response, err := h.activityService.List(c.Request.Context(), userID, &req)
 	if err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
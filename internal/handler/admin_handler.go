@@ -34,6 +34,7 @@ func NewAdminHandler(adminService service.AdminService) *AdminHandler {
 //	@Param			search		query		string	false	"Search term for username or email"
 //	@Param			is_active	query		bool	false	"Filter by active status"
 //	@Param			is_admin	query		bool	false	"Filter by admin status"
+//	@Param			email_verified	query	bool	false	"Filter by email verification status"
 //	@Success		200			{object}	responses.PaginatedUsersResponse
 //	@Failure		400			{object}	apperror.ErrorResponse
 //	@Router			/api/v1/admin/users [get]
@@ -76,6 +77,11 @@ func (h *AdminHandler) ListUsers(c *gin.Context) {
 //	@Failure		404		{object}	apperror.ErrorResponse
 //	@Router			/api/v1/admin/users/{id} [patch]
 func (h *AdminHandler) UpdateUser(c *gin.Context) {
+	adminID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
@@ -88,7 +94,7 @@ func (h *AdminHandler) UpdateUser(c *gin.Context) {
 		return
 	}
 
-	user, err := h.adminService.UpdateUser(c.Request.Context(), uint(id), &req)
+	user, err := h.adminService.UpdateUser(c.Request.Context(), uint(id), adminID, &req)
 	if err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
@@ -138,6 +144,158 @@ func (h *AdminHandler) DeleteUser(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// POST /api/v1/admin/users/:id/unlock
+// UnlockUser godoc
+//
+//	@Summary		Unlock a user
+//	@Description	Clears failed logins and a temporary lock of the account
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id	path	int	true	"User ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse
+//	@Failure		404	{object}	apperror.ErrorResponse
+//	@Router			/api/v1/admin/users/{id}/unlock [post]
+func (h *AdminHandler) UnlockUser(c *gin.Context) {
+	adminID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.adminService.UnlockUser(c.Request.Context(), uint(id), adminID); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	// Audit Log
+	logger.Info().
+		Uint("admin_id", adminID).
+		Uint("unlocked_user_id", uint(id)).
+		Msg("User unlocked by admin")
+
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/v1/admin/users/:id/deactivate
+// DeactivateUser godoc
+//
+//	@Summary		Deactivate a user and their invitees
+//	@Description	Deactivates the user and everyone they invited, with recursive also the invitees of the invitees.
+//	@Description	Admins below the user are skipped. A dry run only lists the affected users.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int								true	"User ID"
+//	@Param			deactivate	body		requests.DeactivateUserRequest	true	"Cascade options"
+//	@Success		200			{object}	responses.DeactivateUsersResponse
+//	@Failure		400			{object}	apperror.ErrorResponse
+//	@Failure		404			{object}	apperror.ErrorResponse
+//	@Router			/api/v1/admin/users/{id}/deactivate [post]
+func (h *AdminHandler) DeactivateUser(c *gin.Context) {
+	adminID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	var req requests.DeactivateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	result, err := h.adminService.DeactivateUser(c.Request.Context(), uint(id), adminID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	// Audit Log
+	if !req.DryRun {
+		logger.Info().
+			Uint("admin_id", adminID).
+			Uint("root_user_id", uint(id)).
+			Bool("recursive", req.Recursive).
+			Int("deactivated", len(result.Deactivated)).
+			Msg("Invite tree deactivated by admin")
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GET /api/v1/admin/users/:id/invite-tree
+// GetUserInviteTree godoc
+//
+//	@Summary		Get the invite tree of a user
+//	@Description	Returns the user, who invited them and everyone they invited with the activity of each branch
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			id		path		int	true	"User ID"
+//	@Param			depth	query		int	false	"Levels below the user (1-10)"	default(3)
+//	@Success		200		{object}	responses.InviteTreeNodeResponse
+//	@Failure		400		{object}	apperror.ErrorResponse
+//	@Failure		404		{object}	apperror.ErrorResponse
+//	@Router			/api/v1/admin/users/{id}/invite-tree [get]
+func (h *AdminHandler) GetUserInviteTree(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	var req requests.InviteTreeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	result, err := h.adminService.GetUserInviteTree(c.Request.Context(), uint(id), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GET /api/v1/admin/invite-tree
+// GetInviteTree godoc
+//
+//	@Summary		Get the invite tree
+//	@Description	Returns all users without inviter and who they invited, with the activity of each branch
+//	@Tags			Admin
+//	@Produce		json
+//	@Param			depth	query		int	false	"Levels below the roots (1-10)"	default(3)
+//	@Success		200		{object}	responses.InviteTreeResponse
+//	@Failure		400		{object}	apperror.ErrorResponse
+//	@Router			/api/v1/admin/invite-tree [get]
+func (h *AdminHandler) GetInviteTree(c *gin.Context) {
+	var req requests.InviteTreeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	result, err := h.adminService.GetInviteTree(c.Request.Context(), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // GET /api/v1/admin/invitation-codes
 // ListInvitationCodes godoc
 //
@@ -221,13 +379,18 @@ func (h *AdminHandler) CreateInvitationCode(c *gin.Context) {
 //	@Failure		404	{object}	apperror.ErrorResponse
 //	@Router			/api/v1/admin/invitation-codes/{id} [delete]
 func (h *AdminHandler) DeleteInvitationCode(c *gin.Context) {
+	adminID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
 		return
 	}
 
-	err = h.adminService.DeleteInvitationCode(c.Request.Context(), uint(id))
+	err = h.adminService.DeleteInvitationCode(c.Request.Context(), uint(id), adminID)
 	if err != nil {
 		logger.Error().Err(err).Uint("code_id", uint(id)).Msg("Failed to delete invitation code")
 		apperror.RespondWithMappedError(c, err)
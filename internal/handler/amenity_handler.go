@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AmenityHandler struct {
+	amenityService service.AmenityService
+}
+
+func NewAmenityHandler(amenityService service.AmenityService) *AmenityHandler {
+	return &AmenityHandler{amenityService: amenityService}
+}
+
+// GET /api/v1/amenities
+// ListAmenities godoc
+//
+//	@Summary		List amenities
+//	@Description	Get the amenity catalogue that spots can be tagged with
+//	@Tags			Amenities
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{array}		responses.AmenityResponse
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/amenities [get]
+func (h *AmenityHandler) List(c *gin.Context) {
+	amenities, err := h.amenityService.List(c.Request.Context())
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, amenities)
+}
+
+// POST /api/v1/admin/amenities
+// CreateAmenity godoc
+//
+//	@Summary		Create an amenity (Admin only)
+//	@Description	Add an amenity to the catalogue. The key is used for filtering and cannot be changed later.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			amenity	body		requests.CreateAmenityRequest	true	"Amenity payload"
+//	@Success		201		{object}	responses.AmenityResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		409		{object}	apperror.ErrorResponse	"Key already exists"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/admin/amenities [post]
+func (h *AmenityHandler) Create(c *gin.Context) {
+	var req requests.CreateAmenityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	result, err := h.amenityService.Create(c.Request.Context(), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// PATCH /api/v1/admin/amenities/:id
+// UpdateAmenity godoc
+//
+//	@Summary		Update an amenity (Admin only)
+//	@Description	Change name or icon of an amenity
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Amenity ID"
+//	@Param			amenity	body		requests.UpdateAmenityRequest	true	"Amenity update payload"
+//	@Success		200		{object}	responses.AmenityResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		404		{object}	apperror.ErrorResponse	"Amenity not found"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/admin/amenities/{id} [patch]
+func (h *AmenityHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	var req requests.UpdateAmenityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	result, err := h.amenityService.Update(c.Request.Context(), uint(id), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DELETE /api/v1/admin/amenities/:id
+// DeleteAmenity godoc
+//
+//	@Summary		Delete an amenity (Admin only)
+//	@Description	Remove an amenity from the catalogue and from all spots
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Amenity ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid ID or protected amenity"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Amenity not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/admin/amenities/{id} [delete]
+func (h *AmenityHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.amenityService.Delete(c.Request.Context(), uint(id)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type AuditHandler struct {
+	auditService service.AuditService
+}
+
+func NewAuditHandler(auditService service.AuditService) *AuditHandler {
+	return &AuditHandler{auditService: auditService}
+}
+
+// GET /api/v1/admin/audit
+// List godoc
+//
+//	@Summary		List audit events
+//	@Description	Get a paginated list of admin actions, moderation of foreign content and auth events, newest first
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page		query		int		false	"Page number"				default(1)
+//	@Param			limit		query		int		false	"Number of events per page"	default(50)
+//	@Param			actor_id	query		int		false	"Filter by acting user"
+//	@Param			target_type	query		string	false	"Filter by target type"	Enums(user, invitation_code, spot, photo)
+//	@Param			target_id	query		int		false	"Filter by target ID"
+//	@Param			action		query		string	false	"Filter by action, e.g. user.updated"
+//	@Param			from		query		string	false	"Events at or after this time (RFC 3339)"
+//	@Param			to			query		string	false	"Events before this time (RFC 3339)"
+//	@Success		200			{object}	responses.PaginatedAuditEventsResponse
+//	@Failure		400			{object}	apperror.ErrorResponse
+//	@Failure		403			{object}	apperror.ErrorResponse	"Missing permission"
+//	@Router			/api/v1/admin/audit [get]
+func (h *AuditHandler) List(c *gin.Context) {
+	var req requests.ListAuditEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	events, err := h.auditService.List(c.Request.Context(), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
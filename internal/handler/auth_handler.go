@@ -2,10 +2,12 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
 	"hopSpotAPI/internal/service"
 	"hopSpotAPI/pkg/apperror"
 )
@@ -37,6 +39,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
 		return
 	}
+	setClientInfo(c, &req.ClientInfo)
 
 	result, err := h.authService.Register(c.Request.Context(), &req)
 	if err != nil {
@@ -51,7 +54,8 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // Login godoc
 //
 //	@Summary		Login a user
-//	@Description	Authenticates a user and returns access and refresh tokens
+//	@Description	Authenticates a user and returns access and refresh tokens.
+//	@Description	With 2FA enabled only a challenge token is returned, see /api/v1/auth/login/2fa.
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
@@ -67,6 +71,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
 		return
 	}
+	setClientInfo(c, &req.ClientInfo)
 
 	result, err := h.authService.Login(c.Request.Context(), &req)
 	if err != nil {
@@ -77,6 +82,37 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// POST /api/v1/auth/login/2fa
+// LoginTwoFactor godoc
+//
+//	@Summary		Complete a 2FA login
+//	@Description	Exchanges the challenge token from login and a TOTP or recovery code for access and refresh tokens
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			loginTwoFactorRequest	body		requests.LoginTwoFactorRequest	true	"Login 2FA Request"
+//	@Success		200						{object}	responses.LoginResponse
+//	@Failure		400						{object}	apperror.ErrorResponse
+//	@Failure		401						{object}	apperror.ErrorResponse	"Invalid code or challenge"
+//	@Failure		403						{object}	apperror.ErrorResponse
+//	@Router			/api/v1/auth/login/2fa [post]
+func (h *AuthHandler) LoginTwoFactor(c *gin.Context) {
+	var req requests.LoginTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+	setClientInfo(c, &req.ClientInfo)
+
+	result, err := h.authService.LoginTwoFactor(c.Request.Context(), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // POST /api/v1/auth/refresh-fcm-token
 // RefreshFCMToken godoc
 //
@@ -139,6 +175,7 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
 		return
 	}
+	setClientInfo(c, &req.ClientInfo)
 
 	result, err := h.authService.Refresh(c.Request.Context(), &req)
 	if err != nil {
@@ -176,3 +213,100 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// GET /api/v1/auth/sessions
+// ListSessions godoc
+//
+//	@Summary		List sessions
+//	@Description	Lists the devices the current user is logged in on
+//	@Tags			Auth
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{array}		responses.SessionResponse
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	sessions, err := h.authService.ListSessions(c.Request.Context(), userID, c.GetUint(middleware.ContextKeySessionID))
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// DELETE /api/v1/auth/sessions/:id
+// RevokeSession godoc
+//
+//	@Summary		Revoke session
+//	@Description	Logs the current user out on one device. All access tokens are revoked,
+//	@Description	the other devices get a new one by refreshing.
+//	@Tags			Auth
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Session ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid session ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Session not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	sessionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.authService.RevokeSession(c.Request.Context(), userID, uint(sessionID)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DELETE /api/v1/auth/sessions
+// RevokeOtherSessions godoc
+//
+//	@Summary		Log out everywhere else
+//	@Description	Revokes all sessions of the current user except the one of the access token
+//	@Tags			Auth
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/auth/sessions [delete]
+func (h *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	if err := h.authService.RevokeOtherSessions(c.Request.Context(), userID, c.GetUint(middleware.ContextKeySessionID)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// setClientInfo fills the session information that is not part of the request body
+func setClientInfo(c *gin.Context, info *requests.ClientInfo) {
+	client := requests.ClientInfoFromContext(c.Request.Context())
+	info.UserAgent = client.UserAgent
+	info.IPAddress = client.IPAddress
+}
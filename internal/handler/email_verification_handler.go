@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type EmailVerificationHandler struct {
+	emailVerificationService service.EmailVerificationService
+}
+
+func NewEmailVerificationHandler(emailVerificationService service.EmailVerificationService) *EmailVerificationHandler {
+	return &EmailVerificationHandler{emailVerificationService: emailVerificationService}
+}
+
+// POST /api/v1/auth/email/confirm
+// ConfirmEmail godoc
+//
+//	@Summary		Confirm email address
+//	@Description	Confirms the address with a token from a verification or email change mail. A change also notifies the old address.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			confirmEmailRequest	body		requests.ConfirmEmailRequest	true	"Confirm Email Request"
+//	@Success		200					{object}	responses.UserResponse
+//	@Failure		400					{object}	apperror.ErrorResponse	"Invalid request or token"
+//	@Failure		409					{object}	apperror.ErrorResponse	"Email already taken"
+//	@Failure		429					{object}	apperror.ErrorResponse
+//	@Router			/api/v1/auth/email/confirm [post]
+func (h *EmailVerificationHandler) ConfirmEmail(c *gin.Context) {
+	var req requests.ConfirmEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	response, err := h.emailVerificationService.ConfirmEmail(c.Request.Context(), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/users/me/email/verification
+// ResendVerification godoc
+//
+//	@Summary		Resend verification mail
+//	@Description	Sends a new confirmation link for the current email address, older links stop working
+//	@Tags			Users
+//	@Security		BearerAuth
+//	@Success		202	"Accepted"
+//	@Failure		401	{object}	apperror.ErrorResponse
+//	@Failure		409	{object}	apperror.ErrorResponse	"Email already verified"
+//	@Router			/api/v1/users/me/email/verification [post]
+func (h *EmailVerificationHandler) ResendVerification(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	if err := h.emailVerificationService.SendVerification(c.Request.Context(), userID); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// POST /api/v1/users/me/email
+// RequestEmailChange godoc
+//
+//	@Summary		Change email address
+//	@Description	Sends a confirmation link to the new address. The email is only changed after confirmation.
+//	@Tags			Users
+//	@Accept			json
+//	@Security		BearerAuth
+//	@Param			changeEmailRequest	body	requests.ChangeEmailRequest	true	"New address, confirmed with the password or the current email for accounts without password"
+//	@Success		202					"Accepted"
+//	@Failure		400					{object}	apperror.ErrorResponse
+//	@Failure		401					{object}	apperror.ErrorResponse	"Unauthorized or wrong password"
+//	@Failure		409					{object}	apperror.ErrorResponse	"Email already taken"
+//	@Router			/api/v1/users/me/email [post]
+func (h *EmailVerificationHandler) RequestEmailChange(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	if err := h.emailVerificationService.RequestEmailChange(c.Request.Context(), userID, &req); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
@@ -0,0 +1,327 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FollowHandler struct {
+	followService service.FollowService
+}
+
+func NewFollowHandler(followService service.FollowService) *FollowHandler {
+	return &FollowHandler{followService: followService}
+}
+
+// POST /api/v1/users/:id/follow
+// Follow godoc
+//
+//	@Summary		Send friend request
+//	@Description	Send a friend request to a user. If the user already sent a request, it is accepted.
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"User ID"
+//	@Success		201	{object}	responses.FollowResponse
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid user ID or own user"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	apperror.ErrorResponse	"Blocked"
+//	@Failure		404	{object}	apperror.ErrorResponse	"User not found"
+//	@Failure		409	{object}	apperror.ErrorResponse	"Already friends or request pending"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/users/{id}/follow [post]
+func (h *FollowHandler) Follow(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	targetID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	response, err := h.followService.Follow(c.Request.Context(), userID, uint(targetID))
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// DELETE /api/v1/users/:id/follow
+// Unfollow godoc
+//
+//	@Summary		Remove friend
+//	@Description	End a friendship or withdraw a pending friend request
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"User ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid user ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Follow not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/users/{id}/follow [delete]
+func (h *FollowHandler) Unfollow(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	targetID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.followService.Unfollow(c.Request.Context(), userID, uint(targetID)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/v1/users/:id/block
+// Block godoc
+//
+//	@Summary		Block user
+//	@Description	Block a user. Removes an existing friendship and pending requests in both directions.
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"User ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid user ID or own user"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"User not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/users/{id}/block [post]
+func (h *FollowHandler) Block(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	targetID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.followService.Block(c.Request.Context(), userID, uint(targetID)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DELETE /api/v1/users/:id/block
+// Unblock godoc
+//
+//	@Summary		Unblock user
+//	@Description	Remove a block on a user
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"User ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid user ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Block not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/users/{id}/block [delete]
+func (h *FollowHandler) Unblock(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	targetID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.followService.Unblock(c.Request.Context(), userID, uint(targetID)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GET /api/v1/friends
+// ListFriends godoc
+//
+//	@Summary		List friends
+//	@Description	Get a paginated list of the current user's friends
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number"
+//	@Param			limit	query		int	false	"Number of items per page"
+//	@Success		200		{object}	responses.PaginatedFriendsResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Invalid request"
+//	@Failure		401		{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/friends [get]
+func (h *FollowHandler) ListFriends(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.ListFriendsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	// Defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	response, err := h.followService.ListFriends(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GET /api/v1/friends/requests
+// ListRequests godoc
+//
+//	@Summary		List friend requests
+//	@Description	Get a paginated list of pending friend requests sent to the current user
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number"
+//	@Param			limit	query		int	false	"Number of items per page"
+//	@Success		200		{object}	responses.PaginatedFollowRequestsResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Invalid request"
+//	@Failure		401		{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/friends/requests [get]
+func (h *FollowHandler) ListRequests(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.ListFriendsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	// Defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	response, err := h.followService.ListRequests(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/friends/requests/:id/accept
+// AcceptRequest godoc
+//
+//	@Summary		Accept friend request
+//	@Description	Accept a pending friend request sent to the current user
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Request ID"
+//	@Success		200	{object}	responses.FollowResponse
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid request ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Request not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/friends/requests/{id}/accept [post]
+func (h *FollowHandler) AcceptRequest(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	requestID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	response, err := h.followService.AcceptRequest(c.Request.Context(), userID, uint(requestID))
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/friends/requests/:id/decline
+// DeclineRequest godoc
+//
+//	@Summary		Decline friend request
+//	@Description	Decline a pending friend request sent to the current user
+//	@Tags			Friends
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Request ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid request ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Request not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/friends/requests/{id}/decline [post]
+func (h *FollowHandler) DeclineRequest(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	requestID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.followService.DeclineRequest(c.Request.Context(), userID, uint(requestID)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
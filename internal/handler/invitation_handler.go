@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type InvitationHandler struct {
+	invitationService service.InvitationService
+}
+
+func NewInvitationHandler(invitationService service.InvitationService) *InvitationHandler {
+	return &InvitationHandler{invitationService: invitationService}
+}
+
+// GET /api/v1/invitations
+// List godoc
+//
+//	@Summary		List own invitations
+//	@Description	Returns the invitation codes created by the current user and the remaining quota
+//	@Tags			Invitations
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	responses.UserInvitationsResponse
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	apperror.ErrorResponse	"Login session required"
+//	@Router			/api/v1/invitations [get]
+func (h *InvitationHandler) List(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	invitations, err := h.invitationService.List(c.Request.Context(), userID)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+// POST /api/v1/invitations
+// Create godoc
+//
+//	@Summary		Invite friends
+//	@Description	Creates an invitation code that expires after the configured time.
+//	@Description	Every use of the code counts against the invitation quota, admins have no quota.
+//	@Tags			Invitations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			createInvitationRequest	body		requests.CreateInvitationRequest	true	"Comment and uses"
+//	@Success		201						{object}	responses.InvitationResponse
+//	@Failure		400						{object}	apperror.ErrorResponse	"Invalid request"
+//	@Failure		401						{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403						{object}	apperror.ErrorResponse	"Quota exceeded or login session required"
+//	@Router			/api/v1/invitations [post]
+func (h *InvitationHandler) Create(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	invitation, err := h.invitationService.Create(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// DELETE /api/v1/invitations/:id
+// Revoke godoc
+//
+//	@Summary		Revoke invitation
+//	@Description	Revokes an own invitation code that still has uses left, the unused uses return to the quota
+//	@Tags			Invitations
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Invitation code ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid ID or code used up"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Invitation code not found"
+//	@Router			/api/v1/invitations/{id} [delete]
+func (h *InvitationHandler) Revoke(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.invitationService.Revoke(c.Request.Context(), userID, uint(id)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/pkg/utils"
+)
+
+type JWKSHandler struct {
+	jwtKeys *utils.JWTKeys
+}
+
+func NewJWKSHandler(jwtKeys *utils.JWTKeys) *JWKSHandler {
+	return &JWKSHandler{jwtKeys: jwtKeys}
+}
+
+// GET /.well-known/jwks.json
+// GetJWKS godoc
+//
+//	@Summary		JSON Web Key Set
+//	@Description	Public keys to verify access tokens, selected by the kid header. Empty in HS256 mode.
+//	@Tags			Auth
+//	@Produce		json
+//	@Success		200	{object}	utils.JWKS
+//	@Router			/.well-known/jwks.json [get]
+func (h *JWKSHandler) GetJWKS(c *gin.Context) {
+	// Keys only change on restart, other services may cache them
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.JSON(http.StatusOK, h.jwtKeys.JWKS())
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationService service.NotificationService
+}
+
+func NewNotificationHandler(notificationService service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notificationService: notificationService}
+}
+
+// GET /api/v1/notifications
+// List godoc
+//
+//	@Summary		List notifications
+//	@Description	Get a paginated list of the current user's notifications (newest first)
+//	@Tags			Notifications
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			page		query		int		false	"Page number"
+//	@Param			limit		query		int		false	"Number of items per page"
+//	@Param			unread_only	query		bool	false	"Only return unread notifications"
+//	@Success		200			{object}	responses.PaginatedNotificationsResponse
+//	@Failure		400			{object}	apperror.ErrorResponse	"Invalid request"
+//	@Failure		401			{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500			{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/notifications [get]
+func (h *NotificationHandler) List(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.ListNotificationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	// Defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	response, err := h.notificationService.List(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GET /api/v1/notifications/unread-count
+// GetUnreadCount godoc
+//
+//	@Summary		Get unread notification count
+//	@Description	Get the number of unread notifications of the current user
+//	@Tags			Notifications
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{object}	responses.UnreadCountResponse
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	response, err := h.notificationService.GetUnreadCount(c.Request.Context(), userID)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PATCH /api/v1/notifications/:id/read
+// MarkAsRead godoc
+//
+//	@Summary		Mark notification as read
+//	@Description	Mark a single notification of the current user as read
+//	@Tags			Notifications
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Notification ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid notification ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Notification not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/notifications/{id}/read [patch]
+func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	notificationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.notificationService.MarkAsRead(c.Request.Context(), uint(notificationID), userID); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/v1/notifications/read-all
+// MarkAllAsRead godoc
+//
+//	@Summary		Mark all notifications as read
+//	@Description	Mark all notifications of the current user as read
+//	@Tags			Notifications
+//	@Security		BearerAuth
+//	@Success		204	"No Content"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	if err := h.notificationService.MarkAllAsRead(c.Request.Context(), userID); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DELETE /api/v1/notifications/:id
+// Delete godoc
+//
+//	@Summary		Delete notification
+//	@Description	Delete a notification of the current user
+//	@Tags			Notifications
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Notification ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid notification ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Notification not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/notifications/{id} [delete]
+func (h *NotificationHandler) Delete(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	notificationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.notificationService.Delete(c.Request.Context(), uint(notificationID), userID); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type OIDCHandler struct {
+	authService service.AuthService
+	oidcService service.OIDCService
+}
+
+func NewOIDCHandler(authService service.AuthService, oidcService service.OIDCService) *OIDCHandler {
+	return &OIDCHandler{authService: authService, oidcService: oidcService}
+}
+
+// GET /api/v1/auth/oidc/providers
+// ListProviders godoc
+//
+//	@Summary		List external login providers
+//	@Description	Returns the configured OpenID Connect providers
+//	@Tags			OIDC
+//	@Produce		json
+//	@Success		200	{array}	responses.OIDCProviderResponse
+//	@Router			/api/v1/auth/oidc/providers [get]
+func (h *OIDCHandler) ListProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, h.oidcService.ListProviders())
+}
+
+// POST /api/v1/auth/oidc/:provider/authorize
+// Authorize godoc
+//
+//	@Summary		Start external login
+//	@Description	Starts the authorization code flow with PKCE. The returned URL is opened in the browser,
+//	@Description	the provider redirects back with code and state which are sent to the login or link endpoint.
+//	@Tags			OIDC
+//	@Produce		json
+//	@Param			provider	path		string	true	"Provider name"
+//	@Success		200			{object}	responses.OIDCAuthorizeResponse
+//	@Failure		404			{object}	apperror.ErrorResponse	"Unknown provider"
+//	@Failure		500			{object}	apperror.ErrorResponse	"Provider not reachable"
+//	@Router			/api/v1/auth/oidc/{provider}/authorize [post]
+func (h *OIDCHandler) Authorize(c *gin.Context) {
+	response, err := h.oidcService.Authorize(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/auth/oidc/:provider/nonce
+// Nonce godoc
+//
+//	@Summary		Start external login with an ID token
+//	@Description	Returns a nonce for the native sign-in SDK. The ID token has to be requested with it,
+//	@Description	it is sent back together with the token to the login or link endpoint and is valid once.
+//	@Tags			OIDC
+//	@Produce		json
+//	@Param			provider	path		string	true	"Provider name"
+//	@Success		200			{object}	responses.OIDCNonceResponse
+//	@Failure		404			{object}	apperror.ErrorResponse	"Unknown provider"
+//	@Router			/api/v1/auth/oidc/{provider}/nonce [post]
+func (h *OIDCHandler) Nonce(c *gin.Context) {
+	response, err := h.oidcService.IssueNonce(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/auth/oidc/:provider/login
+// Login godoc
+//
+//	@Summary		Login with external account
+//	@Description	Logs in with an authorization code and state, or with an ID token of a native sign-in SDK.
+//	@Description	Unknown accounts are registered and need an invitation code. With 2FA enabled only a challenge token is returned.
+//	@Tags			OIDC
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider			path		string						true	"Provider name"
+//	@Param			oidcLoginRequest	body		requests.OIDCLoginRequest	true	"Code and state or ID token"
+//	@Success		200					{object}	responses.LoginResponse
+//	@Failure		400					{object}	apperror.ErrorResponse	"Invalid request, state or invitation code"
+//	@Failure		401					{object}	apperror.ErrorResponse	"Rejected by the provider"
+//	@Failure		403					{object}	apperror.ErrorResponse	"Account deactivated or invitation code required"
+//	@Failure		404					{object}	apperror.ErrorResponse	"Unknown provider"
+//	@Failure		409					{object}	apperror.ErrorResponse	"Email belongs to an existing account"
+//	@Router			/api/v1/auth/oidc/{provider}/login [post]
+func (h *OIDCHandler) Login(c *gin.Context) {
+	var req requests.OIDCLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+	setClientInfo(c, &req.ClientInfo)
+
+	result, err := h.authService.LoginOIDC(c.Request.Context(), c.Param("provider"), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GET /api/v1/users/me/identities
+// ListIdentities godoc
+//
+//	@Summary		List linked external accounts
+//	@Tags			OIDC
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		responses.ExternalIdentityResponse
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Router			/api/v1/users/me/identities [get]
+func (h *OIDCHandler) ListIdentities(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	identities, err := h.oidcService.ListIdentities(c.Request.Context(), userID)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, identities)
+}
+
+// POST /api/v1/users/me/identities/:provider
+// LinkIdentity godoc
+//
+//	@Summary		Link external account
+//	@Description	Links an account of the provider to the current user, proven by code and state or an ID token
+//	@Tags			OIDC
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			provider		path		string					true	"Provider name"
+//	@Param			oidcCredentials	body		requests.OIDCCredentials	true	"Code and state or ID token"
+//	@Success		201				{object}	responses.ExternalIdentityResponse
+//	@Failure		400				{object}	apperror.ErrorResponse	"Invalid request or state"
+//	@Failure		401				{object}	apperror.ErrorResponse	"Unauthorized or rejected by the provider"
+//	@Failure		404				{object}	apperror.ErrorResponse	"Unknown provider"
+//	@Failure		409				{object}	apperror.ErrorResponse	"Already linked"
+//	@Router			/api/v1/users/me/identities/{provider} [post]
+func (h *OIDCHandler) LinkIdentity(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.OIDCCredentials
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	identity, err := h.oidcService.LinkIdentity(c.Request.Context(), userID, c.Param("provider"), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, identity)
+}
+
+// DELETE /api/v1/users/me/identities/:provider
+// UnlinkIdentity godoc
+//
+//	@Summary		Unlink external account
+//	@Description	Accounts without password can't unlink their last external account
+//	@Tags			OIDC
+//	@Security		BearerAuth
+//	@Param			provider	path	string	true	"Provider name"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	apperror.ErrorResponse	"Last login method"
+//	@Failure		401			{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	apperror.ErrorResponse	"Not linked"
+//	@Router			/api/v1/users/me/identities/{provider} [delete]
+func (h *OIDCHandler) UnlinkIdentity(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	if err := h.oidcService.UnlinkIdentity(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type PasswordResetHandler struct {
+	passwordResetService service.PasswordResetService
+}
+
+func NewPasswordResetHandler(passwordResetService service.PasswordResetService) *PasswordResetHandler {
+	return &PasswordResetHandler{passwordResetService: passwordResetService}
+}
+
+// POST /api/v1/auth/password/forgot
+// ForgotPassword godoc
+//
+//	@Summary		Request password reset
+//	@Description	Sends a password reset link by mail. Responds the same whether the email exists or not.
+//	@Tags			Auth
+//	@Accept			json
+//	@Param			forgotPasswordRequest	body	requests.ForgotPasswordRequest	true	"Forgot Password Request"
+//	@Success		202						"Accepted"
+//	@Failure		400						{object}	apperror.ErrorResponse
+//	@Failure		429						{object}	apperror.ErrorResponse
+//	@Router			/api/v1/auth/password/forgot [post]
+func (h *PasswordResetHandler) ForgotPassword(c *gin.Context) {
+	var req requests.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	if err := h.passwordResetService.ForgotPassword(c.Request.Context(), &req); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// POST /api/v1/auth/password/reset
+// ResetPassword godoc
+//
+//	@Summary		Reset password
+//	@Description	Sets a new password with a reset token and logs the user out on all devices
+//	@Tags			Auth
+//	@Accept			json
+//	@Param			resetPasswordRequest	body	requests.ResetPasswordRequest	true	"Reset Password Request"
+//	@Success		204						"No Content"
+//	@Failure		400						{object}	apperror.ErrorResponse	"Invalid request or reset token"
+//	@Failure		429						{object}	apperror.ErrorResponse
+//	@Router			/api/v1/auth/password/reset [post]
+func (h *PasswordResetHandler) ResetPassword(c *gin.Context) {
+	var req requests.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	if err := h.passwordResetService.ResetPassword(c.Request.Context(), &req); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
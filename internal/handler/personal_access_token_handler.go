@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type PersonalAccessTokenHandler struct {
+	tokenService service.PersonalAccessTokenService
+}
+
+func NewPersonalAccessTokenHandler(tokenService service.PersonalAccessTokenService) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{tokenService: tokenService}
+}
+
+// GET /api/v1/users/me/tokens
+// List godoc
+//
+//	@Summary		List personal access tokens
+//	@Description	Returns the tokens of the current user without their secret
+//	@Tags			Access Tokens
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		responses.PersonalAccessTokenResponse
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	apperror.ErrorResponse	"Login session required"
+//	@Router			/api/v1/users/me/tokens [get]
+func (h *PersonalAccessTokenHandler) List(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	tokens, err := h.tokenService.List(c.Request.Context(), userID)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// POST /api/v1/users/me/tokens
+// Create godoc
+//
+//	@Summary		Create personal access token
+//	@Description	Creates a token for scripts and integrations, sent as "Authorization: Bearer hsp_...".
+//	@Description	Scopes: spots:read, spots:write, visits:read, visits:write and admin (admins only). The token is only shown in this response.
+//	@Tags			Access Tokens
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			createPersonalAccessTokenRequest	body		requests.CreatePersonalAccessTokenRequest	true	"Name, scopes and expiry"
+//	@Success		201									{object}	responses.CreatedPersonalAccessTokenResponse
+//	@Failure		400									{object}	apperror.ErrorResponse	"Invalid request or scope"
+//	@Failure		401									{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403									{object}	apperror.ErrorResponse	"Login session required"
+//	@Router			/api/v1/users/me/tokens [post]
+func (h *PersonalAccessTokenHandler) Create(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.CreatePersonalAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	token, err := h.tokenService.Create(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, token)
+}
+
+// DELETE /api/v1/users/me/tokens/:id
+// Revoke godoc
+//
+//	@Summary		Revoke personal access token
+//	@Tags			Access Tokens
+//	@Security		BearerAuth
+//	@Param			id	path	int	true	"Token ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid token ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	apperror.ErrorResponse	"Login session required"
+//	@Failure		404	{object}	apperror.ErrorResponse	"Token not found"
+//	@Router			/api/v1/users/me/tokens/{id} [delete]
+func (h *PersonalAccessTokenHandler) Revoke(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.tokenService.Revoke(c.Request.Context(), userID, uint(id)); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
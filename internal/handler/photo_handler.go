@@ -92,25 +92,18 @@ func (h *PhotoHandler) Upload(c *gin.Context) {
 //	@Router			/api/v1/photos/{id} [delete]
 func (h *PhotoHandler) Delete(c *gin.Context) {
 	// JWT Claims
-	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
-	if !ok {
-		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
-		return
-	}
-	userRole, ok := c.MustGet(middleware.ContextKeyUserRole).(domain.Role)
+	principal, ok := c.MustGet(middleware.ContextKeyPrincipal).(domain.Principal)
 	if !ok {
 		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
 		return
 	}
-	isAdmin := userRole == domain.RoleAdmin
-
 	photoID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
 		return
 	}
 
-	err = h.photoService.Delete(c.Request.Context(), uint(photoID), userID, isAdmin)
+	err = h.photoService.Delete(c.Request.Context(), uint(photoID), principal)
 	if err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
@@ -136,24 +129,17 @@ func (h *PhotoHandler) Delete(c *gin.Context) {
 //	@Router			/api/v1/photos/{id}/main [patch]
 func (h *PhotoHandler) SetMainPhoto(c *gin.Context) {
 	// JWT Claims
-	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
-	if !ok {
-		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
-		return
-	}
-	userRole, ok := c.MustGet(middleware.ContextKeyUserRole).(domain.Role)
+	principal, ok := c.MustGet(middleware.ContextKeyPrincipal).(domain.Principal)
 	if !ok {
 		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
 		return
 	}
-	isAdmin := userRole == domain.RoleAdmin
-
 	photoID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
 		return
 	}
-	err = h.photoService.SetMainPhoto(c.Request.Context(), uint(photoID), userID, isAdmin)
+	err = h.photoService.SetMainPhoto(c.Request.Context(), uint(photoID), principal)
 	if err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
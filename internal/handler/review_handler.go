@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewHandler struct {
+	reviewService service.ReviewService
+}
+
+func NewReviewHandler(reviewService service.ReviewService) *ReviewHandler {
+	return &ReviewHandler{reviewService: reviewService}
+}
+
+// GET /api/v1/spots/:id/reviews
+// ListReviews godoc
+//
+//	@Summary		List reviews of a spot
+//	@Description	Get a paginated list of reviews for a spot, newest first
+//	@Tags			Reviews
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int	true	"Spot ID"
+//	@Param			page	query		int	false	"Page number"		default(1)
+//	@Param			limit	query		int	false	"Items per page"	default(50)
+//	@Success		200		{object}	responses.PaginatedReviewsResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Invalid spot ID"
+//	@Failure		404		{object}	apperror.ErrorResponse	"Spot not found"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/spots/{id}/reviews [get]
+func (h *ReviewHandler) List(c *gin.Context) {
+	spotID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	var req requests.ListReviewsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	// Defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	response, err := h.reviewService.List(c.Request.Context(), uint(spotID), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/spots/:id/reviews
+// CreateReview godoc
+//
+//	@Summary		Review a spot
+//	@Description	Create the authenticated user's review for a spot (one review per user and spot)
+//	@Tags			Reviews
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Spot ID"
+//	@Param			review	body		requests.CreateReviewRequest	true	"Review payload"
+//	@Success		201		{object}	responses.ReviewResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		401		{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404		{object}	apperror.ErrorResponse	"Spot not found"
+//	@Failure		409		{object}	apperror.ErrorResponse	"Spot already reviewed"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/spots/{id}/reviews [post]
+func (h *ReviewHandler) Create(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	spotID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	var req requests.CreateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	response, err := h.reviewService.Create(c.Request.Context(), uint(spotID), &req, userID)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// PATCH /api/v1/spots/:id/reviews/:reviewId
+// UpdateReview godoc
+//
+//	@Summary		Update a review
+//	@Description	Update a review by ID. Only the author or roles with review.update.any can update a review.
+//	@Tags			Reviews
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int							true	"Spot ID"
+//	@Param			reviewId	path		int							true	"Review ID"
+//	@Param			review		body		requests.UpdateReviewRequest	true	"Review update payload"
+//	@Success		200			{object}	responses.ReviewResponse
+//	@Failure		400			{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		401			{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403			{object}	apperror.ErrorResponse	"Forbidden - not author, missing permission"
+//	@Failure		404			{object}	apperror.ErrorResponse	"Review not found"
+//	@Failure		500			{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/spots/{id}/reviews/{reviewId} [patch]
+func (h *ReviewHandler) Update(c *gin.Context) {
+	// JWT Claims
+	principal, ok := c.MustGet(middleware.ContextKeyPrincipal).(domain.Principal)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+	// Request data
+	spotID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+	reviewID, err := strconv.ParseUint(c.Param("reviewId"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	var req requests.UpdateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	response, err := h.reviewService.Update(c.Request.Context(), uint(spotID), uint(reviewID), &req, principal)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DELETE /api/v1/spots/:id/reviews/:reviewId
+// DeleteReview godoc
+//
+//	@Summary		Delete a review
+//	@Description	Delete a review by ID. Only the author or roles with review.delete.any can delete a review.
+//	@Tags			Reviews
+//	@Security		BearerAuth
+//	@Param			id			path	int	true	"Spot ID"
+//	@Param			reviewId	path	int	true	"Review ID"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	apperror.ErrorResponse	"Invalid ID"
+//	@Failure		401			{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403			{object}	apperror.ErrorResponse	"Forbidden - not author, missing permission"
+//	@Failure		404			{object}	apperror.ErrorResponse	"Review not found"
+//	@Failure		500			{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/spots/{id}/reviews/{reviewId} [delete]
+func (h *ReviewHandler) Delete(c *gin.Context) {
+	// JWT Claims
+	principal, ok := c.MustGet(middleware.ContextKeyPrincipal).(domain.Principal)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+	// Request data
+	spotID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+	reviewID, err := strconv.ParseUint(c.Param("reviewId"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	if err := h.reviewService.Delete(c.Request.Context(), uint(spotID), uint(reviewID), principal); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
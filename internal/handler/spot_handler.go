@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"io"
 	"net/http"
 	"strconv"
 
@@ -9,6 +10,8 @@ import (
 	"hopSpotAPI/internal/middleware"
 	"hopSpotAPI/internal/service"
 	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/geoformat"
+	"hopSpotAPI/pkg/logger"
 
 	"github.com/gin-gonic/gin"
 )
@@ -34,12 +37,14 @@ func NewSpotHandler(spotService service.SpotService) *SpotHandler {
 //	@Param			sort_by			query		string	false	"Sort by field"					Enums(name, rating, created_at, distance)	default(created_at)
 //	@Param			sort_order		query		string	false	"Sort order"					Enums(asc, desc)							default(desc)
 //	@Param			search			query		string	false	"Search term for spot name or description"
-//	@Param			has_toilet		query		bool	false	"Filter by presence of toilet"
-//	@Param			has_trash_bin	query		bool	false	"Filter by presence of trash bin"
+//	@Param			amenities		query		string	false	"Comma-separated amenity keys, spots must have all of them"
+//	@Param			has_toilet		query		bool	false	"Filter by presence of toilet (deprecated, use amenities)"
+//	@Param			has_trash_bin	query		bool	false	"Filter by presence of trash bin (deprecated, use amenities)"
 //	@Param			min_rating		query		int		false	"Filter by minimum rating (1-5)"
 //	@Param			lat				query		number	false	"Latitude for proximity search"
 //	@Param			lon				query		number	false	"Longitude for proximity search"
 //	@Param			radius			query		int		false	"Radius in meters for proximity search"
+//	@Param			cursor			query		string	false	"Cursor from next_cursor for keyset pagination (replaces page)"
 //	@Success		200				{object}	responses.PaginatedSpotsResponse
 //	@Failure		400				{object}	apperror.ErrorResponse	"Bad Request"
 //	@Failure		500				{object}	apperror.ErrorResponse	"Internal Server Error"
@@ -70,6 +75,168 @@ func (h *SpotHandler) List(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"data": spots})
 }
 
+// GET /api/v1/spots/nearest
+// ListNearestSpots godoc
+//
+//	@Summary		List nearest spots
+//	@Description	Get the spots closest to a position, ordered by distance
+//	@Tags			Spots
+//	@Accept			json
+//	@Produce		json
+//	@Param			lat		query		number	true	"Latitude"
+//	@Param			lon		query		number	true	"Longitude"
+//	@Param			limit	query		int		false	"Number of spots"	default(10)
+//	@Success		200		{array}		responses.SpotListResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/spots/nearest [get]
+func (h *SpotHandler) ListNearest(c *gin.Context) {
+	var req requests.NearestSpotsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	spots, err := h.spotService.ListNearest(c.Request.Context(), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": spots})
+}
+
+// GET /api/v1/spots/map
+// GetSpotMap godoc
+//
+//	@Summary		Get spots for a map viewport
+//	@Description	Returns clusters (count and centroid) at low zoom levels and lightweight markers at high zoom levels
+//	@Tags			Spots
+//	@Accept			json
+//	@Produce		json
+//	@Param			bbox	query		string	true	"Bounding box: minLon,minLat,maxLon,maxLat"
+//	@Param			zoom	query		int		false	"Map zoom level (0-22)"
+//	@Success		200		{object}	responses.SpotMapResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/spots/map [get]
+func (h *SpotHandler) GetMap(c *gin.Context) {
+	var req requests.MapSpotsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	spotMap, err := h.spotService.GetMap(c.Request.Context(), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": spotMap})
+}
+
+// GET /api/v1/spots/export
+// ExportSpots godoc
+//
+//	@Summary		Export spots
+//	@Description	Stream all spots (or the filtered set, same filters as the list) as GeoJSON, GPX or KML
+//	@Tags			Spots
+//	@Produce		application/geo+json,application/gpx+xml,application/vnd.google-earth.kml+xml
+//	@Param			format			query		string	false	"Export format"	Enums(geojson, gpx, kml)	default(geojson)
+//	@Param			sort_by			query		string	false	"Sort by field"	Enums(name, rating, created_at, distance)	default(created_at)
+//	@Param			sort_order		query		string	false	"Sort order"	Enums(asc, desc)	default(desc)
+//	@Param			search			query		string	false	"Search term for spot name or description"
+//	@Param			amenities		query		string	false	"Comma-separated amenity keys, spots must have all of them"
+//	@Param			has_toilet		query		bool	false	"Filter by presence of toilet (deprecated, use amenities)"
+//	@Param			has_trash_bin	query		bool	false	"Filter by presence of trash bin (deprecated, use amenities)"
+//	@Param			min_rating		query		int		false	"Filter by minimum rating (1-5)"
+//	@Param			lat				query		number	false	"Latitude for proximity search"
+//	@Param			lon				query		number	false	"Longitude for proximity search"
+//	@Param			radius			query		int		false	"Radius in meters for proximity search"
+//	@Success		200				{file}		file
+//	@Failure		400				{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		500				{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/spots/export [get]
+func (h *SpotHandler) Export(c *gin.Context) {
+	var req requests.ExportSpotsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	c.Header("Content-Type", geoformat.ContentType(req.Format))
+	c.Header("Content-Disposition", "attachment; filename=\"hopspot-spots."+req.Format+"\"")
+
+	if err := h.spotService.Export(c.Request.Context(), &req, c.Writer); err != nil {
+		// Once streaming has started the status can't be changed anymore
+		if c.Writer.Written() {
+			logger.Log.Error().Err(err).Msg("Spot export aborted")
+			return
+		}
+		c.Header("Content-Disposition", "")
+		apperror.RespondWithMappedError(c, err)
+	}
+}
+
+// POST /api/v1/admin/spots/import
+// ImportSpots godoc
+//
+//	@Summary		Import spots (Admin only)
+//	@Description	Import spots from a GeoJSON or GPX file. Duplicates (same name or within 15 m) are skipped. Returns a report per feature.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file	true	"GeoJSON or GPX file"
+//	@Success		200		{object}	responses.ImportSpotsResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Invalid file"
+//	@Failure		401		{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	apperror.ErrorResponse	"Forbidden - Admin only"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/admin/spots/import [post]
+func (h *SpotHandler) Import(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationFieldRequired.WithDetails("Import file is required"))
+		return
+	}
+	if fileHeader.Size > geoformat.MaxFileSize {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidImport.WithDetails("File exceeds 10 MB"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	report, err := h.spotService.Import(c.Request.Context(), data, userID)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
 // GET /api/v1/spots/random
 // GetRandomSpot godoc
 //
@@ -133,9 +300,11 @@ func (h *SpotHandler) GetByID(c *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			spot	body		requests.CreateSpotRequest	true	"Spot payload"
+//	@Param			force	query		bool						false	"Create even if spots exist nearby"
 //	@Success		201		{object}	responses.SpotResponse
 //	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
 //	@Failure		401		{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		409		{object}	apperror.ErrorResponse	"Spots exist nearby - data contains responses.DuplicateSpotsResponse"
 //	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
 //	@Router			/api/v1/spots [post]
 func (h *SpotHandler) Create(c *gin.Context) {
@@ -152,6 +321,7 @@ func (h *SpotHandler) Create(c *gin.Context) {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
 		return
 	}
+	req.Force = c.Query("force") == "true"
 
 	result, err := h.spotService.Create(c.Request.Context(), &req, userID)
 	if err != nil {
@@ -166,34 +336,29 @@ func (h *SpotHandler) Create(c *gin.Context) {
 // UpdateSpot godoc
 //
 //	@Summary		Update a spot
-//	@Description	Update spot details by ID. Only the owner or an admin can update a spot.
+//	@Description	Update spot details by ID. Only the owner or roles with spot.update.any can update a spot.
 //	@Tags			Spots
 //	@Security		BearerAuth
 //	@Accept			json
 //	@Produce		json
 //	@Param			id		path		int							true	"Spot ID"
 //	@Param			spot	body		requests.UpdateSpotRequest	true	"Spot update payload"
+//	@Param			force	query		bool						false	"Move even if spots exist nearby"
 //	@Success		200		{object}	responses.SpotResponse
 //	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
 //	@Failure		401		{object}	apperror.ErrorResponse	"Unauthorized"
-//	@Failure		403		{object}	apperror.ErrorResponse	"Forbidden - not owner or admin"
+//	@Failure		403		{object}	apperror.ErrorResponse	"Forbidden - not owner, missing permission"
 //	@Failure		404		{object}	apperror.ErrorResponse	"Spot not found"
+//	@Failure		409		{object}	apperror.ErrorResponse	"Spots exist nearby - data contains responses.DuplicateSpotsResponse"
 //	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
 //	@Router			/api/v1/spots/{id} [patch]
 func (h *SpotHandler) Update(c *gin.Context) {
 	// JWT Claims
-	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
-	if !ok {
-		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
-		return
-	}
-	userRole, ok := c.MustGet(middleware.ContextKeyUserRole).(domain.Role)
+	principal, ok := c.MustGet(middleware.ContextKeyPrincipal).(domain.Principal)
 	if !ok {
 		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
 		return
 	}
-	isAdmin := userRole == domain.RoleAdmin
-
 	// Request data
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
@@ -206,8 +371,9 @@ func (h *SpotHandler) Update(c *gin.Context) {
 		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
 		return
 	}
+	req.Force = c.Query("force") == "true"
 
-	result, err := h.spotService.Update(c.Request.Context(), uint(id), &req, userID, isAdmin)
+	result, err := h.spotService.Update(c.Request.Context(), uint(id), &req, principal)
 	if err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
@@ -220,7 +386,7 @@ func (h *SpotHandler) Update(c *gin.Context) {
 // DeleteSpot godoc
 //
 //	@Summary		Delete a spot
-//	@Description	Delete a spot by ID. Only the owner or an admin can delete a spot.
+//	@Description	Delete a spot by ID. Only the owner or roles with spot.delete.any can delete a spot.
 //	@Tags			Spots
 //	@Security		BearerAuth
 //	@Accept			json
@@ -229,24 +395,17 @@ func (h *SpotHandler) Update(c *gin.Context) {
 //	@Success		200	{object}	nil	"Successfully deleted"
 //	@Failure		400	{object}	apperror.ErrorResponse	"Invalid spot ID"
 //	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
-//	@Failure		403	{object}	apperror.ErrorResponse	"Forbidden - not owner or admin"
+//	@Failure		403	{object}	apperror.ErrorResponse	"Forbidden - not owner, missing permission"
 //	@Failure		404	{object}	apperror.ErrorResponse	"Spot not found"
 //	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
 //	@Router			/api/v1/spots/{id} [delete]
 func (h *SpotHandler) Delete(c *gin.Context) {
 	// JWT Claims
-	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	principal, ok := c.MustGet(middleware.ContextKeyPrincipal).(domain.Principal)
 	if !ok {
 		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
 		return
 	}
-	userRole, ok := c.MustGet(middleware.ContextKeyUserRole).(domain.Role)
-	if !ok {
-		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
-		return
-	}
-	isAdmin := userRole == domain.RoleAdmin
-
 	// Request data
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
@@ -254,7 +413,7 @@ func (h *SpotHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	err = h.spotService.Delete(c.Request.Context(), uint(id), userID, isAdmin)
+	err = h.spotService.Delete(c.Request.Context(), uint(id), principal)
 	if err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
@@ -262,3 +421,42 @@ func (h *SpotHandler) Delete(c *gin.Context) {
 
 	c.JSON(http.StatusOK, nil)
 }
+
+// POST /api/v1/admin/spots/merge
+// MergeSpots godoc
+//
+//	@Summary		Merge two spots (Admin only)
+//	@Description	Moves visits, photos, favorites, reviews and activities from the source spot to the target spot and deletes the source
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			merge	body		requests.MergeSpotsRequest	true	"Source and target spot"
+//	@Success		200		{object}	responses.SpotResponse
+//	@Failure		400		{object}	apperror.ErrorResponse	"Bad Request"
+//	@Failure		401		{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	apperror.ErrorResponse	"Forbidden - missing permission spot.merge"
+//	@Failure		404		{object}	apperror.ErrorResponse	"Spot not found"
+//	@Failure		500		{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/admin/spots/merge [post]
+func (h *SpotHandler) Merge(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.MergeSpotsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	result, err := h.spotService.Merge(c.Request.Context(), &req, userID)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": result})
+}
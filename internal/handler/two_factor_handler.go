@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/middleware"
+	"hopSpotAPI/internal/service"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type TwoFactorHandler struct {
+	twoFactorService service.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorService service.TwoFactorService) *TwoFactorHandler {
+	return &TwoFactorHandler{twoFactorService: twoFactorService}
+}
+
+// POST /api/v1/users/me/2fa/setup
+// Setup godoc
+//
+//	@Summary		Start 2FA setup
+//	@Description	Creates a new TOTP secret. The provisioning URI is shown as QR code, 2FA is active after confirming a code.
+//	@Tags			Two-Factor
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			twoFactorSetupRequest	body		requests.TwoFactorSetupRequest	true	"Current password, or email for accounts without password"
+//	@Success		200						{object}	responses.TwoFactorSetupResponse
+//	@Failure		400						{object}	apperror.ErrorResponse
+//	@Failure		401						{object}	apperror.ErrorResponse	"Unauthorized or wrong password"
+//	@Failure		409						{object}	apperror.ErrorResponse	"2FA already enabled"
+//	@Router			/api/v1/users/me/2fa/setup [post]
+func (h *TwoFactorHandler) Setup(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.TwoFactorSetupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	response, err := h.twoFactorService.Setup(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/users/me/2fa/enable
+// Enable godoc
+//
+//	@Summary		Enable 2FA
+//	@Description	Confirms the setup with a code from the authenticator app and returns the recovery codes once
+//	@Tags			Two-Factor
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			twoFactorCodeRequest	body		requests.TwoFactorCodeRequest	true	"TOTP code"
+//	@Success		200						{object}	responses.RecoveryCodesResponse
+//	@Failure		400						{object}	apperror.ErrorResponse	"Invalid request or setup not started"
+//	@Failure		401						{object}	apperror.ErrorResponse	"Unauthorized or invalid code"
+//	@Failure		409						{object}	apperror.ErrorResponse	"2FA already enabled"
+//	@Router			/api/v1/users/me/2fa/enable [post]
+func (h *TwoFactorHandler) Enable(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	response, err := h.twoFactorService.Enable(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// POST /api/v1/users/me/2fa/disable
+// Disable godoc
+//
+//	@Summary		Disable 2FA
+//	@Description	Disables 2FA with password (email for accounts without password) and a TOTP or recovery code. Not possible for admins when 2FA is mandatory.
+//	@Tags			Two-Factor
+//	@Accept			json
+//	@Security		BearerAuth
+//	@Param			disableTwoFactorRequest	body	requests.DisableTwoFactorRequest	true	"Password and code"
+//	@Success		204						"No Content"
+//	@Failure		400						{object}	apperror.ErrorResponse	"Invalid request or 2FA not enabled"
+//	@Failure		401						{object}	apperror.ErrorResponse	"Unauthorized, wrong password or invalid code"
+//	@Failure		403						{object}	apperror.ErrorResponse	"2FA is mandatory"
+//	@Router			/api/v1/users/me/2fa/disable [post]
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.DisableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	if err := h.twoFactorService.Disable(c.Request.Context(), userID, &req); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// POST /api/v1/users/me/2fa/recovery-codes
+// RegenerateRecoveryCodes godoc
+//
+//	@Summary		Regenerate recovery codes
+//	@Description	Replaces all recovery codes, the new codes are returned once
+//	@Tags			Two-Factor
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			twoFactorCodeRequest	body		requests.TwoFactorCodeRequest	true	"TOTP or recovery code"
+//	@Success		200						{object}	responses.RecoveryCodesResponse
+//	@Failure		400						{object}	apperror.ErrorResponse	"Invalid request or 2FA not enabled"
+//	@Failure		401						{object}	apperror.ErrorResponse	"Unauthorized or invalid code"
+//	@Router			/api/v1/users/me/2fa/recovery-codes [post]
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	response, err := h.twoFactorService.RegenerateRecoveryCodes(c.Request.Context(), userID, &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
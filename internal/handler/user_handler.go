@@ -2,11 +2,13 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"hopSpotAPI/internal/dto/requests"
 	"hopSpotAPI/internal/middleware"
 	"hopSpotAPI/internal/service"
 	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
 
 	"github.com/gin-gonic/gin"
 )
@@ -48,6 +50,37 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// GET /api/v1/users/:id
+// GetPublicProfile godoc
+//
+//	@Summary		Get public user profile
+//	@Description	Retrieve the public profile of a user with stats and recent activities
+//	@Tags			Users
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"User ID"
+//	@Success		200	{object}	responses.PublicProfileResponse
+//	@Failure		400	{object}	apperror.ErrorResponse	"Invalid user ID"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"User not found"
+//	@Failure		500	{object}	apperror.ErrorResponse	"Internal Server Error"
+//	@Router			/api/v1/users/{id} [get]
+func (h *UserHandler) GetPublicProfile(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidID)
+		return
+	}
+
+	result, err := h.userService.GetPublicProfile(c.Request.Context(), uint(userID))
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // PATCH /api/v1/users/me
 // UpdateProfile godoc
 //
@@ -88,7 +121,7 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 // ChangePassword godoc
 //
 //	@Summary		Change user password
-//	@Description	Change the password of the currently authenticated user
+//	@Description	Change the password of the currently authenticated user, all other sessions are logged out
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
@@ -111,11 +144,81 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
 		return
 	}
-	err := h.userService.ChangePassword(c.Request.Context(), userID, &req)
+	err := h.userService.ChangePassword(c.Request.Context(), userID, c.GetUint(middleware.ContextKeySessionID), &req)
+	if err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GET /api/v1/users/me/export
+// ExportData godoc
+//
+//	@Summary		Export personal data
+//	@Description	Downloads a ZIP with data.json, containing everything stored about the user,
+//	@Description	and the original images of the uploaded photos as photos/<id>.jpg
+//	@Tags			Users
+//	@Security		BearerAuth
+//	@Produce		application/zip
+//	@Success		200	{file}		file	"ZIP archive"
+//	@Failure		401	{object}	apperror.ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	apperror.ErrorResponse	"User not found"
+//	@Router			/api/v1/users/me/export [get]
+func (h *UserHandler) ExportData(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	export, err := h.userService.ExportData(c.Request.Context(), userID)
 	if err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
 	}
 
+	// From here on the archive is streamed, errors can only be logged
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", "attachment; filename=\"hopspot-export-"+export.ExportedAt.Format("2006-01-02")+".zip\"")
+	c.Status(http.StatusOK)
+	if err := h.userService.WriteExportArchive(c.Request.Context(), export, c.Writer); err != nil {
+		logger.Error().Err(err).Uint("user_id", userID).Msg("Failed to write data export")
+	}
+}
+
+// DELETE /api/v1/users/me
+// DeleteAccount godoc
+//
+//	@Summary		Delete own account
+//	@Description	Deletes the account after confirmation with the password (accounts without password retype their email).
+//	@Description	Personal data is purged and all tokens are revoked. Spots and photos are anonymized or handed over, depending on the server configuration.
+//	@Tags			Users
+//	@Accept			json
+//	@Security		BearerAuth
+//	@Param			confirmation	body	requests.DeleteAccountRequest	true	"Password or email confirmation"
+//	@Success		204				"No Content"
+//	@Failure		400				{object}	apperror.ErrorResponse	"Invalid request"
+//	@Failure		401				{object}	apperror.ErrorResponse	"Unauthorized or wrong confirmation"
+//	@Router			/api/v1/users/me [delete]
+func (h *UserHandler) DeleteAccount(c *gin.Context) {
+	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	if !ok {
+		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
+		return
+	}
+
+	var req requests.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.RespondWithError(c, apperror.AppErrValidationInvalidRequest)
+		return
+	}
+
+	if err := h.userService.DeleteAccount(c.Request.Context(), userID, &req); err != nil {
+		apperror.RespondWithMappedError(c, err)
+		return
+	}
+
 	c.Status(http.StatusNoContent)
 }
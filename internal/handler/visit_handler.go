@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
 	"hopSpotAPI/internal/dto/responses"
 	"hopSpotAPI/internal/middleware"
@@ -130,7 +131,7 @@ func (h *VisitHandler) CreateVisit(c *gin.Context) {
 // DeleteVisit godoc
 //
 //	@Summary		Delete a visit
-//	@Description	Delete a visit by ID (only own visits can be deleted)
+//	@Description	Delete a visit by ID (own visits, others with visit.delete.any)
 //	@Tags			Visits
 //	@Accept			json
 //	@Produce		json
@@ -141,7 +142,7 @@ func (h *VisitHandler) CreateVisit(c *gin.Context) {
 //	@Failure		404	{object}	apperror.ErrorResponse
 //	@Router			/api/v1/visits/{id} [delete]
 func (h *VisitHandler) DeleteVisit(c *gin.Context) {
-	userID, ok := c.MustGet(middleware.ContextKeyUserID).(uint)
+	principal, ok := c.MustGet(middleware.ContextKeyPrincipal).(domain.Principal)
 	if !ok {
 		apperror.RespondWithError(c, apperror.AppErrSystemInternal)
 		return
@@ -153,7 +154,7 @@ func (h *VisitHandler) DeleteVisit(c *gin.Context) {
 		return
 	}
 
-	if err := h.visitService.Delete(c.Request.Context(), uint(id), userID); err != nil {
+	if err := h.visitService.Delete(c.Request.Context(), uint(id), principal); err != nil {
 		apperror.RespondWithMappedError(c, err)
 		return
 	}
@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func AccountDataToExportResponse(data *domain.AccountData) responses.AccountExportResponse {
+	response := responses.AccountExportResponse{
+		Profile:            UserToResponse(&data.User),
+		Spots:              make([]responses.SpotResponse, len(data.Spots)),
+		Photos:             PhotosToResponse(data.Photos),
+		Visits:             VisitsToListResponse(data.Visits),
+		Favorites:          make([]responses.ExportFavoriteResponse, len(data.Favorites)),
+		Reviews:            ReviewsToResponse(data.Reviews),
+		Activities:         ActivitiesToListResponse(data.Activities),
+		Notifications:      NotificationsToResponse(data.Notifications),
+		Follows:            make([]responses.ExportFollowResponse, len(data.Follows)),
+		Sessions:           SessionsToResponse(data.Sessions, 0),
+		ExternalIdentities: ExternalIdentitiesToResponse(data.ExternalIdentities),
+		AccessTokens:       PersonalAccessTokensToResponse(data.AccessTokens),
+		SecurityEvents:     make([]responses.ExportSecurityEventResponse, len(data.SecurityEvents)),
+		InvitationCodes:    make([]responses.ExportInvitationResponse, len(data.InvitationCodes)),
+	}
+
+	for i, spot := range data.Spots {
+		response.Spots[i] = SpotToResponse(&spot)
+	}
+	for i, favorite := range data.Favorites {
+		response.Favorites[i] = responses.ExportFavoriteResponse{
+			SpotID:    favorite.SpotID,
+			CreatedAt: favorite.CreatedAt,
+		}
+	}
+	for i, follow := range data.Follows {
+		response.Follows[i] = responses.ExportFollowResponse{
+			FollowerID: follow.FollowerID,
+			FolloweeID: follow.FolloweeID,
+			Status:     string(follow.Status),
+			CreatedAt:  follow.CreatedAt,
+		}
+	}
+	for i, event := range data.SecurityEvents {
+		response.SecurityEvents[i] = responses.ExportSecurityEventResponse{
+			Type:      event.Type,
+			IPAddress: event.IPAddress,
+			UserAgent: event.UserAgent,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt,
+		}
+	}
+	for i, code := range data.InvitationCodes {
+		response.InvitationCodes[i] = responses.ExportInvitationResponse{
+			Code:      code.Code,
+			Comment:   code.Comment,
+			MaxUses:   code.MaxUses,
+			UseCount:  code.UseCount,
+			CreatedAt: code.CreatedAt,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func CreateAmenityRequestToDomain(req *requests.CreateAmenityRequest) *domain.Amenity {
+	return &domain.Amenity{
+		Key:  req.Key,
+		Name: req.Name,
+		Icon: req.Icon,
+	}
+}
+
+func AmenityToResponse(amenity *domain.Amenity) responses.AmenityResponse {
+	return responses.AmenityResponse{
+		ID:   amenity.ID,
+		Key:  amenity.Key,
+		Name: amenity.Name,
+		Icon: amenity.Icon,
+	}
+}
+
+func AmenitiesToResponse(amenities []domain.Amenity) []responses.AmenityResponse {
+	result := make([]responses.AmenityResponse, len(amenities))
+	for i, amenity := range amenities {
+		result[i] = AmenityToResponse(&amenity)
+	}
+	return result
+}
@@ -0,0 +1,43 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func AuditEventToResponse(event *domain.AuditEvent) responses.AuditEventResponse {
+	response := responses.AuditEventResponse{
+		ID:         event.ID,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		IPAddress:  event.IPAddress,
+		UserAgent:  event.UserAgent,
+		CreatedAt:  event.CreatedAt,
+	}
+
+	if event.Actor != nil {
+		actor := UserToResponse(event.Actor)
+		response.Actor = &actor
+	}
+
+	if len(event.Changes) > 0 {
+		response.Changes = make(map[string]responses.AuditChangeResponse, len(event.Changes))
+		for field, change := range event.Changes {
+			response.Changes[field] = responses.AuditChangeResponse{
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+	}
+
+	return response
+}
+
+func AuditEventsToResponse(events []domain.AuditEvent) []responses.AuditEventResponse {
+	result := make([]responses.AuditEventResponse, len(events))
+	for i, event := range events {
+		result[i] = AuditEventToResponse(&event)
+	}
+	return result
+}
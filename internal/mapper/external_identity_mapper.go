@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func ExternalIdentityToResponse(identity *domain.ExternalIdentity) responses.ExternalIdentityResponse {
+	return responses.ExternalIdentityResponse{
+		Provider:    identity.Provider,
+		Email:       identity.Email,
+		CreatedAt:   identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
+
+func ExternalIdentitiesToResponse(identities []domain.ExternalIdentity) []responses.ExternalIdentityResponse {
+	result := make([]responses.ExternalIdentityResponse, len(identities))
+	for i, identity := range identities {
+		result[i] = ExternalIdentityToResponse(&identity)
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func FollowUserToResponse(user *domain.User) responses.FollowUserResponse {
+	return responses.FollowUserResponse{
+		ID:          user.ID,
+		DisplayName: user.DisplayName,
+	}
+}
+
+// FollowToResponse maps an edge from the point of view of one side - other is the user on the other end
+func FollowToResponse(follow *domain.Follow, other *domain.User) responses.FollowResponse {
+	return responses.FollowResponse{
+		ID:        follow.ID,
+		User:      FollowUserToResponse(other),
+		Status:    string(follow.Status),
+		CreatedAt: follow.CreatedAt,
+	}
+}
+
+// FriendsToResponse maps accepted outgoing edges (Followee loaded) to friends
+func FriendsToResponse(follows []domain.Follow) []responses.FriendResponse {
+	result := make([]responses.FriendResponse, len(follows))
+	for i, follow := range follows {
+		result[i] = responses.FriendResponse{
+			User:  FollowUserToResponse(&follow.Followee),
+			Since: follow.UpdatedAt,
+		}
+	}
+	return result
+}
+
+// FollowRequestsToResponse maps incoming edges (Follower loaded) to friend requests
+func FollowRequestsToResponse(follows []domain.Follow) []responses.FollowResponse {
+	result := make([]responses.FollowResponse, len(follows))
+	for i, follow := range follows {
+		result[i] = FollowToResponse(&follow, &follow.Follower)
+	}
+	return result
+}
@@ -15,10 +15,19 @@ func InvitationCodesToResponse(codes []domain.InvitationCode) []responses.Invita
 
 func InvitationCodeToResponse(code *domain.InvitationCode) responses.InvitationCodeResponse {
 	response := responses.InvitationCodeResponse{
-		ID:        code.ID,
-		Code:      code.Code,
-		Comment:   code.Comment,
-		CreatedAt: code.CreatedAt,
+		ID:          code.ID,
+		Code:        code.Code,
+		Comment:     code.Comment,
+		MaxUses:     code.MaxUses,
+		UseCount:    code.UseCount,
+		ExpiresAt:   code.ExpiresAt,
+		Redemptions: make([]responses.InvitationRedemptionResponse, len(code.Redemptions)),
+		CreatedAt:   code.CreatedAt,
+	}
+
+	if code.Role != nil {
+		role := string(*code.Role)
+		response.Role = &role
 	}
 
 	// Creator (with nil check)
@@ -26,12 +35,44 @@ func InvitationCodeToResponse(code *domain.InvitationCode) responses.InvitationC
 		response.CreatedBy = UserToResponse(code.Creator)
 	}
 
-	// Redeemer (if exists)
-	if code.Redeemer != nil {
-		redeemerResponse := UserToResponse(code.Redeemer)
-		response.RedeemedBy = &redeemerResponse
-		response.RedeemedAt = &code.UpdatedAt
+	// Redemptions, the first one also fills the fields of single-use codes
+	for i, redemption := range code.Redemptions {
+		response.Redemptions[i] = responses.InvitationRedemptionResponse{
+			User:       UserToResponse(&redemption.User),
+			RedeemedAt: redemption.CreatedAt,
+		}
+	}
+	if len(response.Redemptions) > 0 {
+		response.RedeemedBy = &response.Redemptions[0].User
+		response.RedeemedAt = &response.Redemptions[0].RedeemedAt
 	}
 
 	return response
 }
+
+func InvitationToResponse(code *domain.InvitationCode) responses.InvitationResponse {
+	response := responses.InvitationResponse{
+		ID:           code.ID,
+		Code:         code.Code,
+		Comment:      code.Comment,
+		MaxUses:      code.MaxUses,
+		UseCount:     code.UseCount,
+		ExpiresAt:    code.ExpiresAt,
+		InvitedUsers: make([]responses.FollowUserResponse, len(code.Redemptions)),
+		CreatedAt:    code.CreatedAt,
+	}
+
+	for i, redemption := range code.Redemptions {
+		response.InvitedUsers[i] = FollowUserToResponse(&redemption.User)
+	}
+
+	return response
+}
+
+func InvitationsToResponse(codes []domain.InvitationCode) []responses.InvitationResponse {
+	result := make([]responses.InvitationResponse, len(codes))
+	for i, code := range codes {
+		result[i] = InvitationToResponse(&code)
+	}
+	return result
+}
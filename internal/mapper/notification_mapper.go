@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func NotificationToResponse(notification *domain.Notification) responses.NotificationResponse {
+	return responses.NotificationResponse{
+		ID:            notification.ID,
+		Type:          notification.Type,
+		Title:         notification.Title,
+		Message:       notification.Message,
+		RelatedSpotID: notification.RelatedSpotID,
+		RelatedUserID: notification.RelatedUserID,
+		IsRead:        notification.IsRead,
+		SentAt:        notification.SentAt,
+		ReadAt:        notification.ReadAt,
+	}
+}
+
+func NotificationsToResponse(notifications []domain.Notification) []responses.NotificationResponse {
+	result := make([]responses.NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		result[i] = NotificationToResponse(&notification)
+	}
+	return result
+}
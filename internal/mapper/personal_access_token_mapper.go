@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func PersonalAccessTokenToResponse(token *domain.PersonalAccessToken) responses.PersonalAccessTokenResponse {
+	scopes := make([]string, len(token.Scopes))
+	for i, scope := range token.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return responses.PersonalAccessTokenResponse{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
+
+func PersonalAccessTokensToResponse(tokens []domain.PersonalAccessToken) []responses.PersonalAccessTokenResponse {
+	result := make([]responses.PersonalAccessTokenResponse, len(tokens))
+	for i, token := range tokens {
+		result[i] = PersonalAccessTokenToResponse(&token)
+	}
+	return result
+}
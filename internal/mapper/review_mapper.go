@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func CreateReviewRequestToDomain(req *requests.CreateReviewRequest, spotID, userID uint) *domain.SpotReview {
+	review := &domain.SpotReview{
+		SpotID: spotID,
+		UserID: userID,
+		Stars:  req.Stars,
+	}
+
+	if req.Comment != nil {
+		review.Comment = *req.Comment
+	}
+
+	return review
+}
+
+func ReviewToResponse(review *domain.SpotReview) responses.ReviewResponse {
+	return responses.ReviewResponse{
+		ID:     review.ID,
+		SpotID: review.SpotID,
+		User: responses.ReviewUserResponse{
+			ID:          review.User.ID,
+			DisplayName: review.User.DisplayName,
+		},
+		Stars:     review.Stars,
+		Comment:   review.Comment,
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+}
+
+func ReviewsToResponse(reviews []domain.SpotReview) []responses.ReviewResponse {
+	result := make([]responses.ReviewResponse, len(reviews))
+	for i, review := range reviews {
+		result[i] = ReviewToResponse(&review)
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/responses"
+)
+
+func SessionToResponse(token *domain.RefreshToken, currentSessionID uint) responses.SessionResponse {
+	return responses.SessionResponse{
+		ID:         token.ID,
+		DeviceName: token.DeviceName,
+		Platform:   token.Platform,
+		UserAgent:  token.UserAgent,
+		IPAddress:  token.IPAddress,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: token.LastUsedAt,
+		Current:    token.ID == currentSessionID,
+	}
+}
+
+func SessionsToResponse(tokens []domain.RefreshToken, currentSessionID uint) []responses.SessionResponse {
+	result := make([]responses.SessionResponse, len(tokens))
+	for i, token := range tokens {
+		result[i] = SessionToResponse(&token, currentSessionID)
+	}
+	return result
+}
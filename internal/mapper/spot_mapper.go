@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"math"
+
 	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
 	"hopSpotAPI/internal/dto/responses"
@@ -8,11 +10,9 @@ import (
 
 func CreateSpotRequestToDomain(req *requests.CreateSpotRequest) *domain.Spot {
 	spot := &domain.Spot{
-		Name:        req.Name,
-		Latitude:    req.Latitude,
-		Longitude:   req.Longitude,
-		HasToilet:   req.HasToilet,
-		HasTrashBin: req.HasTrashBin,
+		Name:      req.Name,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
 	}
 
 	// Handle optional fields with nil checks
@@ -20,38 +20,42 @@ func CreateSpotRequestToDomain(req *requests.CreateSpotRequest) *domain.Spot {
 		spot.Description = *req.Description
 	}
 
-	if req.Rating != nil {
-		spot.Rating = req.Rating
-	}
+	// Rating is stored as the creator's review, amenities are resolved in the service layer
 
 	return spot
 }
 
 func SpotToResponse(spot *domain.Spot) responses.SpotResponse {
 	return responses.SpotResponse{
-		ID:          spot.ID,
-		Name:        spot.Name,
-		Latitude:    spot.Latitude,
-		Longitude:   spot.Longitude,
-		Description: &spot.Description,
-		Rating:      spot.Rating,
-		HasToilet:   spot.HasToilet,
-		HasTrashBin: spot.HasTrashBin,
-		CreatedBy:   UserToResponse(&spot.Creator),
-		CreatedAt:   spot.CreatedAt,
-		UpdatedAt:   spot.UpdatedAt,
+		ID:            spot.ID,
+		Name:          spot.Name,
+		Latitude:      spot.Latitude,
+		Longitude:     spot.Longitude,
+		Description:   &spot.Description,
+		Rating:        RoundRating(spot.AverageRating),
+		AverageRating: spot.AverageRating,
+		ReviewCount:   spot.ReviewCount,
+		Amenities:     AmenitiesToResponse(spot.Amenities),
+		HasToilet:     spot.HasAmenity(domain.AmenityKeyToilet),
+		HasTrashBin:   spot.HasAmenity(domain.AmenityKeyTrashBin),
+		CreatedBy:     UserToResponse(&spot.Creator),
+		CreatedAt:     spot.CreatedAt,
+		UpdatedAt:     spot.UpdatedAt,
 	}
 }
 
 func SpotToListResponse(spot *domain.Spot) responses.SpotListResponse {
 	return responses.SpotListResponse{
-		ID:          spot.ID,
-		Name:        spot.Name,
-		Latitude:    spot.Latitude,
-		Longitude:   spot.Longitude,
-		Rating:      spot.Rating,
-		HasToilet:   spot.HasToilet,
-		HasTrashBin: spot.HasTrashBin,
+		ID:            spot.ID,
+		Name:          spot.Name,
+		Latitude:      spot.Latitude,
+		Longitude:     spot.Longitude,
+		Rating:        RoundRating(spot.AverageRating),
+		AverageRating: spot.AverageRating,
+		ReviewCount:   spot.ReviewCount,
+		Amenities:     spot.AmenityKeys(),
+		HasToilet:     spot.HasAmenity(domain.AmenityKeyToilet),
+		HasTrashBin:   spot.HasAmenity(domain.AmenityKeyTrashBin),
 		// MainPhotoURL und Distance werden separat gesetzt
 	}
 }
@@ -63,3 +67,12 @@ func SpotsToListResponse(spots []domain.Spot) []responses.SpotListResponse {
 	}
 	return result
 }
+
+// RoundRating converts an average rating to the legacy whole-star value
+func RoundRating(average *float64) *int {
+	if average == nil {
+		return nil
+	}
+	rounded := int(math.Round(*average))
+	return &rounded
+}
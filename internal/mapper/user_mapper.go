@@ -23,6 +23,9 @@ func UserToResponse(user *domain.User) responses.UserResponse {
 		Role:        string(user.Role),
 		IsActive:    user.IsActive,
 		CreatedAt:   user.CreatedAt,
+
+		EmailVerifiedAt:  user.EmailVerifiedAt,
+		TwoFactorEnabled: user.IsTwoFactorEnabled(),
 	}
 }
 
@@ -41,3 +44,13 @@ func UsersToResponse(users []domain.User) []responses.UserResponse {
 	}
 	return responsesList
 }
+
+func UserToPublicProfileResponse(user *domain.User, stats responses.UserStatsResponse, activities []domain.Activity) responses.PublicProfileResponse {
+	return responses.PublicProfileResponse{
+		ID:               user.ID,
+		DisplayName:      user.DisplayName,
+		MemberSince:      user.CreatedAt,
+		Stats:            stats,
+		RecentActivities: ActivitiesToListResponse(activities),
+	}
+}
@@ -1,22 +1,39 @@
 package middleware
 
 import (
+	"errors"
+	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/service"
 	"hopSpotAPI/pkg/apperror"
 	"hopSpotAPI/pkg/utils"
 )
 
 type AuthMiddleware struct {
-	jwtSecret string
+	jwtKeys                    *utils.JWTKeys
+	tokenVersionService        service.TokenVersionService
+	personalAccessTokenService service.PersonalAccessTokenService
+	requireAdminTwoFactor      bool
 }
 
-func NewAuthMiddleware(jwtSecret string) *AuthMiddleware {
-	return &AuthMiddleware{jwtSecret: jwtSecret}
+func NewAuthMiddleware(
+	jwtKeys *utils.JWTKeys,
+	tokenVersionService service.TokenVersionService,
+	personalAccessTokenService service.PersonalAccessTokenService,
+	requireAdminTwoFactor bool,
+) *AuthMiddleware {
+	return &AuthMiddleware{
+		jwtKeys:                    jwtKeys,
+		tokenVersionService:        tokenVersionService,
+		personalAccessTokenService: personalAccessTokenService,
+		requireAdminTwoFactor:      requireAdminTwoFactor,
+	}
 }
 
 func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
@@ -35,8 +52,13 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			return
 		}
 
+		if strings.HasPrefix(tokenString, domain.PersonalAccessTokenPrefix) {
+			m.authenticatePersonalAccessToken(c, tokenString)
+			return
+		}
+
 		// Validating the token
-		claims, err := utils.ValidateJWT(tokenString, m.jwtSecret)
+		claims, err := utils.ValidateJWT(tokenString, m.jwtKeys)
 		if err != nil {
 			apperror.AbortWithError(c, apperror.AppErrTokenExpired)
 			return
@@ -49,21 +71,121 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			return
 		}
 
+		// Deactivation, role or password changes revoke tokens before they expire
+		version, err := m.tokenVersionService.Current(c.Request.Context(), uint(userID))
+		if err != nil {
+			if errors.Is(err, apperror.ErrUserNotFound) {
+				apperror.AbortWithError(c, apperror.AppErrTokenRevoked)
+				return
+			}
+			apperror.AbortWithError(c, apperror.AppErrSystemInternal)
+			return
+		}
+		if claims.TokenVersion != version {
+			apperror.AbortWithError(c, apperror.AppErrTokenRevoked)
+			return
+		}
+
 		// Storing user information in context
 		c.Set(ContextKeyUserEmail, claims.Email)
 		c.Set(ContextKeyUserRole, claims.Role)
 		c.Set(ContextKeyUserID, uint(userID))
+		c.Set(ContextKeySessionID, claims.SessionID)
+		c.Set(ContextKeyTwoFactor, claims.TwoFactor)
+		m.setPrincipal(c, uint(userID), claims.Role, claims.TwoFactor)
+
+		c.Next()
+	}
+}
+
+// authenticatePersonalAccessToken is the Authenticate path of scripts and integrations.
+// The scopes are checked by RequireScope of the route group.
+func (m *AuthMiddleware) authenticatePersonalAccessToken(c *gin.Context, rawToken string) {
+	token, err := m.personalAccessTokenService.Authenticate(c.Request.Context(), rawToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrInvalidToken):
+			apperror.AbortWithError(c, apperror.AppErrInvalidToken)
+		case errors.Is(err, apperror.ErrAccountDeactivated):
+			apperror.AbortWithError(c, apperror.AppErrAccountDeactivated)
+		default:
+			apperror.AbortWithError(c, apperror.AppErrSystemInternal)
+		}
+		return
+	}
+
+	c.Set(ContextKeyUserEmail, token.User.Email)
+	c.Set(ContextKeyUserRole, token.User.Role)
+	c.Set(ContextKeyUserID, token.UserID)
+	c.Set(ContextKeySessionID, uint(0))
+	c.Set(ContextKeyTwoFactor, token.User.IsTwoFactorEnabled())
+	m.setPrincipal(c, token.UserID, token.User.Role, token.User.IsTwoFactorEnabled())
+	c.Set(ContextKeyTokenScopes, token.Scopes)
+
+	c.Next()
+}
+
+// RequireScope limits personal access tokens to their scopes: read for GET and HEAD requests, write for all others.
+// An empty scope rejects tokens for those methods, requests with a login session always pass.
+func (m *AuthMiddleware) RequireScope(read, write domain.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, isToken := c.Get(ContextKeyTokenScopes)
+		if !isToken {
+			c.Next()
+			return
+		}
+
+		required := write
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			required = read
+		}
+
+		scopes, ok := value.([]domain.Scope)
+		if !ok || required == "" || !slices.Contains(scopes, required) {
+			apperror.AbortWithError(c, apperror.AppErrInsufficientScope)
+			return
+		}
 
 		c.Next()
 	}
 }
 
-func (m *AuthMiddleware) RequireAdmin() gin.HandlerFunc {
+// setPrincipal stores who the request acts for, together with the 2FA policy for staff
+func (m *AuthMiddleware) setPrincipal(c *gin.Context, userID uint, role domain.Role, twoFactor bool) {
+	c.Set(ContextKeyPrincipal, domain.Principal{
+		UserID:           userID,
+		Role:             role,
+		TwoFactor:        twoFactor,
+		RequireTwoFactor: m.requireAdminTwoFactor,
+	})
+}
+
+// RequireSession rejects personal access tokens, for account and session management
+func (m *AuthMiddleware) RequireSession() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		role, exists := c.Get("userRole")
-		roleValue, ok := role.(domain.Role)
-		if !exists || !ok || roleValue != domain.RoleAdmin {
-			apperror.AbortWithError(c, apperror.AppErrAdminRequired)
+		if _, isToken := c.Get(ContextKeyTokenScopes); isToken {
+			apperror.AbortWithError(c, apperror.AppErrSessionRequired)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequirePermission rejects requests of roles without the permission, see domain.Permission
+func (m *AuthMiddleware) RequirePermission(permission domain.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, ok := c.Get(ContextKeyUserRole)
+		roleValue, isRole := role.(domain.Role)
+		if !ok || !isRole || !roleValue.Can(permission) {
+			apperror.AbortWithError(c, apperror.AppErrPermissionDenied.WithDetails(string(permission)))
+			return
+		}
+
+		// Staff has to set up 2FA before using permissions regular users don't have
+		principal, _ := c.Get(ContextKeyPrincipal)
+		if principalValue, _ := principal.(domain.Principal); !principalValue.Can(permission) {
+			apperror.AbortWithError(c, apperror.AppErrTwoFactorRequired)
 			return
 		}
 
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hopSpotAPI/internal/dto/requests"
+)
+
+// maxUserAgentLength matches the column size of the stored user agent
+const maxUserAgentLength = 255
+
+// ClientInfo puts the user agent and IP of the request into the request context,
+// where security and audit events read them from
+func ClientInfo() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAgent := c.Request.UserAgent()
+		if len(userAgent) > maxUserAgentLength {
+			userAgent = userAgent[:maxUserAgentLength]
+		}
+
+		ctx := requests.ContextWithClientInfo(c.Request.Context(), requests.ClientInfo{
+			UserAgent: userAgent,
+			IPAddress: c.ClientIP(),
+		})
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -4,4 +4,10 @@ const (
 	ContextKeyUserID    = "userID"
 	ContextKeyUserEmail = "userEmail"
 	ContextKeyUserRole  = "userRole"
+	ContextKeySessionID = "sessionID"
+	ContextKeyTwoFactor = "twoFactor"
+	// ContextKeyPrincipal holds the domain.Principal for ownership checks in services
+	ContextKeyPrincipal = "principal"
+	// ContextKeyTokenScopes is only set for requests with a personal access token
+	ContextKeyTokenScopes = "tokenScopes"
 )
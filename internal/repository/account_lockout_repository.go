@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hopSpotAPI/internal/domain"
+)
+
+type accountLockoutRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountLockoutRepository(db *gorm.DB) AccountLockoutRepository {
+	return &accountLockoutRepository{db: db}
+}
+
+func (r *accountLockoutRepository) FindByUserID(ctx context.Context, userID uint) (*domain.AccountLockout, error) {
+	var lockout domain.AccountLockout
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&lockout).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// RecordFailure increments in a single upsert, so parallel attempts can't overwrite each other's count
+func (r *accountLockoutRepository) RecordFailure(ctx context.Context, userID uint, resetBefore time.Time) (*domain.AccountLockout, error) {
+	now := time.Now()
+	lockout := domain.AccountLockout{
+		UserID:         userID,
+		FailedAttempts: 1,
+		LastFailedAt:   now,
+	}
+
+	err := r.db.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "user_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"failed_attempts": gorm.Expr(
+						"CASE WHEN account_lockouts.last_failed_at < ? THEN 1 ELSE account_lockouts.failed_attempts + 1 END",
+						resetBefore,
+					),
+					"last_failed_at": now,
+				}),
+			},
+			clause.Returning{},
+		).
+		Create(&lockout).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+func (r *accountLockoutRepository) Lock(ctx context.Context, userID uint, until time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.AccountLockout{}).
+		Where("user_id = ?", userID).
+		Update("locked_until", until).Error
+}
+
+func (r *accountLockoutRepository) Delete(ctx context.Context, userID uint) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&domain.AccountLockout{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
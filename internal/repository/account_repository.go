@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"hopSpotAPI/internal/domain"
+)
+
+// deletedUserDisplayName replaces the name of deleted accounts, their spots and photos keep pointing to the row
+const deletedUserDisplayName = "Deleted user"
+
+type accountRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountRepository(db *gorm.DB) AccountRepository {
+	return &accountRepository{db: db}
+}
+
+func (r *accountRepository) FindData(ctx context.Context, userID uint) (*domain.AccountData, error) {
+	var data domain.AccountData
+	if err := r.db.WithContext(ctx).First(&data.User, "id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	db := r.db.WithContext(ctx)
+	finds := []struct {
+		dest  interface{}
+		query *gorm.DB
+	}{
+		{&data.Spots, db.Preload("Creator").Preload("Amenities").Where("created_by = ?", userID)},
+		{&data.Photos, db.Where("uploaded_by = ?", userID)},
+		{&data.Visits, db.Preload("Spot").Where("user_id = ?", userID)},
+		{&data.Favorites, db.Where("user_id = ?", userID)},
+		{&data.Reviews, db.Preload("User").Where("user_id = ?", userID)},
+		{&data.Activities, db.Preload("User").Preload("Spot").Where("user_id = ?", userID)},
+		{&data.Notifications, db.Where("user_id = ?", userID)},
+		// Blocks by other users are their data, the blocked user must not learn about them
+		{&data.Follows, db.Where("follower_id = ? OR (followee_id = ? AND status <> ?)", userID, userID, domain.FollowStatusBlocked)},
+		{&data.Sessions, db.Where("user_id = ?", userID)},
+		{&data.ExternalIdentities, db.Where("user_id = ?", userID)},
+		{&data.AccessTokens, db.Where("user_id = ?", userID)},
+		{&data.SecurityEvents, db.Where("user_id = ?", userID)},
+		{&data.InvitationCodes, db.Where("created_by = ?", userID)},
+	}
+	for _, find := range finds {
+		if err := find.query.Order("id").Find(find.dest).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+// Delete removes the personal data of a user in one transaction.
+// Spots and photos stay: with an owner they are handed over, otherwise they keep
+// pointing to the scrubbed and soft-deleted user row and show no author.
+func (r *accountRepository) Delete(ctx context.Context, userID uint, spotOwnerID *uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Reviews - the ratings of the reviewed spots are recalculated
+		var reviewedSpotIDs []uint
+		if err := tx.Model(&domain.SpotReview{}).Where("user_id = ?", userID).Pluck("spot_id", &reviewedSpotIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&domain.SpotReview{}).Error; err != nil {
+			return err
+		}
+		for _, spotID := range reviewedSpotIDs {
+			if err := refreshSpotRating(tx, spotID); err != nil {
+				return err
+			}
+		}
+
+		// Social graph and notifications
+		if err := tx.Where("follower_id = ? OR followee_id = ?", userID, userID).Delete(&domain.Follow{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("user_id = ?", userID).Delete(&domain.Notification{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&domain.Notification{}).Where("related_user_id = ?", userID).Update("related_user_id", nil).Error; err != nil {
+			return err
+		}
+
+		// Sessions including the hashes of their token families
+		if err := tx.Where("family_id IN (?)",
+			tx.Unscoped().Model(&domain.RefreshToken{}).Select("id").Where("user_id = ?", userID),
+		).Delete(&domain.RotatedRefreshToken{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("user_id = ?", userID).Delete(&domain.RefreshToken{}).Error; err != nil {
+			return err
+		}
+
+		// Everything else that belongs to the user alone (including soft-deleted visits)
+		for _, model := range []interface{}{
+			&domain.Visit{},
+			&domain.Favorite{},
+			&domain.Activity{},
+			&domain.PasswordResetToken{},
+			&domain.EmailToken{},
+			&domain.RecoveryCode{},
+			&domain.TwoFactorChallenge{},
+			&domain.ExternalIdentity{},
+			&domain.PersonalAccessToken{},
+			&domain.AccountLockout{},
+			&domain.SecurityEvent{},
+		} {
+			if err := tx.Unscoped().Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		// Unused invitation codes can't be redeemed anymore, redeemed ones document who invited whom
+		if err := tx.Unscoped().Where("created_by = ? AND use_count = 0", userID).Delete(&domain.InvitationCode{}).Error; err != nil {
+			return err
+		}
+
+		// Authored content
+		if spotOwnerID != nil {
+			if err := tx.Model(&domain.Spot{}).Where("created_by = ?", userID).Update("created_by", *spotOwnerID).Error; err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Model(&domain.Photo{}).Where("uploaded_by = ?", userID).Update("uploaded_by", *spotOwnerID).Error; err != nil {
+				return err
+			}
+		}
+
+		// Scrub the user row, the email is freed for a new registration
+		if err := tx.Model(&domain.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"email":             fmt.Sprintf("deleted-%d@deleted.invalid", userID),
+			"display_name":      deletedUserDisplayName,
+			"password_hash":     "",
+			"fcm_token":         nil,
+			"is_active":         false,
+			"email_verified_at": nil,
+			"totp_secret":       nil,
+			"totp_enabled_at":   nil,
+		}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.User{}, userID).Error
+	})
+}
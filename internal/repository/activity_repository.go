@@ -35,6 +35,15 @@ func (r *activityRepository) FindAll(ctx context.Context, filter ActivityFilter)
 		query = query.Where("action_type = ?", *filter.ActionType)
 	}
 
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+
+	// Friends scope: own activities and those of accepted friends
+	if filter.FriendsOf != nil {
+		query = query.Where("user_id = ? OR user_id IN (?)", *filter.FriendsOf, followeeIDs(r.db, *filter.FriendsOf))
+	}
+
 	// Count total records
 	if err := query.Count(&count).Error; err != nil {
 		return nil, 0, err
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"hopSpotAPI/internal/domain"
+)
+
+type amenityRepository struct {
+	db *gorm.DB
+}
+
+func NewAmenityRepository(db *gorm.DB) AmenityRepository {
+	return &amenityRepository{db: db}
+}
+
+func (r *amenityRepository) Create(ctx context.Context, amenity *domain.Amenity) error {
+	return r.db.WithContext(ctx).Create(amenity).Error
+}
+
+func (r *amenityRepository) FindByID(ctx context.Context, id uint) (*domain.Amenity, error) {
+	var amenity domain.Amenity
+	err := r.db.WithContext(ctx).First(&amenity, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &amenity, nil
+}
+
+func (r *amenityRepository) Update(ctx context.Context, amenity *domain.Amenity) error {
+	return r.db.WithContext(ctx).Save(amenity).Error
+}
+
+// Delete removes the amenity and untags all spots
+func (r *amenityRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM spot_amenities WHERE amenity_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Amenity{}, id).Error
+	})
+}
+
+func (r *amenityRepository) FindAll(ctx context.Context) ([]domain.Amenity, error) {
+	var amenities []domain.Amenity
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&amenities).Error; err != nil {
+		return nil, err
+	}
+	return amenities, nil
+}
+
+func (r *amenityRepository) FindByKey(ctx context.Context, key string) (*domain.Amenity, error) {
+	var amenity domain.Amenity
+	err := r.db.WithContext(ctx).Where("key = ?", key).First(&amenity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &amenity, nil
+}
+
+// FindByKeys returns the amenities for the given keys - unknown keys are skipped
+func (r *amenityRepository) FindByKeys(ctx context.Context, keys []string) ([]domain.Amenity, error) {
+	var amenities []domain.Amenity
+	if len(keys) == 0 {
+		return amenities, nil
+	}
+	if err := r.db.WithContext(ctx).Where("key IN ?", keys).Find(&amenities).Error; err != nil {
+		return nil, err
+	}
+	return amenities, nil
+}
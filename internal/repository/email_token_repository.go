@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"hopSpotAPI/internal/domain"
+)
+
+type emailTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailTokenRepository(db *gorm.DB) EmailTokenRepository {
+	return &emailTokenRepository{db: db}
+}
+
+func (r *emailTokenRepository) Create(ctx context.Context, token *domain.EmailToken) error {
+	return r.db.WithContext(ctx).Create(token).Error
+}
+
+func (r *emailTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailToken, error) {
+	var token domain.EmailToken
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("token_hash = ?", tokenHash).
+		First(&token).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *emailTokenRepository) MarkAsUsed(ctx context.Context, id uint) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.EmailToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *emailTokenRepository) InvalidateByUserID(ctx context.Context, userID uint, purpose string) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.EmailToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", time.Now()).Error
+}
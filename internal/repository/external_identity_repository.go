@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"hopSpotAPI/internal/domain"
+)
+
+type externalIdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewExternalIdentityRepository(db *gorm.DB) ExternalIdentityRepository {
+	return &externalIdentityRepository{db: db}
+}
+
+func (r *externalIdentityRepository) Create(ctx context.Context, identity *domain.ExternalIdentity) error {
+	return r.db.WithContext(ctx).Create(identity).Error
+}
+
+func (r *externalIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*domain.ExternalIdentity, error) {
+	var identity domain.ExternalIdentity
+	err := r.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *externalIdentityRepository) FindByUserID(ctx context.Context, userID uint) ([]domain.ExternalIdentity, error) {
+	var identities []domain.ExternalIdentity
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("provider ASC").
+		Find(&identities).Error
+	return identities, err
+}
+
+func (r *externalIdentityRepository) UpdateLastLogin(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.ExternalIdentity{}).
+		Where("id = ?", id).
+		Update("last_login_at", time.Now()).Error
+}
+
+func (r *externalIdentityRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&domain.ExternalIdentity{}, id).Error
+}
+
+type oidcAuthRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewOIDCAuthRequestRepository(db *gorm.DB) OIDCAuthRequestRepository {
+	return &oidcAuthRequestRepository{db: db}
+}
+
+func (r *oidcAuthRequestRepository) Create(ctx context.Context, request *domain.OIDCAuthRequest) error {
+	return r.db.WithContext(ctx).Create(request).Error
+}
+
+func (r *oidcAuthRequestRepository) FindByStateHash(ctx context.Context, stateHash string) (*domain.OIDCAuthRequest, error) {
+	var request domain.OIDCAuthRequest
+	err := r.db.WithContext(ctx).
+		Where("state_hash = ?", stateHash).
+		First(&request).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *oidcAuthRequestRepository) MarkAsUsed(ctx context.Context, id uint) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.OIDCAuthRequest{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
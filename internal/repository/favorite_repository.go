@@ -75,6 +75,7 @@ func (r *favoriteRepository) FindByUserID(ctx context.Context, userID uint, filt
 	err := query.
 		Preload("Spot").
 		Preload("Spot.Creator").
+		Preload("Spot.Amenities").
 		Order("created_at DESC").
 		Find(&favorites).Error
 
@@ -97,3 +98,16 @@ func (r *favoriteRepository) GetSpotIDsByUserID(ctx context.Context, userID uint
 	}
 	return spotIDs, nil
 }
+
+func (r *favoriteRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.Favorite{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hopSpotAPI/internal/domain"
+)
+
+type followRepository struct {
+	db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) FollowRepository {
+	return &followRepository{db: db}
+}
+
+// followerIDs is a subquery for the users with an accepted edge to the user
+func followerIDs(db *gorm.DB, userID uint) *gorm.DB {
+	return db.Model(&domain.Follow{}).
+		Select("follower_id").
+		Where("followee_id = ? AND status = ?", userID, domain.FollowStatusAccepted)
+}
+
+// followeeIDs is a subquery for the users the user has an accepted edge to
+func followeeIDs(db *gorm.DB, userID uint) *gorm.DB {
+	return db.Model(&domain.Follow{}).
+		Select("followee_id").
+		Where("follower_id = ? AND status = ?", userID, domain.FollowStatusAccepted)
+}
+
+func (r *followRepository) Create(ctx context.Context, follow *domain.Follow) error {
+	return r.db.WithContext(ctx).Create(follow).Error
+}
+
+func (r *followRepository) FindByID(ctx context.Context, id uint) (*domain.Follow, error) {
+	var follow domain.Follow
+	err := r.db.WithContext(ctx).
+		Preload("Follower").
+		Preload("Followee").
+		First(&follow, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &follow, nil
+}
+
+func (r *followRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&domain.Follow{}, id).Error
+}
+
+func (r *followRepository) Find(ctx context.Context, followerID, followeeID uint) (*domain.Follow, error) {
+	var follow domain.Follow
+	err := r.db.WithContext(ctx).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		First(&follow).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &follow, nil
+}
+
+func (r *followRepository) Accept(ctx context.Context, follow *domain.Follow) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		follow.Status = domain.FollowStatusAccepted
+		if err := tx.Model(follow).Update("status", follow.Status).Error; err != nil {
+			return err
+		}
+
+		// Friendship goes both ways - also replaces a pending request in the other direction
+		reverse := &domain.Follow{
+			FollowerID: follow.FolloweeID,
+			FolloweeID: follow.FollowerID,
+			Status:     domain.FollowStatusAccepted,
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "follower_id"}, {Name: "followee_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "updated_at"}),
+		}).Create(reverse).Error
+	})
+}
+
+func (r *followRepository) DeleteBetween(ctx context.Context, userID, otherUserID uint) error {
+	return r.db.WithContext(ctx).
+		Where("(follower_id = ? AND followee_id = ?) OR (follower_id = ? AND followee_id = ?)",
+			userID, otherUserID, otherUserID, userID).
+		Delete(&domain.Follow{}).Error
+}
+
+func (r *followRepository) Block(ctx context.Context, blockerID, blockedID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("(follower_id = ? AND followee_id = ?) OR (follower_id = ? AND followee_id = ?)",
+				blockerID, blockedID, blockedID, blockerID).
+			Delete(&domain.Follow{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&domain.Follow{
+			FollowerID: blockerID,
+			FolloweeID: blockedID,
+			Status:     domain.FollowStatusBlocked,
+		}).Error
+	})
+}
+
+// FindByFollower returns the outgoing edges with the followee loaded (e.g. friends)
+func (r *followRepository) FindByFollower(ctx context.Context, followerID uint, filter FollowFilter) ([]domain.Follow, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&domain.Follow{}).
+		Where("follower_id = ?", followerID)
+
+	return r.findPaginated(query, filter, "Followee")
+}
+
+// FindByFollowee returns the incoming edges with the follower loaded (e.g. friend requests)
+func (r *followRepository) FindByFollowee(ctx context.Context, followeeID uint, filter FollowFilter) ([]domain.Follow, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&domain.Follow{}).
+		Where("followee_id = ?", followeeID)
+
+	return r.findPaginated(query, filter, "Follower")
+}
+
+func (r *followRepository) findPaginated(query *gorm.DB, filter FollowFilter, preload string) ([]domain.Follow, int64, error) {
+	var follows []domain.Follow
+	var total int64
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Limit > 0 {
+		offset := (filter.Page - 1) * filter.Limit
+		query = query.Offset(offset).Limit(filter.Limit)
+	}
+
+	err := query.
+		Preload(preload).
+		Order("created_at DESC").
+		Find(&follows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return follows, total, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"hopSpotAPI/internal/domain"
 )
@@ -16,7 +17,15 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
 	FindAll(ctx context.Context, filter UserFilter) ([]domain.User, int64, error)
 	UpdateFCMToken(ctx context.Context, userID uint, token string) error
-	GetAllFCMTokens(ctx context.Context, excludeUserID uint) ([]string, error)
+	// UpdateTwoFactor writes only the TOTP columns, so a stale copy doesn't overwrite concurrent changes
+	UpdateTwoFactor(ctx context.Context, user *domain.User) error
+	GetFollowerFCMTokens(ctx context.Context, userID uint) ([]string, error)
+	GetActiveFollowerIDs(ctx context.Context, userID uint) ([]uint, error)
+	// FindTokenVersion returns the current token version of an existing user (nil if not found)
+	FindTokenVersion(ctx context.Context, id uint) (*uint, error)
+	IncrementTokenVersion(ctx context.Context, id uint) error
+	// DeactivateMany deactivates the given users in one statement
+	DeactivateMany(ctx context.Context, ids []uint) error
 }
 
 type RefreshTokenRepository interface {
@@ -25,6 +34,109 @@ type RefreshTokenRepository interface {
 	RevokeByUserID(ctx context.Context, userID uint) error
 	RevokeByID(ctx context.Context, id uint) error
 	DeleteExpired(ctx context.Context) error
+
+	FindByID(ctx context.Context, id uint) (*domain.RefreshToken, error)
+	// FindActiveByUserID returns the valid sessions of a user, most recently used first
+	FindActiveByUserID(ctx context.Context, userID uint) ([]domain.RefreshToken, error)
+	// Rotate stores the new hash, expiry and client information of a session
+	// and keeps the previous hash as member of the token family
+	Rotate(ctx context.Context, token *domain.RefreshToken, previousHash string) error
+	// FindByRotatedHash returns the session a rotated-away token belonged to (nil if none)
+	FindByRotatedHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	// RevokeOthers revokes all sessions of a user except the given one
+	RevokeOthers(ctx context.Context, userID, keepID uint) error
+}
+
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *domain.PasswordResetToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error)
+	// MarkAsUsed sets used_at only if the token is still unused, returns false otherwise
+	MarkAsUsed(ctx context.Context, id uint) (bool, error)
+	// InvalidateByUserID marks all open tokens of a user as used
+	InvalidateByUserID(ctx context.Context, userID uint) error
+}
+
+type EmailTokenRepository interface {
+	Create(ctx context.Context, token *domain.EmailToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailToken, error)
+	// MarkAsUsed sets used_at only if the token is still unused, returns false otherwise
+	MarkAsUsed(ctx context.Context, id uint) (bool, error)
+	// InvalidateByUserID marks all open tokens of a user with the given purpose as used
+	InvalidateByUserID(ctx context.Context, userID uint, purpose string) error
+}
+
+type RecoveryCodeRepository interface {
+	// ReplaceByUserID deletes all codes of a user and stores the given ones
+	ReplaceByUserID(ctx context.Context, userID uint, codes []domain.RecoveryCode) error
+	FindUnusedByHash(ctx context.Context, userID uint, codeHash string) (*domain.RecoveryCode, error)
+	// MarkAsUsed sets used_at only if the code is still unused, returns false otherwise
+	MarkAsUsed(ctx context.Context, id uint) (bool, error)
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
+
+type TwoFactorChallengeRepository interface {
+	Create(ctx context.Context, challenge *domain.TwoFactorChallenge) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.TwoFactorChallenge, error)
+	IncrementAttempts(ctx context.Context, id uint) error
+	// MarkAsUsed sets used_at only if the challenge is still unused, returns false otherwise
+	MarkAsUsed(ctx context.Context, id uint) (bool, error)
+}
+
+type ExternalIdentityRepository interface {
+	Create(ctx context.Context, identity *domain.ExternalIdentity) error
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*domain.ExternalIdentity, error)
+	FindByUserID(ctx context.Context, userID uint) ([]domain.ExternalIdentity, error)
+	UpdateLastLogin(ctx context.Context, id uint) error
+	Delete(ctx context.Context, id uint) error
+}
+
+type OIDCAuthRequestRepository interface {
+	Create(ctx context.Context, request *domain.OIDCAuthRequest) error
+	FindByStateHash(ctx context.Context, stateHash string) (*domain.OIDCAuthRequest, error)
+	// MarkAsUsed sets used_at only if the request is still unused, returns false otherwise
+	MarkAsUsed(ctx context.Context, id uint) (bool, error)
+}
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *domain.PersonalAccessToken) error
+	// FindByTokenHash returns the token together with its user
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error)
+	// FindByUserID returns the tokens of a user, newest first
+	FindByUserID(ctx context.Context, userID uint) ([]domain.PersonalAccessToken, error)
+	// UpdateLastUsed sets last_used_at if it is older than the given time, to save writes on busy tokens
+	UpdateLastUsed(ctx context.Context, id uint, olderThan time.Time) error
+	// DeleteByUser deletes a token of the user, returns false if there was none
+	DeleteByUser(ctx context.Context, id, userID uint) (bool, error)
+}
+
+// AccountRepository covers everything stored about a user, for data export and account deletion
+type AccountRepository interface {
+	// FindData collects all data of a user (nil if the user doesn't exist)
+	FindData(ctx context.Context, userID uint) (*domain.AccountData, error)
+	// Delete purges the personal data and soft-deletes the scrubbed user row.
+	// Spots and photos are handed to spotOwnerID if given, otherwise they stay without author.
+	Delete(ctx context.Context, userID uint, spotOwnerID *uint) error
+}
+
+type AccountLockoutRepository interface {
+	FindByUserID(ctx context.Context, userID uint) (*domain.AccountLockout, error)
+	// RecordFailure atomically counts a failed login, failures before resetBefore are forgotten
+	RecordFailure(ctx context.Context, userID uint, resetBefore time.Time) (*domain.AccountLockout, error)
+	Lock(ctx context.Context, userID uint, until time.Time) error
+	// Delete clears failures and lock, returns false if there were none
+	Delete(ctx context.Context, userID uint) (bool, error)
+}
+
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *domain.SecurityEvent) error
+}
+
+// AuditEventRepository is append-only, events are only removed by the retention job
+type AuditEventRepository interface {
+	Create(ctx context.Context, event *domain.AuditEvent) error
+	FindAll(ctx context.Context, filter AuditEventFilter) ([]domain.AuditEvent, int64, error)
+	// DeleteBefore removes the events created before the given time and returns how many were removed
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
 type InvitationRepository interface {
@@ -35,7 +147,33 @@ type InvitationRepository interface {
 
 	FindByCode(ctx context.Context, code string) (*domain.InvitationCode, error)
 	FindAll(ctx context.Context, filter InvitationFilter) ([]domain.InvitationCode, int64, error)
-	MarkAsRedeemed(ctx context.Context, codeID uint, userID uint) error
+	// Redeem creates the user and records the redemption in one transaction.
+	// The external identity is linked to the new user in the same transaction, nil for sign-ups with password.
+	// Returns false without creating the user if the code expired or has no use left.
+	Redeem(ctx context.Context, codeID uint, user *domain.User, identity *domain.ExternalIdentity) (bool, error)
+	// CreateWithinQuota creates a code of a user if the uses they handed out stay within the quota, false otherwise
+	CreateWithinQuota(ctx context.Context, code *domain.InvitationCode, quota int) (bool, error)
+	// CountQuotaUsed returns the uses a user has handed out: all uses of open codes, the redeemed ones of expired or revoked codes
+	CountQuotaUsed(ctx context.Context, userID uint) (int64, error)
+	// FindInviteTree returns the root and the users invited by it, directly or through others, up to maxDepth levels below.
+	// Without a root every user without inviter is a root. Nodes are ordered by depth.
+	FindInviteTree(ctx context.Context, rootID *uint, maxDepth int) ([]InviteTreeNode, error)
+}
+
+// InviteTreeNode is a user in the invitation tree together with their contributions
+type InviteTreeNode struct {
+	UserID      uint
+	InvitedBy   *uint // creator of the redeemed code, nil for users without inviter
+	Depth       int   // 0 for the roots
+	DisplayName string
+	Email       string
+	Role        domain.Role
+	IsActive    bool
+	IsDeleted   bool
+	CreatedAt   time.Time
+	SpotCount   int64
+	PhotoCount  int64
+	ReviewCount int64
 }
 
 type SpotRepository interface {
@@ -45,8 +183,16 @@ type SpotRepository interface {
 	Delete(ctx context.Context, id uint) error
 
 	FindAll(ctx context.Context, filter SpotFilter) ([]domain.Spot, int64, error)
+	FindByName(ctx context.Context, name string) (*domain.Spot, error)
+	FindNearest(ctx context.Context, lat, lon float64, limit int) ([]domain.Spot, error)
+	FindWithinRadius(ctx context.Context, lat, lon, radius float64, excludeID uint) ([]domain.Spot, error)
+	FindMarkersInBounds(ctx context.Context, bounds BoundingBox, limit int) ([]SpotMarker, error)
+	FindClustersInBounds(ctx context.Context, bounds BoundingBox, cellSize float64) ([]SpotCluster, error)
 	FindRandom(ctx context.Context) (*domain.Spot, error)
+	CountByCreator(ctx context.Context, userID uint) (int64, error)
 	UpdateFields(ctx context.Context, id uint, fields map[string]interface{}) error
+	ReplaceAmenities(ctx context.Context, spotID uint, amenities []domain.Amenity) error
+	Merge(ctx context.Context, sourceID, targetID uint) error
 }
 
 type PhotoRepository interface {
@@ -72,6 +218,8 @@ type VisitRepository interface {
 	FindByUserID(ctx context.Context, userID uint, filter VisitFilter) ([]domain.Visit, int64, error)
 	FindBySpotIDUnscoped(ctx context.Context, spotID uint) ([]domain.Visit, error)
 	CountBySpotID(ctx context.Context, spotID uint) (int64, error)
+	CountByUserID(ctx context.Context, userID uint) (int64, error)
+	CountDistinctSpotsByUserID(ctx context.Context, userID uint) (int64, error)
 }
 
 type UserFilter struct {
@@ -80,6 +228,8 @@ type UserFilter struct {
 	IsActive *bool
 	Role     *string
 	Search   string // search by email or display name
+
+	EmailVerified *bool
 }
 
 type InvitationFilter struct {
@@ -89,19 +239,63 @@ type InvitationFilter struct {
 	CreatedBy  *uint
 }
 
+type AuditEventFilter struct {
+	Page       int
+	Limit      int
+	ActorID    *uint
+	TargetType string
+	TargetID   *uint
+	Action     string
+	From       *time.Time // inclusive
+	To         *time.Time // exclusive
+}
+
 type SpotFilter struct {
-	Page        int
-	Limit       int
-	SortBy      string // name, rating, created_at, distance, visit_count
-	SortOrder   string // asc, desc
-	HasToilet   *bool
-	HasTrashBin *bool
-	MinRating   *int
-	Search      string
+	Page      int
+	Limit     int
+	SortBy    string // name, rating, created_at, distance, visit_count
+	SortOrder string // asc, desc
+	MinRating *int   // compared against the average review rating
+	Search    string
+
+	Amenities        []string // amenity keys, spot must have all of them
+	WithoutAmenities []string // amenity keys, spot must have none of them
 
 	Lat    *float64
 	Lon    *float64
 	Radius *int // in Metern
+
+	Cursor *SpotCursor // keyset pagination, replaces Page if set
+}
+
+// SpotCursor points at the last spot of the previous page
+type SpotCursor struct {
+	ID    uint
+	Value interface{} // value of the sort column, nil for unrated spots
+}
+
+type BoundingBox struct {
+	MinLon float64
+	MinLat float64
+	MaxLon float64
+	MaxLat float64
+}
+
+// SpotMarker is a lightweight spot projection for map rendering
+type SpotMarker struct {
+	ID            uint
+	Latitude      float64
+	Longitude     float64
+	AverageRating *float64
+	ThumbnailPath *string
+}
+
+// SpotCluster groups the spots of one grid cell
+type SpotCluster struct {
+	Count     int64
+	Latitude  float64 // centroid
+	Longitude float64 // centroid
+	SpotID    *uint   // only set if the cell contains a single spot
 }
 
 type VisitFilter struct {
@@ -118,6 +312,7 @@ type FavoriteRepository interface {
 	Exists(ctx context.Context, userID, spotID uint) (bool, error)
 	FindByUserID(ctx context.Context, userID uint, filter FavoriteFilter) ([]domain.Favorite, int64, error)
 	GetSpotIDsByUserID(ctx context.Context, userID uint) ([]uint, error)
+	CountByUserID(ctx context.Context, userID uint) (int64, error)
 }
 
 type FavoriteFilter struct {
@@ -135,10 +330,78 @@ type ActivityFilter struct {
 	Page       int
 	Limit      int
 	ActionType *string
+	UserID     *uint
+	FriendsOf  *uint // only activities of this user and their friends
 }
 
 type NotificationRepository interface {
 	Create(ctx context.Context, notification *domain.Notification) error
-	FindByRelatedSpotIDUnscoped(ctx context.Context, relatedSpotID uint) ([]domain.Notification, error)
+	CreateBatch(ctx context.Context, notifications []domain.Notification) error
+	FindByID(ctx context.Context, id uint) (*domain.Notification, error)
+	Delete(ctx context.Context, id uint) error
 	HardDelete(ctx context.Context, id uint) error
+
+	FindByUserID(ctx context.Context, userID uint, filter NotificationFilter) ([]domain.Notification, int64, error)
+	FindByRelatedSpotIDUnscoped(ctx context.Context, relatedSpotID uint) ([]domain.Notification, error)
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+	MarkAsRead(ctx context.Context, id uint) error
+	MarkAllAsRead(ctx context.Context, userID uint) error
+}
+
+type NotificationFilter struct {
+	Page       int
+	Limit      int
+	UnreadOnly bool
+}
+
+type SpotReviewRepository interface {
+	// Create returns false without creating the review if the user already reviewed the spot
+	Create(ctx context.Context, review *domain.SpotReview) (bool, error)
+	FindByID(ctx context.Context, id uint) (*domain.SpotReview, error)
+	Update(ctx context.Context, review *domain.SpotReview) error
+	Delete(ctx context.Context, id uint) error
+	DeleteBySpotID(ctx context.Context, spotID uint) error
+
+	FindBySpotID(ctx context.Context, spotID uint, filter ReviewFilter) ([]domain.SpotReview, int64, error)
+	FindByUserAndSpot(ctx context.Context, userID, spotID uint) (*domain.SpotReview, error)
+}
+
+type ReviewFilter struct {
+	Page  int
+	Limit int
+}
+
+type AmenityRepository interface {
+	Create(ctx context.Context, amenity *domain.Amenity) error
+	FindByID(ctx context.Context, id uint) (*domain.Amenity, error)
+	Update(ctx context.Context, amenity *domain.Amenity) error
+	Delete(ctx context.Context, id uint) error
+
+	FindAll(ctx context.Context) ([]domain.Amenity, error)
+	FindByKey(ctx context.Context, key string) (*domain.Amenity, error)
+	FindByKeys(ctx context.Context, keys []string) ([]domain.Amenity, error)
+}
+
+type FollowRepository interface {
+	Create(ctx context.Context, follow *domain.Follow) error
+	FindByID(ctx context.Context, id uint) (*domain.Follow, error)
+	Delete(ctx context.Context, id uint) error
+
+	// Find returns the edge follower -> followee (nil if none)
+	Find(ctx context.Context, followerID, followeeID uint) (*domain.Follow, error)
+	// Accept marks the edge as accepted and adds the accepted reverse edge
+	Accept(ctx context.Context, follow *domain.Follow) error
+	// DeleteBetween removes the edges in both directions
+	DeleteBetween(ctx context.Context, userID, otherUserID uint) error
+	// Block replaces all edges between the users with a blocked edge blocker -> blocked
+	Block(ctx context.Context, blockerID, blockedID uint) error
+
+	FindByFollower(ctx context.Context, followerID uint, filter FollowFilter) ([]domain.Follow, int64, error)
+	FindByFollowee(ctx context.Context, followeeID uint, filter FollowFilter) ([]domain.Follow, int64, error)
+}
+
+type FollowFilter struct {
+	Page   int
+	Limit  int
+	Status domain.FollowStatus
 }
@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"hopSpotAPI/internal/domain"
 )
@@ -23,9 +26,8 @@ func (r invitationRepository) Create(ctx context.Context, code *domain.Invitatio
 
 func (r invitationRepository) FindByID(ctx context.Context, id uint) (*domain.InvitationCode, error) {
 	var code domain.InvitationCode
-	err := r.db.WithContext(ctx).
+	err := preloadRedemptions(r.db.WithContext(ctx)).
 		Preload("Creator").
-		Preload("Redeemer").
 		First(&code, id).Error
 
 	if err != nil {
@@ -47,7 +49,7 @@ func (r invitationRepository) Delete(ctx context.Context, id uint) error {
 
 func (r invitationRepository) FindByCode(ctx context.Context, code string) (*domain.InvitationCode, error) {
 	var invitationCode domain.InvitationCode
-	err := r.db.WithContext(ctx).Where("code = ?", code).First(&invitationCode).Error
+	err := r.db.WithContext(ctx).Preload("Creator").Where("code = ?", code).First(&invitationCode).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -67,9 +69,9 @@ func (r invitationRepository) FindAll(ctx context.Context, filter InvitationFilt
 
 	if filter.IsRedeemed != nil {
 		if *filter.IsRedeemed {
-			query = query.Where("redeemed_by IS NOT NULL")
+			query = query.Where("use_count > 0")
 		} else {
-			query = query.Where("redeemed_by IS NULL")
+			query = query.Where("use_count = 0")
 		}
 	}
 
@@ -89,9 +91,9 @@ func (r invitationRepository) FindAll(ctx context.Context, filter InvitationFilt
 	}
 
 	// Execute query
-	if err := query.
+	if err := preloadRedemptions(query).
 		Preload("Creator").
-		Preload("Redeemer").
+		Order("created_at DESC").
 		Find(&codes).Error; err != nil {
 		return nil, 0, err
 	}
@@ -99,9 +101,140 @@ func (r invitationRepository) FindAll(ctx context.Context, filter InvitationFilt
 	return codes, total, nil
 }
 
-func (r invitationRepository) MarkAsRedeemed(ctx context.Context, codeID uint, userID uint) error {
-	return r.db.WithContext(ctx).
-		Model(&domain.InvitationCode{}).
-		Where("id = ?", codeID).
-		Update("redeemed_by", userID).Error
+// Redeem takes a use of the code, creates the user and records the redemption in one transaction.
+// The conditional update locks the code row, so parallel sign-ups can't take the same last use.
+// Codes of deactivated or deleted users can't be redeemed.
+func (r invitationRepository) Redeem(ctx context.Context, codeID uint, user *domain.User, identity *domain.ExternalIdentity) (bool, error) {
+	redeemed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.InvitationCode{}).
+			Where("id = ? AND use_count < max_uses AND (expires_at IS NULL OR expires_at > ?)", codeID, time.Now()).
+			Where("(created_by IS NULL OR EXISTS (SELECT 1 FROM users WHERE users.id = invitation_codes.created_by AND users.is_active AND users.deleted_at IS NULL))").
+			Update("use_count", gorm.Expr("use_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&domain.InvitationRedemption{InvitationCodeID: codeID, UserID: user.ID}).Error; err != nil {
+			return err
+		}
+		if identity != nil {
+			identity.UserID = user.ID
+			if err := tx.Create(identity).Error; err != nil {
+				return err
+			}
+		}
+
+		redeemed = true
+		return nil
+	})
+	return redeemed, err
+}
+
+// CreateWithinQuota locks the creator while counting, so parallel requests can't exceed the quota together
+func (r invitationRepository) CreateWithinQuota(ctx context.Context, code *domain.InvitationCode, quota int) (bool, error) {
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&domain.User{}, *code.CreatedBy).Error; err != nil {
+			return err
+		}
+
+		used, err := countQuotaUsed(tx, *code.CreatedBy)
+		if err != nil {
+			return err
+		}
+		if used+int64(code.MaxUses) > int64(quota) {
+			return nil
+		}
+
+		if err := tx.Create(code).Error; err != nil {
+			return err
+		}
+
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+func (r invitationRepository) CountQuotaUsed(ctx context.Context, userID uint) (int64, error) {
+	return countQuotaUsed(r.db.WithContext(ctx), userID)
+}
+
+// countQuotaUsed counts the uses a user has handed out: all uses of open codes,
+// only the redeemed ones of expired or revoked codes
+func countQuotaUsed(db *gorm.DB, userID uint) (int64, error) {
+	var used int64
+	err := db.Unscoped().Model(&domain.InvitationCode{}).
+		Select(`COALESCE(SUM(CASE
+			WHEN deleted_at IS NOT NULL OR (expires_at IS NOT NULL AND expires_at <= ?) THEN use_count
+			ELSE max_uses
+		END), 0)`, time.Now()).
+		Where("created_by = ?", userID).
+		Scan(&used).Error
+	return used, err
+}
+
+// inviterQuery selects the creator of the code a user registered with
+const inviterQuery = `(SELECT c.created_by FROM invitation_redemptions r
+	JOIN invitation_codes c ON c.id = r.invitation_code_id
+	WHERE r.user_id = u.id)`
+
+// FindInviteTree walks the redemptions downwards from the roots. Revoked codes still connect
+// their redeemers, deleted users stay in the tree so their invitees remain reachable.
+func (r invitationRepository) FindInviteTree(ctx context.Context, rootID *uint, maxDepth int) ([]InviteTreeNode, error) {
+	roots := "u.id = ?"
+	args := []interface{}{}
+	if rootID != nil {
+		args = append(args, *rootID)
+	} else {
+		// Users without inviter, deleted ones only if they invited someone
+		roots = fmt.Sprintf(`%s IS NULL AND (u.deleted_at IS NULL OR EXISTS (
+			SELECT 1 FROM invitation_codes c JOIN invitation_redemptions r ON r.invitation_code_id = c.id
+			WHERE c.created_by = u.id))`, inviterQuery)
+	}
+	args = append(args, maxDepth)
+
+	var nodes []InviteTreeNode
+	err := r.db.WithContext(ctx).Raw(fmt.Sprintf(`WITH RECURSIVE tree AS (
+			SELECT u.id AS user_id, %s AS invited_by, 0 AS depth
+			FROM users u
+			WHERE %s
+			UNION ALL
+			SELECT r.user_id, c.created_by, tree.depth + 1
+			FROM tree
+			JOIN invitation_codes c ON c.created_by = tree.user_id
+			JOIN invitation_redemptions r ON r.invitation_code_id = c.id
+			WHERE tree.depth < ?
+		)
+		SELECT tree.user_id, tree.invited_by, tree.depth,
+			u.display_name, u.email, u.role, u.is_active, u.deleted_at IS NOT NULL AS is_deleted, u.created_at,
+			(SELECT COUNT(*) FROM spots s WHERE s.created_by = tree.user_id) AS spot_count,
+			(SELECT COUNT(*) FROM photos p WHERE p.uploaded_by = tree.user_id AND p.deleted_at IS NULL) AS photo_count,
+			(SELECT COUNT(*) FROM spot_reviews sr WHERE sr.user_id = tree.user_id) AS review_count
+		FROM tree
+		JOIN users u ON u.id = tree.user_id
+		ORDER BY tree.depth, tree.user_id`, inviterQuery, roots), args...).
+		Scan(&nodes).Error
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// preloadRedemptions loads the redemptions with their users, deleted accounts included
+func preloadRedemptions(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Redemptions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Redemptions.User", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		})
 }
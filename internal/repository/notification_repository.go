@@ -2,12 +2,16 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
 	"hopSpotAPI/internal/domain"
 )
 
+// notificationBatchSize limits the rows per INSERT when fanning out to many recipients
+const notificationBatchSize = 500
+
 type notificationRepository struct {
 	db *gorm.DB
 }
@@ -20,6 +24,61 @@ func (r *notificationRepository) Create(ctx context.Context, notification *domai
 	return r.db.WithContext(ctx).Create(notification).Error
 }
 
+func (r *notificationRepository) CreateBatch(ctx context.Context, notifications []domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).CreateInBatches(notifications, notificationBatchSize).Error
+}
+
+func (r *notificationRepository) FindByID(ctx context.Context, id uint) (*domain.Notification, error) {
+	var notification domain.Notification
+	err := r.db.WithContext(ctx).First(&notification, id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &notification, nil
+}
+
+func (r *notificationRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&domain.Notification{}, id).Error
+}
+
+func (r *notificationRepository) FindByUserID(ctx context.Context, userID uint, filter NotificationFilter) ([]domain.Notification, int64, error) {
+	var notifications []domain.Notification
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("user_id = ?", userID)
+
+	if filter.UnreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	if filter.Limit > 0 {
+		offset := (filter.Page - 1) * filter.Limit
+		query = query.Offset(offset).Limit(filter.Limit)
+	}
+
+	// Newest first
+	if err := query.Order("sent_at DESC").Find(&notifications).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
 // FindByRelatedSpotIDUnscoped returns all notifications for a related spot, including soft-deleted ones
 func (r *notificationRepository) FindByRelatedSpotIDUnscoped(ctx context.Context, relatedSpotID uint) ([]domain.Notification, error) {
 	var notifications []domain.Notification
@@ -29,6 +88,38 @@ func (r *notificationRepository) FindByRelatedSpotIDUnscoped(ctx context.Context
 	return notifications, nil
 }
 
+func (r *notificationRepository) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *notificationRepository) MarkAsRead(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("id = ? AND is_read = ?", id, false).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": time.Now(),
+		}).Error
+}
+
+func (r *notificationRepository) MarkAllAsRead(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": time.Now(),
+		}).Error
+}
+
 func (r *notificationRepository) HardDelete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Unscoped().Delete(&domain.Notification{}, id).Error
 }
@@ -53,7 +53,76 @@ func (r *refreshTokenRepository) RevokeByID(ctx context.Context, id uint) error
 }
 
 func (r *refreshTokenRepository) DeleteExpired(ctx context.Context) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&domain.RefreshToken{}).Select("id").Where("expires_at < ?", time.Now())
+		if err := tx.Where("family_id IN (?)", expired).Delete(&domain.RotatedRefreshToken{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("expires_at < ?", time.Now()).Delete(&domain.RefreshToken{}).Error
+	})
+}
+
+func (r *refreshTokenRepository) FindByID(ctx context.Context, id uint) (*domain.RefreshToken, error) {
+	var token domain.RefreshToken
+	err := r.db.WithContext(ctx).First(&token, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepository) FindActiveByUserID(ctx context.Context, userID uint) ([]domain.RefreshToken, error) {
+	var tokens []domain.RefreshToken
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND is_revoked = ? AND expires_at > ?", userID, false, time.Now()).
+		Order("last_used_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *refreshTokenRepository) Rotate(ctx context.Context, token *domain.RefreshToken, previousHash string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rotated := &domain.RotatedRefreshToken{
+			FamilyID:  token.ID,
+			TokenHash: previousHash,
+			RotatedAt: time.Now(),
+		}
+		if err := tx.Create(rotated).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(token).
+			Select("token_hash", "expires_at", "last_used_at", "user_agent", "ip_address").
+			Updates(token).Error
+	})
+}
+
+func (r *refreshTokenRepository) FindByRotatedHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var token domain.RefreshToken
+	err := r.db.WithContext(ctx).
+		Where("id = (?)", r.db.Model(&domain.RotatedRefreshToken{}).Select("family_id").Where("token_hash = ?", tokenHash)).
+		First(&token).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepository) RevokeOthers(ctx context.Context, userID, keepID uint) error {
 	return r.db.WithContext(ctx).
-		Where("expires_at < ?", time.Now()).
-		Delete(&domain.RefreshToken{}).Error
+		Model(&domain.RefreshToken{}).
+		Where("user_id = ? AND id <> ? AND is_revoked = ?", userID, keepID, false).
+		Update("is_revoked", true).Error
 }
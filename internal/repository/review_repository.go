@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hopSpotAPI/internal/domain"
+)
+
+type spotReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewSpotReviewRepository Constructor for SpotReviewRepository
+func NewSpotReviewRepository(db *gorm.DB) SpotReviewRepository {
+	return &spotReviewRepository{db: db}
+}
+
+// Create relies on the unique index of user and spot, so parallel requests can't create a second review
+func (r *spotReviewRepository) Create(ctx context.Context, review *domain.SpotReview) (bool, error) {
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "spot_id"}},
+			DoNothing: true,
+		}).Create(review)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		created = true
+		return refreshSpotRating(tx, review.SpotID)
+	})
+	return created, err
+}
+
+func (r *spotReviewRepository) FindByID(ctx context.Context, id uint) (*domain.SpotReview, error) {
+	var review domain.SpotReview
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		First(&review, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+func (r *spotReviewRepository) Update(ctx context.Context, review *domain.SpotReview) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(review).Select("stars", "comment").Updates(review).Error; err != nil {
+			return err
+		}
+		return refreshSpotRating(tx, review.SpotID)
+	})
+}
+
+func (r *spotReviewRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var review domain.SpotReview
+		if err := tx.First(&review, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&review).Error; err != nil {
+			return err
+		}
+		return refreshSpotRating(tx, review.SpotID)
+	})
+}
+
+func (r *spotReviewRepository) DeleteBySpotID(ctx context.Context, spotID uint) error {
+	return r.db.WithContext(ctx).Where("spot_id = ?", spotID).Delete(&domain.SpotReview{}).Error
+}
+
+func (r *spotReviewRepository) FindBySpotID(ctx context.Context, spotID uint, filter ReviewFilter) ([]domain.SpotReview, int64, error) {
+	var reviews []domain.SpotReview
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&domain.SpotReview{}).
+		Where("spot_id = ?", spotID)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	if filter.Limit > 0 {
+		offset := (filter.Page - 1) * filter.Limit
+		query = query.Offset(offset).Limit(filter.Limit)
+	}
+
+	// Newest reviews first
+	err := query.
+		Preload("User").
+		Order("updated_at DESC").
+		Find(&reviews).Error
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+func (r *spotReviewRepository) FindByUserAndSpot(ctx context.Context, userID, spotID uint) (*domain.SpotReview, error) {
+	var review domain.SpotReview
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND spot_id = ?", userID, spotID).
+		First(&review).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// refreshSpotRating recalculates the aggregated rating columns of a spot
+func refreshSpotRating(tx *gorm.DB, spotID uint) error {
+	return tx.Exec(`
+		UPDATE spots SET
+			average_rating = (SELECT AVG(stars) FROM spot_reviews WHERE spot_id = ?),
+			review_count = (SELECT COUNT(*) FROM spot_reviews WHERE spot_id = ?)
+		WHERE id = ?`, spotID, spotID, spotID).Error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"hopSpotAPI/internal/domain"
+)
+
+type securityEventRepository struct {
+	db *gorm.DB
+}
+
+func NewSecurityEventRepository(db *gorm.DB) SecurityEventRepository {
+	return &securityEventRepository{db: db}
+}
+
+func (r *securityEventRepository) Create(ctx context.Context, event *domain.SecurityEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
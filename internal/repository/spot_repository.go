@@ -6,10 +6,14 @@ import (
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"hopSpotAPI/internal/domain"
 )
 
+// geoPointSQL builds a geography point from (lon, lat) parameters
+const geoPointSQL = "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+
 type spotRepository struct {
 	db *gorm.DB
 }
@@ -27,6 +31,7 @@ func (r spotRepository) FindByID(ctx context.Context, id uint) (*domain.Spot, er
 	var spot domain.Spot
 	err := r.db.WithContext(ctx).
 		Preload("Creator").
+		Preload("Amenities").
 		First(&spot, id).Error
 
 	if err != nil {
@@ -39,11 +44,13 @@ func (r spotRepository) FindByID(ctx context.Context, id uint) (*domain.Spot, er
 }
 
 func (r spotRepository) Update(ctx context.Context, spot *domain.Spot) error {
-	return r.db.WithContext(ctx).Save(spot).Error
+	// Rating aggregates are owned by the review repository, amenities by ReplaceAmenities
+	return r.db.WithContext(ctx).Omit("average_rating", "review_count", clause.Associations).Save(spot).Error
 }
 
 func (r spotRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&domain.Spot{}, id).Error
+	// Select removes the spot_amenities rows as well
+	return r.db.WithContext(ctx).Select("Amenities").Delete(&domain.Spot{ID: id}).Error
 }
 
 func (r spotRepository) FindAll(ctx context.Context, filter SpotFilter) ([]domain.Spot, int64, error) {
@@ -53,16 +60,24 @@ func (r spotRepository) FindAll(ctx context.Context, filter SpotFilter) ([]domai
 	query := r.db.WithContext(ctx).Model(&domain.Spot{})
 
 	// Apply filters
-	if filter.HasToilet != nil {
-		query = query.Where("has_toilet = ?", *filter.HasToilet)
+	if len(filter.Amenities) > 0 {
+		query = query.Where(`spots.id IN (
+			SELECT spot_amenities.spot_id FROM spot_amenities
+			JOIN amenities ON amenities.id = spot_amenities.amenity_id
+			WHERE amenities.key IN ?
+			GROUP BY spot_amenities.spot_id
+			HAVING COUNT(DISTINCT amenities.key) = ?)`, filter.Amenities, len(filter.Amenities))
 	}
 
-	if filter.HasTrashBin != nil {
-		query = query.Where("has_trash_bin = ?", *filter.HasTrashBin)
+	if len(filter.WithoutAmenities) > 0 {
+		query = query.Where(`spots.id NOT IN (
+			SELECT spot_amenities.spot_id FROM spot_amenities
+			JOIN amenities ON amenities.id = spot_amenities.amenity_id
+			WHERE amenities.key IN ?)`, filter.WithoutAmenities)
 	}
 
 	if filter.MinRating != nil {
-		query = query.Where("rating >= ?", *filter.MinRating)
+		query = query.Where("average_rating >= ?", *filter.MinRating)
 	}
 
 	if filter.Search != "" {
@@ -70,15 +85,11 @@ func (r spotRepository) FindAll(ctx context.Context, filter SpotFilter) ([]domai
 		query = query.Where("name ILIKE ? OR description ILIKE ?", searchPattern, searchPattern)
 	}
 
-	// Radius filter (requires lat/lon)
-	if filter.Radius != nil && filter.Lat != nil && filter.Lon != nil {
-		// Haversine formula for radius filtering (in meters)
-		radiusKm := float64(*filter.Radius) / 1000.0
-		haversineCondition := fmt.Sprintf(
-			"(6371 * acos(cos(radians(%f)) * cos(radians(latitude)) * cos(radians(longitude) - radians(%f)) + sin(radians(%f)) * sin(radians(latitude)))) <= %f",
-			*filter.Lat, *filter.Lon, *filter.Lat, radiusKm,
-		)
-		query = query.Where(haversineCondition)
+	hasPoint := filter.Lat != nil && filter.Lon != nil
+
+	// Radius filter (requires lat/lon) - uses the GiST index on location
+	if filter.Radius != nil && hasPoint {
+		query = query.Where("ST_DWithin(location, "+geoPointSQL+", ?)", *filter.Lon, *filter.Lat, *filter.Radius)
 	}
 
 	// Count total records (before pagination)
@@ -86,64 +97,209 @@ func (r spotRepository) FindAll(ctx context.Context, filter SpotFilter) ([]domai
 		return nil, 0, err
 	}
 
-	// Handle distance sorting
+	// Resolve sort expression - distance needs the search point
+	sortExpr := "created_at"
+	sortOrder := "DESC"
+	var sortVars []interface{}
+	// orderExpr differs from sortExpr only for distance, where the KNN operator can use the GiST index
+	orderExpr := ""
+	distanceSQL := "ST_Distance(location, " + geoPointSQL + ")"
+
 	if filter.SortBy == "distance" {
-		if filter.Lat == nil || filter.Lon == nil {
+		if !hasPoint {
 			return nil, 0, errors.New("lat and lon are required for distance sorting")
 		}
 
-		// Haversine formula for distance calculation (result in km)
-		distanceFormula := fmt.Sprintf(
-			"(6371 * acos(LEAST(1.0, cos(radians(%f)) * cos(radians(latitude)) * cos(radians(longitude) - radians(%f)) + sin(radians(%f)) * sin(radians(latitude)))))",
-			*filter.Lat, *filter.Lon, *filter.Lat,
-		)
+		// <-> on geography measures on the sphere, the cursor and the returned distance
+		// use the same measure so pages line up with the index order
+		distanceSQL = "ST_Distance(location, " + geoPointSQL + ", false)"
+		sortExpr = distanceSQL
+		orderExpr = "location <-> " + geoPointSQL
+		sortVars = []interface{}{*filter.Lon, *filter.Lat}
 
-		sortOrder := "ASC"
+		sortOrder = "ASC"
 		if filter.SortOrder == "desc" {
 			sortOrder = "DESC"
 		}
-
-		query = query.Order(fmt.Sprintf("%s %s", distanceFormula, sortOrder))
 	} else {
 		// Normal column sorting
-		sortBy := "created_at"
-		validSortColumns := map[string]bool{
-			"name":       true,
-			"rating":     true,
-			"created_at": true,
-			"updated_at": true,
+		validSortColumns := map[string]string{
+			"name":       "name",
+			"rating":     "average_rating",
+			"created_at": "created_at",
+			"updated_at": "updated_at",
 		}
 
-		if filter.SortBy != "" && validSortColumns[filter.SortBy] {
-			sortBy = filter.SortBy
+		if column, ok := validSortColumns[filter.SortBy]; ok {
+			sortExpr = column
 		}
 
-		sortOrder := "DESC"
 		if filter.SortOrder == "asc" {
 			sortOrder = "ASC"
 		}
+	}
+
+	// Distance is returned with every spot if coordinates are given
+	if hasPoint {
+		query = query.Select("spots.*, "+distanceSQL+" AS distance", *filter.Lon, *filter.Lat)
+	}
+
+	if orderExpr == "" {
+		orderExpr = sortExpr
+	}
+
+	// Unrated spots always go last
+	nullsLast := sortExpr == "average_rating"
+
+	// Keyset pagination continues after the cursor instead of using an offset
+	if filter.Cursor != nil {
+		query = applySpotCursor(query, sortExpr, sortVars, sortOrder, nullsLast, filter.Cursor)
+	}
 
-		query = query.Order(fmt.Sprintf("%s %s", sortBy, sortOrder))
+	nulls := ""
+	if nullsLast {
+		nulls = " NULLS LAST"
 	}
 
+	// id as tie-breaker keeps the order stable between pages
+	query = query.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL:  fmt.Sprintf("%s %s%s, spots.id %s", orderExpr, sortOrder, nulls, sortOrder),
+		Vars: sortVars,
+	}})
+
 	// Apply pagination
 	if filter.Limit > 0 {
-		offset := (filter.Page - 1) * filter.Limit
-		query = query.Offset(offset).Limit(filter.Limit)
+		query = query.Limit(filter.Limit)
+		if filter.Cursor == nil {
+			offset := (filter.Page - 1) * filter.Limit
+			query = query.Offset(offset)
+		}
 	}
 
 	// Execute query
-	if err := query.Find(&spots).Error; err != nil {
+	if err := query.Preload("Amenities").Find(&spots).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return spots, total, nil
 }
 
+// FindByName looks up a spot by name, case-insensitive
+func (r spotRepository) FindByName(ctx context.Context, name string) (*domain.Spot, error) {
+	var spot domain.Spot
+	err := r.db.WithContext(ctx).
+		Where("LOWER(name) = LOWER(?)", name).
+		First(&spot).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &spot, nil
+}
+
+// applySpotCursor restricts the query to the spots after the cursor in the given sort order
+func applySpotCursor(query *gorm.DB, sortExpr string, sortVars []interface{}, sortOrder string, nullsLast bool, cursor *SpotCursor) *gorm.DB {
+	op := ">"
+	if sortOrder == "DESC" {
+		op = "<"
+	}
+
+	// Cursor is already in the NULLS LAST tail - only the id decides
+	if cursor.Value == nil {
+		return query.Where(fmt.Sprintf("%s IS NULL AND spots.id %s ?", sortExpr, op), cursor.ID)
+	}
+
+	condition := fmt.Sprintf("%s %s ? OR (%s = ? AND spots.id %s ?)", sortExpr, op, sortExpr, op)
+	if nullsLast {
+		condition += fmt.Sprintf(" OR %s IS NULL", sortExpr)
+	}
+
+	vars := append([]interface{}{}, sortVars...)
+	vars = append(vars, cursor.Value)
+	vars = append(vars, sortVars...)
+	vars = append(vars, cursor.Value, cursor.ID)
+
+	return query.Where("("+condition+")", vars...)
+}
+
+// FindNearest returns the closest spots to a point using the KNN operator on the GiST index
+func (r spotRepository) FindNearest(ctx context.Context, lat, lon float64, limit int) ([]domain.Spot, error) {
+	var spots []domain.Spot
+	err := r.db.WithContext(ctx).
+		Model(&domain.Spot{}).
+		Select("spots.*, ST_Distance(location, "+geoPointSQL+") AS distance", lon, lat).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: "location <-> " + geoPointSQL, Vars: []interface{}{lon, lat}}}).
+		Limit(limit).
+		Preload("Amenities").
+		Find(&spots).Error
+	if err != nil {
+		return nil, err
+	}
+	return spots, nil
+}
+
+// FindWithinRadius returns all spots within radius meters of a point, closest first.
+// excludeID skips the spot itself when it is being moved (0 = none).
+func (r spotRepository) FindWithinRadius(ctx context.Context, lat, lon, radius float64, excludeID uint) ([]domain.Spot, error) {
+	var spots []domain.Spot
+	query := r.db.WithContext(ctx).
+		Model(&domain.Spot{}).
+		Select("spots.*, ST_Distance(location, "+geoPointSQL+") AS distance", lon, lat).
+		Where("ST_DWithin(location, "+geoPointSQL+", ?)", lon, lat, radius)
+
+	if excludeID != 0 {
+		query = query.Where("spots.id <> ?", excludeID)
+	}
+
+	err := query.
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: "location <-> " + geoPointSQL, Vars: []interface{}{lon, lat}}}).
+		Find(&spots).Error
+	if err != nil {
+		return nil, err
+	}
+	return spots, nil
+}
+
+// FindMarkersInBounds returns lightweight markers with the main thumbnail for all spots in the bounding box
+func (r spotRepository) FindMarkersInBounds(ctx context.Context, bounds BoundingBox, limit int) ([]SpotMarker, error) {
+	var markers []SpotMarker
+	err := r.db.WithContext(ctx).
+		Model(&domain.Spot{}).
+		Select("spots.id, spots.latitude, spots.longitude, spots.average_rating, photos.file_path_thumbnail AS thumbnail_path").
+		Joins("LEFT JOIN photos ON photos.spot_id = spots.id AND photos.is_main = ? AND photos.deleted_at IS NULL", true).
+		Where("spots.latitude BETWEEN ? AND ? AND spots.longitude BETWEEN ? AND ?", bounds.MinLat, bounds.MaxLat, bounds.MinLon, bounds.MaxLon).
+		Order("spots.id").
+		Limit(limit).
+		Scan(&markers).Error
+	if err != nil {
+		return nil, err
+	}
+	return markers, nil
+}
+
+// FindClustersInBounds groups the spots in the bounding box into square grid cells (size in degrees)
+func (r spotRepository) FindClustersInBounds(ctx context.Context, bounds BoundingBox, cellSize float64) ([]SpotCluster, error) {
+	var clusters []SpotCluster
+	err := r.db.WithContext(ctx).
+		Model(&domain.Spot{}).
+		Select("COUNT(*) AS count, AVG(latitude) AS latitude, AVG(longitude) AS longitude, CASE WHEN COUNT(*) = 1 THEN MIN(id) END AS spot_id").
+		Where("latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?", bounds.MinLat, bounds.MaxLat, bounds.MinLon, bounds.MaxLon).
+		Group(fmt.Sprintf("FLOOR(longitude / %f), FLOOR(latitude / %f)", cellSize, cellSize)).
+		Scan(&clusters).Error
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
 func (r spotRepository) FindRandom(ctx context.Context) (*domain.Spot, error) {
 	var spot domain.Spot
 	err := r.db.WithContext(ctx).
 		Preload("Creator").
+		Preload("Amenities").
 		Order("RANDOM()").
 		First(&spot).Error
 
@@ -156,6 +312,84 @@ func (r spotRepository) FindRandom(ctx context.Context) (*domain.Spot, error) {
 	return &spot, nil
 }
 
+func (r spotRepository) CountByCreator(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&domain.Spot{}).Where("created_by = ?", userID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (r spotRepository) UpdateFields(ctx context.Context, id uint, fields map[string]interface{}) error {
 	return r.db.WithContext(ctx).Model(&domain.Spot{}).Where("id = ?", id).Updates(fields).Error
 }
+
+// ReplaceAmenities sets the amenities of a spot, removing all others
+func (r spotRepository) ReplaceAmenities(ctx context.Context, spotID uint, amenities []domain.Amenity) error {
+	return r.db.WithContext(ctx).Model(&domain.Spot{ID: spotID}).Association("Amenities").Replace(amenities)
+}
+
+// Merge moves everything attached to the source spot to the target spot and deletes the source.
+// Favorites and reviews the target already has from the same user are dropped.
+func (r spotRepository) Merge(ctx context.Context, sourceID, targetID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Visits (including soft-deleted ones)
+		if err := tx.Unscoped().Model(&domain.Visit{}).Where("spot_id = ?", sourceID).Update("spot_id", targetID).Error; err != nil {
+			return err
+		}
+
+		// Photos - the target keeps its main photo if it has one
+		var targetMainPhotos int64
+		if err := tx.Model(&domain.Photo{}).Where("spot_id = ? AND is_main = ?", targetID, true).Count(&targetMainPhotos).Error; err != nil {
+			return err
+		}
+		photoFields := map[string]interface{}{"spot_id": targetID}
+		if targetMainPhotos > 0 {
+			photoFields["is_main"] = false
+		}
+		if err := tx.Unscoped().Model(&domain.Photo{}).Where("spot_id = ?", sourceID).Updates(photoFields).Error; err != nil {
+			return err
+		}
+
+		// Favorites - one per user and spot
+		if err := tx.Where("spot_id = ? AND user_id IN (?)", sourceID,
+			tx.Model(&domain.Favorite{}).Select("user_id").Where("spot_id = ?", targetID),
+		).Delete(&domain.Favorite{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.Favorite{}).Where("spot_id = ?", sourceID).Update("spot_id", targetID).Error; err != nil {
+			return err
+		}
+
+		// Reviews - one per user and spot, the review on the target wins
+		if err := tx.Where("spot_id = ? AND user_id IN (?)", sourceID,
+			tx.Model(&domain.SpotReview{}).Select("user_id").Where("spot_id = ?", targetID),
+		).Delete(&domain.SpotReview{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&domain.SpotReview{}).Where("spot_id = ?", sourceID).Update("spot_id", targetID).Error; err != nil {
+			return err
+		}
+		if err := refreshSpotRating(tx, targetID); err != nil {
+			return err
+		}
+
+		// Activities and notifications
+		if err := tx.Model(&domain.Activity{}).Where("spot_id = ?", sourceID).Update("spot_id", targetID).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&domain.Notification{}).Where("related_spot_id = ?", sourceID).Update("related_spot_id", targetID).Error; err != nil {
+			return err
+		}
+
+		// Amenities - the target gets the union of both
+		if err := tx.Exec(`
+			INSERT INTO spot_amenities (spot_id, amenity_id)
+			SELECT ?, amenity_id FROM spot_amenities WHERE spot_id = ?
+			ON CONFLICT DO NOTHING`, targetID, sourceID).Error; err != nil {
+			return err
+		}
+
+		return tx.Select("Amenities").Delete(&domain.Spot{ID: sourceID}).Error
+	})
+}
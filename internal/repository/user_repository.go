@@ -35,7 +35,8 @@ func (r *userRepository) FindByID(ctx context.Context, id uint) (*domain.User, e
 }
 
 func (r userRepository) Update(ctx context.Context, user *domain.User) error {
-	return r.db.WithContext(ctx).Save(user).Error
+	// The token version is only changed by IncrementTokenVersion, a stale copy must not reset it
+	return r.db.WithContext(ctx).Omit("token_version").Save(user).Error
 }
 
 func (r userRepository) Delete(ctx context.Context, id uint) error {
@@ -77,6 +78,14 @@ func (r userRepository) FindAll(ctx context.Context, filter UserFilter) ([]domai
 		query = query.Where("role = ?", *filter.Role)
 	}
 
+	if filter.EmailVerified != nil {
+		if *filter.EmailVerified {
+			query = query.Where("email_verified_at IS NOT NULL")
+		} else {
+			query = query.Where("email_verified_at IS NULL")
+		}
+	}
+
 	if filter.Search != "" {
 		searchPattern := "%" + filter.Search + "%"
 		query = query.Where("email LIKE ? OR display_name LIKE ?", searchPattern, searchPattern)
@@ -101,12 +110,61 @@ func (r userRepository) UpdateFCMToken(ctx context.Context, userID uint, token s
 		Where("id = ?", userID).Update("fcm_token", token).Error
 }
 
-func (r userRepository) GetAllFCMTokens(ctx context.Context, excludeUserID uint) ([]string, error) {
+func (r userRepository) UpdateTwoFactor(ctx context.Context, user *domain.User) error {
+	return r.db.WithContext(ctx).Model(&domain.User{}).
+		Where("id = ?", user.ID).
+		Updates(map[string]any{
+			"totp_secret":     user.TOTPSecret,
+			"totp_enabled_at": user.TOTPEnabledAt,
+			"totp_last_step":  user.TOTPLastStep,
+		}).Error
+}
+
+// GetFollowerFCMTokens returns the FCM tokens of the active friends of a user
+func (r userRepository) GetFollowerFCMTokens(ctx context.Context, userID uint) ([]string, error) {
 	var tokens []string
 	if err := r.db.WithContext(ctx).Model(&domain.User{}).
-		Where("id != ? AND fcm_token IS NOT NULL", excludeUserID).
+		Where("id IN (?) AND is_active = ? AND fcm_token IS NOT NULL", followerIDs(r.db, userID), true).
 		Pluck("fcm_token", &tokens).Error; err != nil {
 		return nil, err
 	}
 	return tokens, nil
 }
+
+// GetActiveFollowerIDs returns the IDs of the active friends of a user
+func (r userRepository) GetActiveFollowerIDs(ctx context.Context, userID uint) ([]uint, error) {
+	var ids []uint
+	if err := r.db.WithContext(ctx).Model(&domain.User{}).
+		Where("id IN (?) AND is_active = ?", followerIDs(r.db, userID), true).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r userRepository) FindTokenVersion(ctx context.Context, id uint) (*uint, error) {
+	var user domain.User
+	err := r.db.WithContext(ctx).Select("id", "token_version").First(&user, "id = ?", id).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user.TokenVersion, nil
+}
+
+func (r userRepository) IncrementTokenVersion(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.User{}).
+		Where("id = ?", id).
+		UpdateColumn("token_version", gorm.Expr("token_version + 1")).Error
+}
+
+func (r userRepository) DeactivateMany(ctx context.Context, ids []uint) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.User{}).
+		Where("id IN ?", ids).
+		Update("is_active", false).Error
+}
@@ -84,3 +84,20 @@ func (r *visitRepository) CountBySpotID(ctx context.Context, spotID uint) (int64
 	}
 	return count, nil
 }
+
+func (r *visitRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&domain.Visit{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountDistinctSpotsByUserID counts the different spots a user has visited
+func (r *visitRepository) CountDistinctSpotsByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&domain.Visit{}).Where("user_id = ?", userID).Distinct("spot_id").Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/handler"
 	"hopSpotAPI/internal/middleware"
 
@@ -21,6 +22,18 @@ func Setup(
 	weatherHandler *handler.WeatherHandler,
 	favoriteHandler *handler.FavoriteHandler,
 	activityHandler *handler.ActivityHandler,
+	reviewHandler *handler.ReviewHandler,
+	notificationHandler *handler.NotificationHandler,
+	amenityHandler *handler.AmenityHandler,
+	followHandler *handler.FollowHandler,
+	passwordResetHandler *handler.PasswordResetHandler,
+	emailVerificationHandler *handler.EmailVerificationHandler,
+	twoFactorHandler *handler.TwoFactorHandler,
+	jwksHandler *handler.JWKSHandler,
+	oidcHandler *handler.OIDCHandler,
+	personalAccessTokenHandler *handler.PersonalAccessTokenHandler,
+	invitationHandler *handler.InvitationHandler,
+	auditHandler *handler.AuditHandler,
 	authMiddleware *middleware.AuthMiddleware,
 	globalRateLimiter *middleware.RateLimitMiddleware,
 	loginRateLimiter *middleware.RateLimitMiddleware,
@@ -29,10 +42,14 @@ func Setup(
 
 	// Global Rate Limiting (all Requests)
 	router.Use(globalRateLimiter.Limit())
+	router.Use(middleware.ClientInfo())
 
 	// Swagger UI
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// Public keys to verify access tokens
+	router.GET("/.well-known/jwks.json", jwksHandler.GetJWKS)
+
 	v1 := router.Group("/api/v1")
 	{
 		// Public routes
@@ -40,33 +57,91 @@ func Setup(
 		{
 			auth.POST("/register", loginRateLimiter.LimitLogin(), authHandler.Register)
 			auth.POST("/login", loginRateLimiter.LimitLogin(), authHandler.Login)
+			auth.POST("/login/2fa", loginRateLimiter.LimitLogin(), authHandler.LoginTwoFactor)
 			auth.POST("/refresh", authHandler.Refresh)
 			auth.POST("/logout", authHandler.Logout)
+			auth.POST("/password/forgot", loginRateLimiter.LimitLogin(), passwordResetHandler.ForgotPassword)
+			auth.POST("/password/reset", loginRateLimiter.LimitLogin(), passwordResetHandler.ResetPassword)
+			auth.POST("/email/confirm", loginRateLimiter.LimitLogin(), emailVerificationHandler.ConfirmEmail)
+			auth.GET("/oidc/providers", oidcHandler.ListProviders)
+			auth.POST("/oidc/:provider/authorize", loginRateLimiter.LimitLogin(), oidcHandler.Authorize)
+			auth.POST("/oidc/:provider/nonce", loginRateLimiter.LimitLogin(), oidcHandler.Nonce)
+			auth.POST("/oidc/:provider/login", loginRateLimiter.LimitLogin(), oidcHandler.Login)
 		}
 
-		// Protected routes
+		// Protected routes. Every group either requires a login session or
+		// accepts personal access tokens with the scopes of the group.
 		protected := v1.Group("/")
 		protected.Use(authMiddleware.Authenticate())
 		{
 			// Auth routes
 			protectedAuth := protected.Group("/auth")
+			protectedAuth.Use(authMiddleware.RequireSession())
 			{
 				protectedAuth.POST("refresh-fcm-token", authHandler.RefreshFCMToken)
+				protectedAuth.GET("/sessions", authHandler.ListSessions)
+				protectedAuth.DELETE("/sessions", authHandler.RevokeOtherSessions)
+				protectedAuth.DELETE("/sessions/:id", authHandler.RevokeSession)
 			}
 
 			// User routes
 			user := protected.Group("/users")
+			user.Use(authMiddleware.RequireSession())
 			{
 				user.GET("/me", userHandler.GetProfile)
 				user.PATCH("/me", userHandler.UpdateProfile)
+				user.DELETE("/me", userHandler.DeleteAccount)
+				user.GET("/me/export", userHandler.ExportData)
 				user.POST("/me/change-password", userHandler.ChangePassword)
+				user.POST("/me/email", emailVerificationHandler.RequestEmailChange)
+				user.POST("/me/email/verification", emailVerificationHandler.ResendVerification)
+				user.POST("/me/2fa/setup", twoFactorHandler.Setup)
+				user.POST("/me/2fa/enable", twoFactorHandler.Enable)
+				user.POST("/me/2fa/disable", twoFactorHandler.Disable)
+				user.POST("/me/2fa/recovery-codes", twoFactorHandler.RegenerateRecoveryCodes)
+				user.GET("/me/identities", oidcHandler.ListIdentities)
+				user.POST("/me/identities/:provider", oidcHandler.LinkIdentity)
+				user.DELETE("/me/identities/:provider", oidcHandler.UnlinkIdentity)
+				user.GET("/me/tokens", personalAccessTokenHandler.List)
+				user.POST("/me/tokens", personalAccessTokenHandler.Create)
+				user.DELETE("/me/tokens/:id", personalAccessTokenHandler.Revoke)
+				user.GET("/:id", userHandler.GetPublicProfile)
+
+				// Friend routes unter /users/:id
+				user.POST("/:id/follow", followHandler.Follow)
+				user.DELETE("/:id/follow", followHandler.Unfollow)
+				user.POST("/:id/block", followHandler.Block)
+				user.DELETE("/:id/block", followHandler.Unblock)
+			}
+
+			// Friends routes
+			friends := protected.Group("/friends")
+			friends.Use(authMiddleware.RequireSession())
+			{
+				friends.GET("", followHandler.ListFriends)
+				friends.GET("/requests", followHandler.ListRequests)
+				friends.POST("/requests/:id/accept", followHandler.AcceptRequest)
+				friends.POST("/requests/:id/decline", followHandler.DeclineRequest)
+			}
+
+			// Invitation routes
+			invitations := protected.Group("/invitations")
+			invitations.Use(authMiddleware.RequireSession(), authMiddleware.RequirePermission(domain.PermissionInvitationCreate))
+			{
+				invitations.GET("", invitationHandler.List)
+				invitations.POST("", invitationHandler.Create)
+				invitations.DELETE("/:id", invitationHandler.Revoke)
 			}
 
 			// Spot routes
 			spot := protected.Group("/spots")
+			spot.Use(authMiddleware.RequireScope(domain.ScopeSpotsRead, domain.ScopeSpotsWrite))
 			{
 				spot.GET("", spotHandler.List)
 				spot.GET("/random", spotHandler.GetRandom)
+				spot.GET("/nearest", spotHandler.ListNearest)
+				spot.GET("/map", spotHandler.GetMap)
+				spot.GET("/export", spotHandler.Export)
 				spot.GET("/:id", spotHandler.GetByID)
 				spot.POST("", spotHandler.Create)
 				spot.PATCH("/:id", spotHandler.Update)
@@ -83,10 +158,20 @@ func Setup(
 				// Photo routes unter /spots/:id
 				spot.POST("/:id/photos", photoHandler.Upload)
 				spot.GET("/:id/photos", photoHandler.GetBySpotID)
+
+				// Review routes unter /spots/:id
+				spot.GET("/:id/reviews", reviewHandler.List)
+				spot.POST("/:id/reviews", reviewHandler.Create)
+				spot.PATCH("/:id/reviews/:reviewId", reviewHandler.Update)
+				spot.DELETE("/:id/reviews/:reviewId", reviewHandler.Delete)
 			}
 
+			// Amenity catalogue
+			protected.GET("/amenities", authMiddleware.RequireScope(domain.ScopeSpotsRead, ""), amenityHandler.List)
+
 			// Visit routes
 			visits := protected.Group("/visits")
+			visits.Use(authMiddleware.RequireScope(domain.ScopeVisitsRead, domain.ScopeVisitsWrite))
 			{
 				visits.GET("", visitHandler.ListVisits)
 				visits.POST("", visitHandler.CreateVisit)
@@ -95,41 +180,70 @@ func Setup(
 
 			// Favorites routes
 			favorites := protected.Group("/favorites")
+			favorites.Use(authMiddleware.RequireSession())
 			{
 				favorites.GET("", favoriteHandler.List)
 			}
 
 			// Photo routes
 			photos := protected.Group("/photos")
+			photos.Use(authMiddleware.RequireScope(domain.ScopeSpotsRead, domain.ScopeSpotsWrite))
 			{
 				photos.DELETE("/:id", photoHandler.Delete)
 				photos.PATCH("/:id/main", photoHandler.SetMainPhoto)
 				photos.GET("/:id/url", photoHandler.GetPresignedURL)
 			}
 
-			// Admin routes
+			// Admin routes - each route needs its permission, see domain.Permission
 			admin := protected.Group("/admin")
-			admin.Use(authMiddleware.RequireAdmin())
+			admin.Use(authMiddleware.RequireScope(domain.ScopeAdmin, domain.ScopeAdmin))
 			{
-				admin.GET("/users", adminHandler.ListUsers)
-				admin.PATCH("/users/:id", adminHandler.UpdateUser)
-				admin.DELETE("/users/:id", adminHandler.DeleteUser)
-				admin.GET("/invitation-codes", adminHandler.ListInvitationCodes)
-				admin.POST("/invitation-codes", adminHandler.CreateInvitationCode)
-				admin.DELETE("/invitation-codes/:id", adminHandler.DeleteInvitationCode)
+				manageUsers := authMiddleware.RequirePermission(domain.PermissionUserManage)
+				manageInvitations := authMiddleware.RequirePermission(domain.PermissionInvitationManage)
+				manageAmenities := authMiddleware.RequirePermission(domain.PermissionAmenityManage)
+
+				admin.GET("/users", manageUsers, adminHandler.ListUsers)
+				admin.PATCH("/users/:id", manageUsers, adminHandler.UpdateUser)
+				admin.DELETE("/users/:id", manageUsers, adminHandler.DeleteUser)
+				admin.POST("/users/:id/unlock", manageUsers, adminHandler.UnlockUser)
+				admin.POST("/users/:id/deactivate", manageUsers, adminHandler.DeactivateUser)
+				admin.GET("/users/:id/invite-tree", manageUsers, adminHandler.GetUserInviteTree)
+				admin.GET("/invite-tree", manageUsers, adminHandler.GetInviteTree)
+				admin.GET("/invitation-codes", manageInvitations, adminHandler.ListInvitationCodes)
+				admin.POST("/invitation-codes", manageInvitations, adminHandler.CreateInvitationCode)
+				admin.DELETE("/invitation-codes/:id", manageInvitations, adminHandler.DeleteInvitationCode)
+				admin.GET("/audit", authMiddleware.RequirePermission(domain.PermissionAuditRead), auditHandler.List)
+				admin.POST("/spots/import", authMiddleware.RequirePermission(domain.PermissionSpotImport), spotHandler.Import)
+				admin.POST("/spots/merge", authMiddleware.RequirePermission(domain.PermissionSpotMerge), spotHandler.Merge)
+				admin.POST("/amenities", manageAmenities, amenityHandler.Create)
+				admin.PATCH("/amenities/:id", manageAmenities, amenityHandler.Update)
+				admin.DELETE("/amenities/:id", manageAmenities, amenityHandler.Delete)
 			}
 
 			// Weather routes
 			weather := protected.Group("/weather")
+			weather.Use(authMiddleware.RequireSession())
 			{
 				weather.GET("", weatherHandler.GetCurrentWeather)
 			}
 
 			// Activity routes
 			activities := protected.Group("/activities")
+			activities.Use(authMiddleware.RequireSession())
 			{
 				activities.GET("", activityHandler.List)
 			}
+
+			// Notification routes
+			notifications := protected.Group("/notifications")
+			notifications.Use(authMiddleware.RequireSession())
+			{
+				notifications.GET("", notificationHandler.List)
+				notifications.GET("/unread-count", notificationHandler.GetUnreadCount)
+				notifications.POST("/read-all", notificationHandler.MarkAllAsRead)
+				notifications.PATCH("/:id/read", notificationHandler.MarkAsRead)
+				notifications.DELETE("/:id", notificationHandler.Delete)
+			}
 		}
 	}
 
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
+)
+
+// AccountLockoutService slows down password guessing per account, independent of the client IP.
+// Once LoginLockoutThreshold logins failed the account is locked, every further failure doubles the lock.
+type AccountLockoutService interface {
+	// Check returns ErrAccountLocked with the retry time while the account is locked
+	Check(ctx context.Context, userID uint) error
+	// RecordFailure counts a failed login, returns ErrAccountLocked if the account got locked by it
+	RecordFailure(ctx context.Context, userID uint, client requests.ClientInfo) error
+	// Reset clears the failures after a successful login
+	Reset(ctx context.Context, userID uint) error
+	// Unlock clears failures and lock on behalf of an admin
+	Unlock(ctx context.Context, userID, adminID uint) error
+}
+
+type accountLockoutService struct {
+	lockoutRepo       repository.AccountLockoutRepository
+	securityEventRepo repository.SecurityEventRepository
+	config            config.Config
+}
+
+func NewAccountLockoutService(
+	lockoutRepo repository.AccountLockoutRepository,
+	securityEventRepo repository.SecurityEventRepository,
+	config config.Config,
+) AccountLockoutService {
+	return &accountLockoutService{
+		lockoutRepo:       lockoutRepo,
+		securityEventRepo: securityEventRepo,
+		config:            config,
+	}
+}
+
+// Check implements AccountLockoutService.
+func (s *accountLockoutService) Check(ctx context.Context, userID uint) error {
+	if s.config.LoginLockoutThreshold <= 0 {
+		return nil
+	}
+
+	lockout, err := s.lockoutRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if lockout != nil && lockout.IsLocked() {
+		return apperror.WithRetryAfter(apperror.ErrAccountLocked, *lockout.LockedUntil)
+	}
+
+	return nil
+}
+
+// RecordFailure implements AccountLockoutService.
+func (s *accountLockoutService) RecordFailure(ctx context.Context, userID uint, client requests.ClientInfo) error {
+	if s.config.LoginLockoutThreshold <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	lockout, err := s.lockoutRepo.RecordFailure(ctx, userID, now.Add(-s.config.LoginFailureWindow))
+	if err != nil {
+		return err
+	}
+	if lockout.FailedAttempts < s.config.LoginLockoutThreshold {
+		return nil
+	}
+
+	duration := s.lockDuration(lockout.FailedAttempts)
+	lockedUntil := now.Add(duration)
+	if err := s.lockoutRepo.Lock(ctx, userID, lockedUntil); err != nil {
+		return err
+	}
+
+	event := &domain.SecurityEvent{
+		UserID:    userID,
+		Type:      domain.SecurityEventAccountLocked,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		Details:   fmt.Sprintf("%d failed logins, locked for %s", lockout.FailedAttempts, duration),
+		CreatedAt: now,
+	}
+	if err := s.securityEventRepo.Create(ctx, event); err != nil {
+		return err
+	}
+
+	logger.Warn().
+		Uint("userID", userID).
+		Int("failedAttempts", lockout.FailedAttempts).
+		Dur("lockedFor", duration).
+		Str("ip", client.IPAddress).
+		Msg("Account locked after failed logins")
+
+	return apperror.WithRetryAfter(apperror.ErrAccountLocked, lockedUntil)
+}
+
+// Reset implements AccountLockoutService.
+func (s *accountLockoutService) Reset(ctx context.Context, userID uint) error {
+	_, err := s.lockoutRepo.Delete(ctx, userID)
+	return err
+}
+
+// Unlock implements AccountLockoutService.
+func (s *accountLockoutService) Unlock(ctx context.Context, userID, adminID uint) error {
+	deleted, err := s.lockoutRepo.Delete(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return nil
+	}
+
+	event := &domain.SecurityEvent{
+		UserID:    userID,
+		Type:      domain.SecurityEventAccountUnlocked,
+		Details:   fmt.Sprintf("failed logins cleared by admin %d", adminID),
+		CreatedAt: time.Now(),
+	}
+	return s.securityEventRepo.Create(ctx, event)
+}
+
+// lockDuration is the base lock at the threshold, doubled for every failure beyond it
+func (s *accountLockoutService) lockDuration(failedAttempts int) time.Duration {
+	duration := s.config.LoginLockoutBase
+	for i := s.config.LoginLockoutThreshold; i < failedAttempts && duration < s.config.LoginLockoutMax; i++ {
+		duration *= 2
+	}
+	return min(duration, s.config.LoginLockoutMax)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func lockoutTestConfig() config.Config {
+	return config.Config{
+		LoginLockoutThreshold: 5,
+		LoginLockoutBase:      30 * time.Second,
+		LoginLockoutMax:       time.Hour,
+		LoginFailureWindow:    24 * time.Hour,
+	}
+}
+
+func TestAccountLockoutService_RecordFailure_BelowThreshold(t *testing.T) {
+	// Arrange
+	lockoutRepo := mocks.NewAccountLockoutRepository(t)
+	svc := NewAccountLockoutService(lockoutRepo, nil, lockoutTestConfig())
+
+	lockoutRepo.EXPECT().
+		RecordFailure(mock.Anything, uint(1), mock.AnythingOfType("time.Time")).
+		Return(&domain.AccountLockout{UserID: 1, FailedAttempts: 4}, nil)
+
+	// Act
+	err := svc.RecordFailure(context.Background(), 1, requests.ClientInfo{})
+
+	// Assert - no lock and no security event yet
+	assert.NoError(t, err)
+}
+
+func TestAccountLockoutService_RecordFailure_LocksAtThreshold(t *testing.T) {
+	// Arrange
+	lockoutRepo := mocks.NewAccountLockoutRepository(t)
+	securityEventRepo := mocks.NewSecurityEventRepository(t)
+	svc := NewAccountLockoutService(lockoutRepo, securityEventRepo, lockoutTestConfig())
+
+	lockoutRepo.EXPECT().
+		RecordFailure(mock.Anything, uint(1), mock.AnythingOfType("time.Time")).
+		Return(&domain.AccountLockout{UserID: 1, FailedAttempts: 5}, nil)
+
+	var lockedUntil time.Time
+	lockoutRepo.EXPECT().
+		Lock(mock.Anything, uint(1), mock.AnythingOfType("time.Time")).
+		Run(func(ctx context.Context, userID uint, until time.Time) {
+			lockedUntil = until
+		}).
+		Return(nil)
+
+	securityEventRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+			return event.UserID == 1 && event.Type == domain.SecurityEventAccountLocked && event.IPAddress == "203.0.113.7"
+		})).
+		Return(nil)
+
+	// Act
+	err := svc.RecordFailure(context.Background(), 1, requests.ClientInfo{IPAddress: "203.0.113.7"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrAccountLocked)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), lockedUntil, 2*time.Second)
+
+	var dataErr *apperror.DataError
+	assert.True(t, errors.As(err, &dataErr))
+	retry, ok := dataErr.Data.(apperror.RetryAfter)
+	assert.True(t, ok)
+	assert.InDelta(t, 30, retry.RetryAfterSeconds, 1)
+
+	// Clients read the snake_case keys of the error body
+	body, err := json.Marshal(dataErr.Data)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"retry_after_seconds":`)
+	assert.Contains(t, string(body), `"retry_at":`)
+}
+
+func TestAccountLockoutService_LockDuration_DoublesUpToMax(t *testing.T) {
+	// Arrange
+	svc := NewAccountLockoutService(nil, nil, lockoutTestConfig()).(*accountLockoutService)
+
+	// Act & Assert
+	assert.Equal(t, 30*time.Second, svc.lockDuration(5))
+	assert.Equal(t, time.Minute, svc.lockDuration(6))
+	assert.Equal(t, 4*time.Minute, svc.lockDuration(8))
+	assert.Equal(t, time.Hour, svc.lockDuration(12))
+	assert.Equal(t, time.Hour, svc.lockDuration(100))
+}
+
+func TestAccountLockoutService_Check_Locked(t *testing.T) {
+	// Arrange
+	lockoutRepo := mocks.NewAccountLockoutRepository(t)
+	svc := NewAccountLockoutService(lockoutRepo, nil, lockoutTestConfig())
+
+	lockedUntil := time.Now().Add(2 * time.Minute)
+	lockoutRepo.EXPECT().
+		FindByUserID(mock.Anything, uint(1)).
+		Return(&domain.AccountLockout{UserID: 1, FailedAttempts: 7, LockedUntil: &lockedUntil}, nil)
+
+	// Act
+	err := svc.Check(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrAccountLocked)
+}
+
+func TestAccountLockoutService_Check_LockExpired(t *testing.T) {
+	// Arrange
+	lockoutRepo := mocks.NewAccountLockoutRepository(t)
+	svc := NewAccountLockoutService(lockoutRepo, nil, lockoutTestConfig())
+
+	lockedUntil := time.Now().Add(-time.Second)
+	lockoutRepo.EXPECT().
+		FindByUserID(mock.Anything, uint(1)).
+		Return(&domain.AccountLockout{UserID: 1, FailedAttempts: 5, LockedUntil: &lockedUntil}, nil)
+
+	// Act
+	err := svc.Check(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAccountLockoutService_Disabled(t *testing.T) {
+	// Arrange - no repository calls expected
+	svc := NewAccountLockoutService(nil, nil, config.Config{})
+
+	// Act & Assert
+	assert.NoError(t, svc.Check(context.Background(), 1))
+	assert.NoError(t, svc.RecordFailure(context.Background(), 1, requests.ClientInfo{}))
+}
+
+func TestAccountLockoutService_Unlock_RecordsEvent(t *testing.T) {
+	// Arrange
+	lockoutRepo := mocks.NewAccountLockoutRepository(t)
+	securityEventRepo := mocks.NewSecurityEventRepository(t)
+	svc := NewAccountLockoutService(lockoutRepo, securityEventRepo, lockoutTestConfig())
+
+	lockoutRepo.EXPECT().Delete(mock.Anything, uint(1)).Return(true, nil)
+	securityEventRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(event *domain.SecurityEvent) bool {
+			return event.UserID == 1 && event.Type == domain.SecurityEventAccountUnlocked
+		})).
+		Return(nil)
+
+	// Act
+	err := svc.Unlock(context.Background(), 1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAccountLockoutService_Unlock_NothingToClear(t *testing.T) {
+	// Arrange
+	lockoutRepo := mocks.NewAccountLockoutRepository(t)
+	svc := NewAccountLockoutService(lockoutRepo, nil, lockoutTestConfig())
+
+	lockoutRepo.EXPECT().Delete(mock.Anything, uint(1)).Return(false, nil)
+
+	// Act
+	err := svc.Unlock(context.Background(), 1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+}
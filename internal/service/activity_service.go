@@ -14,7 +14,7 @@ import (
 
 type ActivityService interface {
 	Create(ctx context.Context, userID uint, actionType string, spotID *uint) error
-	List(ctx context.Context, req *requests.ListActivitiesRequest) (*responses.PaginatedActivitiesResponse, error)
+	List(ctx context.Context, userID uint, req *requests.ListActivitiesRequest) (*responses.PaginatedActivitiesResponse, error)
 }
 
 type activityService struct {
@@ -42,12 +42,15 @@ func (s *activityService) Create(ctx context.Context, userID uint, actionType st
 	return s.activityRepo.Create(ctx, activity)
 }
 
-func (s *activityService) List(ctx context.Context, req *requests.ListActivitiesRequest) (*responses.PaginatedActivitiesResponse, error) {
+func (s *activityService) List(ctx context.Context, userID uint, req *requests.ListActivitiesRequest) (*responses.PaginatedActivitiesResponse, error) {
 	filter := repository.ActivityFilter{
 		Page:       req.Page,
 		Limit:      req.Limit,
 		ActionType: req.ActionType,
 	}
+	if req.Scope == "friends" {
+		filter.FriendsOf = &userID
+	}
 
 	activities, total, err := s.activityRepo.FindAll(ctx, filter)
 	if err != nil {
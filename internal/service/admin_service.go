@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
@@ -15,24 +16,41 @@ import (
 type AdminService interface {
 	// Users
 	ListUsers(ctx context.Context, req *requests.ListUsersRequest) (*responses.PaginatedUsersResponse, error)
-	UpdateUser(ctx context.Context, id uint, req *requests.AdminUpdateUserRequest) (*responses.UserResponse, error)
+	UpdateUser(ctx context.Context, id uint, adminID uint, req *requests.AdminUpdateUserRequest) (*responses.UserResponse, error)
 	DeleteUser(ctx context.Context, id uint, adminID uint) error
+	// UnlockUser clears failed logins and a lock of the account
+	UnlockUser(ctx context.Context, id uint, adminID uint) error
 
 	// Invitation Codes
 	ListInvitationCodes(ctx context.Context, req *requests.ListInvitationCodesRequest) (*responses.PaginatedInvitationCodesResponse, error)
 	CreateInvitationCode(ctx context.Context, req *requests.CreateInvitationCodeRequest, adminID uint) (*responses.InvitationCodeResponse, error)
-	DeleteInvitationCode(ctx context.Context, id uint) error
+	DeleteInvitationCode(ctx context.Context, id uint, adminID uint) error
+
+	// Invite Tree
+	GetInviteTree(ctx context.Context, req *requests.InviteTreeRequest) (*responses.InviteTreeResponse, error)
+	GetUserInviteTree(ctx context.Context, id uint, req *requests.InviteTreeRequest) (*responses.InviteTreeNodeResponse, error)
+	// DeactivateUser deactivates a user and the users they invited, on request also everyone below them
+	DeactivateUser(ctx context.Context, id uint, adminID uint, req *requests.DeactivateUserRequest) (*responses.DeactivateUsersResponse, error)
 }
 
+// recursiveDeactivationDepth bounds a recursive deactivation, real invite chains are far shorter
+const recursiveDeactivationDepth = 100
+
 type adminService struct {
 	userRepo           repository.UserRepository
 	invitationCodeRepo repository.InvitationRepository
+	tokenVersions      TokenVersionService
+	lockouts           AccountLockoutService
+	audit              AuditService
 }
 
-func NewAdminService(userRepo repository.UserRepository, invitationCodeRepo repository.InvitationRepository) AdminService {
+func NewAdminService(userRepo repository.UserRepository, invitationCodeRepo repository.InvitationRepository, tokenVersions TokenVersionService, lockouts AccountLockoutService, audit AuditService) AdminService {
 	return &adminService{
 		userRepo:           userRepo,
 		invitationCodeRepo: invitationCodeRepo,
+		tokenVersions:      tokenVersions,
+		lockouts:           lockouts,
+		audit:              audit,
 	}
 }
 
@@ -43,6 +61,8 @@ func (a *adminService) ListUsers(ctx context.Context, req *requests.ListUsersReq
 		Limit:    req.Limit,
 		IsActive: req.IsActive,
 		Search:   req.Search,
+
+		EmailVerified: req.EmailVerified,
 		// Role isn't a pointer in the request, so we need to check for empty string
 	}
 
@@ -73,7 +93,7 @@ func (a *adminService) ListUsers(ctx context.Context, req *requests.ListUsersReq
 	}, nil
 }
 
-func (a *adminService) UpdateUser(ctx context.Context, id uint, req *requests.AdminUpdateUserRequest) (*responses.UserResponse, error) {
+func (a *adminService) UpdateUser(ctx context.Context, id uint, adminID uint, req *requests.AdminUpdateUserRequest) (*responses.UserResponse, error) {
 	// Get existing user
 	user, err := a.userRepo.FindByID(ctx, id)
 	if err != nil {
@@ -83,6 +103,11 @@ func (a *adminService) UpdateUser(ctx context.Context, id uint, req *requests.Ad
 		return nil, apperror.ErrUserNotFound
 	}
 
+	// Tokens carry the role, so a change or deactivation revokes them
+	revokeTokens := (req.Role != nil && *req.Role != user.Role) ||
+		(req.IsActive != nil && *req.IsActive != user.IsActive)
+	before := auditUserFields(user)
+
 	// Update fields
 	if req.Role != nil {
 		user.Role = *req.Role
@@ -97,6 +122,16 @@ func (a *adminService) UpdateUser(ctx context.Context, id uint, req *requests.Ad
 		return nil, err
 	}
 
+	if revokeTokens {
+		if err := a.tokenVersions.Invalidate(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if changes := domain.AuditDiff(before, auditUserFields(user)); len(changes) > 0 {
+		a.audit.Record(ctx, newAuditEvent(adminID, domain.AuditActionUserUpdated, domain.AuditTargetUser, user.ID, changes))
+	}
+
 	response := mapper.UserToResponse(user)
 	return &response, nil
 }
@@ -115,7 +150,35 @@ func (a *adminService) DeleteUser(ctx context.Context, id uint, adminID uint) er
 		return apperror.ErrUserNotFound
 	}
 
-	return a.userRepo.Delete(ctx, id)
+	// Revoke first, a cached token version must not outlive the user
+	if err := a.tokenVersions.Invalidate(ctx, id); err != nil {
+		return err
+	}
+
+	if err := a.userRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	a.audit.Record(ctx, newAuditEvent(adminID, domain.AuditActionUserDeleted, domain.AuditTargetUser, id,
+		domain.AuditDiff(auditUserFields(user), nil)))
+	return nil
+}
+
+func (a *adminService) UnlockUser(ctx context.Context, id uint, adminID uint) error {
+	user, err := a.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return apperror.ErrUserNotFound
+	}
+
+	if err := a.lockouts.Unlock(ctx, id, adminID); err != nil {
+		return err
+	}
+
+	a.audit.Record(ctx, newAuditEvent(adminID, domain.AuditActionUserUnlocked, domain.AuditTargetUser, id, nil))
+	return nil
 }
 
 func (a *adminService) ListInvitationCodes(ctx context.Context, req *requests.ListInvitationCodesRequest) (*responses.PaginatedInvitationCodesResponse, error) {
@@ -160,6 +223,15 @@ func (a *adminService) CreateInvitationCode(ctx context.Context, req *requests.C
 		Code:      code,
 		Comment:   req.Comment,
 		CreatedBy: &adminID,
+		MaxUses:   1,
+		Role:      req.Role,
+	}
+	if req.MaxUses != nil {
+		invitationCode.MaxUses = *req.MaxUses
+	}
+	if req.ExpiresInDays != nil {
+		expiresAt := time.Now().AddDate(0, 0, *req.ExpiresInDays)
+		invitationCode.ExpiresAt = &expiresAt
 	}
 
 	// Save to repository
@@ -167,6 +239,9 @@ func (a *adminService) CreateInvitationCode(ctx context.Context, req *requests.C
 		return nil, err
 	}
 
+	a.audit.Record(ctx, newAuditEvent(adminID, domain.AuditActionInvitationCodeCreated, domain.AuditTargetInvitationCode, invitationCode.ID,
+		domain.AuditDiff(nil, auditInvitationCodeFields(invitationCode))))
+
 	// Reload with Creator preloaded
 	invitationCode, err = a.invitationCodeRepo.FindByID(ctx, invitationCode.ID)
 	if err != nil {
@@ -177,7 +252,7 @@ func (a *adminService) CreateInvitationCode(ctx context.Context, req *requests.C
 	return &response, nil
 }
 
-func (a *adminService) DeleteInvitationCode(ctx context.Context, id uint) error {
+func (a *adminService) DeleteInvitationCode(ctx context.Context, id uint, adminID uint) error {
 	// Check if code exists
 	code, err := a.invitationCodeRepo.FindByID(ctx, id)
 	if err != nil {
@@ -187,10 +262,206 @@ func (a *adminService) DeleteInvitationCode(ctx context.Context, id uint) error
 		return apperror.ErrInvitationCodeNotFound
 	}
 
-	// Don't allow deleting redeemed codes
-	if code.RedeemedBy != nil {
+	// Used up codes stay as record of who was invited, partly used ones can be revoked
+	if code.IsUsedUp() {
 		return apperror.ErrCannotDeleteRedeemedCode
 	}
 
-	return a.invitationCodeRepo.Delete(ctx, id)
+	if err := a.invitationCodeRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	a.audit.Record(ctx, newAuditEvent(adminID, domain.AuditActionInvitationCodeDeleted, domain.AuditTargetInvitationCode, id,
+		domain.AuditDiff(auditInvitationCodeFields(code), nil)))
+	return nil
+}
+
+func (a *adminService) GetInviteTree(ctx context.Context, req *requests.InviteTreeRequest) (*responses.InviteTreeResponse, error) {
+	nodes, err := a.invitationCodeRepo.FindInviteTree(ctx, nil, req.Depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.InviteTreeResponse{
+		Roots: buildInviteTree(nodes),
+		Depth: req.Depth,
+	}, nil
+}
+
+func (a *adminService) GetUserInviteTree(ctx context.Context, id uint, req *requests.InviteTreeRequest) (*responses.InviteTreeNodeResponse, error) {
+	user, err := a.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, apperror.ErrUserNotFound
+	}
+
+	nodes, err := a.invitationCodeRepo.FindInviteTree(ctx, &id, req.Depth)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := buildInviteTree(nodes)
+	if len(roots) == 0 {
+		return nil, apperror.ErrUserNotFound
+	}
+	return &roots[0], nil
+}
+
+// DeactivateUser implements [AdminService].
+// Admins below the root are skipped, they have to be demoted first. The acting admin is never deactivated.
+func (a *adminService) DeactivateUser(ctx context.Context, id uint, adminID uint, req *requests.DeactivateUserRequest) (*responses.DeactivateUsersResponse, error) {
+	if id == adminID {
+		return nil, apperror.ErrCannotDeactivateSelf
+	}
+
+	user, err := a.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, apperror.ErrUserNotFound
+	}
+
+	depth := 1
+	if req.Recursive {
+		depth = recursiveDeactivationDepth
+	}
+	nodes, err := a.invitationCodeRepo.FindInviteTree(ctx, &id, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &responses.DeactivateUsersResponse{
+		DryRun:      req.DryRun,
+		Deactivated: []responses.InviteTreeUserResponse{},
+		Skipped:     []responses.InviteTreeUserResponse{},
+	}
+	var ids []uint
+	for _, node := range nodes {
+		// Nothing to do, their invitees are still covered
+		if !node.IsActive || node.IsDeleted {
+			continue
+		}
+		if node.UserID == adminID || (node.Depth > 0 && node.Role.Can(domain.PermissionUserManage)) {
+			response.Skipped = append(response.Skipped, inviteTreeUserToResponse(node))
+			continue
+		}
+		ids = append(ids, node.UserID)
+		response.Deactivated = append(response.Deactivated, inviteTreeUserToResponse(node))
+	}
+
+	if req.DryRun || len(ids) == 0 {
+		return response, nil
+	}
+
+	if err := a.userRepo.DeactivateMany(ctx, ids); err != nil {
+		return nil, err
+	}
+	// Access tokens of deactivated users stop working immediately, refresh tokens check the flag
+	for _, userID := range ids {
+		if err := a.tokenVersions.Invalidate(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	deactivated := map[string]domain.AuditChange{"is_active": {Before: true, After: false}}
+	for _, userID := range ids {
+		a.audit.Record(ctx, newAuditEvent(adminID, domain.AuditActionUserDeactivated, domain.AuditTargetUser, userID, deactivated))
+	}
+
+	return response, nil
+}
+
+// auditUserFields are the fields of a user admins change, recorded before and after
+func auditUserFields(user *domain.User) map[string]any {
+	return map[string]any{
+		"email":        user.Email,
+		"display_name": user.DisplayName,
+		"role":         string(user.Role),
+		"is_active":    user.IsActive,
+	}
+}
+
+func auditInvitationCodeFields(code *domain.InvitationCode) map[string]any {
+	fields := map[string]any{
+		"code":       code.Code,
+		"comment":    code.Comment,
+		"max_uses":   code.MaxUses,
+		"use_count":  code.UseCount,
+		"expires_at": code.ExpiresAt,
+	}
+	if code.Role != nil {
+		fields["role"] = string(*code.Role)
+	}
+	return fields
+}
+
+// buildInviteTree nests the flat nodes of the repository below their roots and sums up every branch
+func buildInviteTree(nodes []repository.InviteTreeNode) []responses.InviteTreeNodeResponse {
+	invitees := make(map[uint][]repository.InviteTreeNode)
+	var roots []repository.InviteTreeNode
+	for _, node := range nodes {
+		if node.Depth == 0 || node.InvitedBy == nil {
+			roots = append(roots, node)
+			continue
+		}
+		invitees[*node.InvitedBy] = append(invitees[*node.InvitedBy], node)
+	}
+
+	result := make([]responses.InviteTreeNodeResponse, len(roots))
+	for i, root := range roots {
+		result[i] = inviteTreeNodeToResponse(root, invitees)
+	}
+	return result
+}
+
+func inviteTreeNodeToResponse(node repository.InviteTreeNode, invitees map[uint][]repository.InviteTreeNode) responses.InviteTreeNodeResponse {
+	response := responses.InviteTreeNodeResponse{
+		InviteTreeUserResponse: inviteTreeUserToResponse(node),
+		SpotCount:              node.SpotCount,
+		PhotoCount:             node.PhotoCount,
+		ReviewCount:            node.ReviewCount,
+		Branch: responses.InviteBranchResponse{
+			Users:   1,
+			Spots:   node.SpotCount,
+			Photos:  node.PhotoCount,
+			Reviews: node.ReviewCount,
+		},
+		Invitees: []responses.InviteTreeNodeResponse{},
+	}
+	if node.IsActive && !node.IsDeleted {
+		response.Branch.ActiveUsers = 1
+	}
+
+	for _, invitee := range invitees[node.UserID] {
+		// Only the next level, keeps the recursion finite even on inconsistent data
+		if invitee.Depth != node.Depth+1 {
+			continue
+		}
+		child := inviteTreeNodeToResponse(invitee, invitees)
+		response.Branch.Users += child.Branch.Users
+		response.Branch.ActiveUsers += child.Branch.ActiveUsers
+		response.Branch.Depth = max(response.Branch.Depth, child.Branch.Depth+1)
+		response.Branch.Spots += child.Branch.Spots
+		response.Branch.Photos += child.Branch.Photos
+		response.Branch.Reviews += child.Branch.Reviews
+		response.Invitees = append(response.Invitees, child)
+	}
+	return response
+}
+
+func inviteTreeUserToResponse(node repository.InviteTreeNode) responses.InviteTreeUserResponse {
+	return responses.InviteTreeUserResponse{
+		ID:          node.UserID,
+		Email:       node.Email,
+		DisplayName: node.DisplayName,
+		Role:        string(node.Role),
+		IsActive:    node.IsActive,
+		IsDeleted:   node.IsDeleted,
+		CreatedAt:   node.CreatedAt,
+		InvitedBy:   node.InvitedBy,
+		Depth:       node.Depth,
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"hopSpotAPI/internal/dto/requests"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -18,7 +19,7 @@ func TestAdminService_ListUsers_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	users := []domain.User{
 		{
@@ -61,7 +62,7 @@ func TestAdminService_ListUsers_WithFilter(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	users := []domain.User{
 		{
@@ -102,7 +103,8 @@ func TestAdminService_UpdateUser_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAdminService(userRepo, invitationRepo, tokenVersions, nil, newNoopAuditService(t))
 
 	user := &domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -131,8 +133,10 @@ func TestAdminService_UpdateUser_Success(t *testing.T) {
 		}).
 		Return(nil)
 
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+
 	// Act
-	result, err := svc.UpdateUser(context.Background(), uint(1), req)
+	result, err := svc.UpdateUser(context.Background(), uint(1), uint(100), req)
 
 	// Assert
 	assert.NoError(t, err)
@@ -144,7 +148,7 @@ func TestAdminService_UpdateUser_UserNotFound(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	newRole := domain.RoleAdmin
 	req := &requests.AdminUpdateUserRequest{
@@ -156,7 +160,7 @@ func TestAdminService_UpdateUser_UserNotFound(t *testing.T) {
 		Return(nil, nil)
 
 	// Act
-	result, err := svc.UpdateUser(context.Background(), uint(999), req)
+	result, err := svc.UpdateUser(context.Background(), uint(999), uint(100), req)
 
 	// Assert
 	assert.Error(t, err)
@@ -168,7 +172,8 @@ func TestAdminService_UpdateUser_PartialUpdate(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAdminService(userRepo, invitationRepo, tokenVersions, nil, newNoopAuditService(t))
 
 	user := &domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -197,8 +202,10 @@ func TestAdminService_UpdateUser_PartialUpdate(t *testing.T) {
 		}).
 		Return(nil)
 
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+
 	// Act
-	result, err := svc.UpdateUser(context.Background(), uint(1), req)
+	result, err := svc.UpdateUser(context.Background(), uint(1), uint(100), req)
 
 	// Assert
 	assert.NoError(t, err)
@@ -206,11 +213,89 @@ func TestAdminService_UpdateUser_PartialUpdate(t *testing.T) {
 	assert.Equal(t, "user", result.Role) // Unchanged
 }
 
+func TestAdminService_UpdateUser_RecordsChangedFields(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	audit := mocks.NewAuditService(t)
+	svc := NewAdminService(userRepo, nil, tokenVersions, nil, audit)
+
+	user := &domain.User{
+		Model:       &gorm.Model{ID: 1},
+		Email:       "user@example.com",
+		DisplayName: "Test User",
+		Role:        domain.RoleUser,
+		IsActive:    true,
+	}
+	role := domain.RoleModerator
+	req := &requests.AdminUpdateUserRequest{Role: &role}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	userRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+	audit.EXPECT().
+		Record(mock.Anything, mock.AnythingOfType("*domain.AuditEvent")).
+		Run(func(ctx context.Context, event *domain.AuditEvent) {
+			assert.Equal(t, domain.AuditActionUserUpdated, event.Action)
+			assert.Equal(t, uint(100), *event.ActorID)
+			assert.Equal(t, domain.AuditTargetUser, event.TargetType)
+			assert.Equal(t, uint(1), *event.TargetID)
+			// Unchanged fields are left out
+			assert.Equal(t, map[string]domain.AuditChange{
+				"role": {Before: "user", After: "moderator"},
+			}, event.Changes)
+		}).
+		Once()
+
+	// Act
+	_, err := svc.UpdateUser(context.Background(), uint(1), uint(100), req)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAdminService_UpdateUser_UnchangedKeepsTokens(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAdminService(userRepo, invitationRepo, tokenVersions, nil, newNoopAuditService(t))
+
+	user := &domain.User{
+		Model:    &gorm.Model{ID: 1},
+		Role:     domain.RoleUser,
+		IsActive: true,
+	}
+
+	role := domain.RoleUser
+	isActive := true
+	req := &requests.AdminUpdateUserRequest{
+		Role:     &role,
+		IsActive: &isActive,
+	}
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(user, nil)
+
+	userRepo.EXPECT().
+		Update(mock.Anything, mock.AnythingOfType("*domain.User")).
+		Return(nil)
+
+	// Act
+	_, err := svc.UpdateUser(context.Background(), uint(1), uint(100), req)
+
+	// Assert
+	assert.NoError(t, err)
+	tokenVersions.AssertNotCalled(t, "Invalidate", mock.Anything, mock.Anything)
+}
+
 func TestAdminService_DeleteUser_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAdminService(userRepo, invitationRepo, tokenVersions, nil, newNoopAuditService(t))
 
 	user := &domain.User{
 		Model:       &gorm.Model{ID: 2},
@@ -222,6 +307,8 @@ func TestAdminService_DeleteUser_Success(t *testing.T) {
 		FindByID(mock.Anything, uint(2)).
 		Return(user, nil)
 
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(2)).Return(nil)
+
 	userRepo.EXPECT().
 		Delete(mock.Anything, uint(2)).
 		Return(nil)
@@ -237,7 +324,7 @@ func TestAdminService_DeleteUser_CannotDeleteSelf(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	// Act - admin tries to delete themselves
 	err := svc.DeleteUser(context.Background(), uint(1), uint(1))
@@ -251,7 +338,7 @@ func TestAdminService_DeleteUser_UserNotFound(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	userRepo.EXPECT().
 		FindByID(mock.Anything, uint(999)).
@@ -269,7 +356,7 @@ func TestAdminService_ListInvitationCodes_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	adminID := uint(1)
 	adminUser := domain.User{
@@ -281,20 +368,20 @@ func TestAdminService_ListInvitationCodes_Success(t *testing.T) {
 
 	codes := []domain.InvitationCode{
 		{
-			Model:      &gorm.Model{ID: 1},
-			Code:       "ABC123",
-			Comment:    "For friend",
-			CreatedBy:  &adminID,
-			Creator:    &adminUser,
-			RedeemedBy: nil,
+			Model:     &gorm.Model{ID: 1},
+			Code:      "ABC123",
+			Comment:   "For friend",
+			CreatedBy: &adminID,
+			Creator:   &adminUser,
+			MaxUses:   1,
 		},
 		{
-			Model:      &gorm.Model{ID: 2},
-			Code:       "XYZ789",
-			Comment:    "For colleague",
-			CreatedBy:  &adminID,
-			Creator:    &adminUser,
-			RedeemedBy: nil,
+			Model:     &gorm.Model{ID: 2},
+			Code:      "XYZ789",
+			Comment:   "For colleague",
+			CreatedBy: &adminID,
+			Creator:   &adminUser,
+			MaxUses:   1,
 		},
 	}
 
@@ -321,7 +408,7 @@ func TestAdminService_ListInvitationCodes_FilterRedeemed(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	isRedeemed := true
 	req := &requests.ListInvitationCodesRequest{
@@ -349,7 +436,7 @@ func TestAdminService_CreateInvitationCode_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	req := &requests.CreateInvitationCodeRequest{
 		Comment: "For new team member",
@@ -375,6 +462,22 @@ func TestAdminService_CreateInvitationCode_Success(t *testing.T) {
 		}).
 		Return(nil)
 
+	// Reload with Creator preloaded
+	creatorID := uint(1)
+	invitationRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.InvitationCode{
+			Model:     &gorm.Model{ID: 1},
+			Code:      "ABC123",
+			Comment:   "For new team member",
+			CreatedBy: &creatorID,
+			Creator: &domain.User{
+				Model:       &gorm.Model{ID: 1},
+				DisplayName: "Admin",
+				Role:        domain.RoleAdmin,
+			},
+		}, nil)
+
 	// Act
 	result, err := svc.CreateInvitationCode(context.Background(), req, uint(1))
 
@@ -389,7 +492,7 @@ func TestAdminService_ListUsers_Pagination(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
-	svc := NewAdminService(userRepo, invitationRepo)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
 
 	// 55 total users, requesting page 2 with limit 50
 	users := make([]domain.User, 5) // Only 5 users on page 2
@@ -424,3 +527,142 @@ func TestAdminService_ListUsers_Pagination(t *testing.T) {
 	assert.Equal(t, 2, result.Pagination.TotalPages) // 55/50 = 2 pages
 	assert.Equal(t, 2, result.Pagination.Page)
 }
+
+func TestAdminService_UnlockUser_Success(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	svc := NewAdminService(userRepo, nil, nil, lockouts, newNoopAuditService(t))
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(2)).Return(&domain.User{Model: &gorm.Model{ID: 2}}, nil)
+	lockouts.EXPECT().Unlock(mock.Anything, uint(2), uint(1)).Return(nil)
+
+	// Act
+	err := svc.UnlockUser(context.Background(), 2, 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAdminService_UnlockUser_NotFound(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewAdminService(userRepo, nil, nil, nil, newNoopAuditService(t))
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(2)).Return(nil, nil)
+
+	// Act
+	err := svc.UnlockUser(context.Background(), 2, 1)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrUserNotFound)
+}
+
+func TestAdminService_GetUserInviteTree_BranchStats(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewAdminService(userRepo, invitationRepo, nil, nil, newNoopAuditService(t))
+
+	inviter := uint(1)
+	root, child := uint(2), uint(3)
+	userRepo.EXPECT().FindByID(mock.Anything, root).Return(&domain.User{Model: &gorm.Model{ID: root}}, nil)
+	invitationRepo.EXPECT().
+		FindInviteTree(mock.Anything, &root, 3).
+		Return([]repository.InviteTreeNode{
+			{UserID: root, InvitedBy: &inviter, Depth: 0, IsActive: true, SpotCount: 1},
+			{UserID: child, InvitedBy: &root, Depth: 1, IsActive: true, SpotCount: 2, ReviewCount: 4},
+			{UserID: 4, InvitedBy: &root, Depth: 1, IsActive: false},
+			{UserID: 5, InvitedBy: &child, Depth: 2, IsActive: true, PhotoCount: 3},
+		}, nil)
+
+	// Act
+	tree, err := svc.GetUserInviteTree(context.Background(), root, &requests.InviteTreeRequest{Depth: 3})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, root, tree.ID)
+	assert.Equal(t, &inviter, tree.InvitedBy)
+	assert.Len(t, tree.Invitees, 2)
+	assert.Equal(t, uint(5), tree.Invitees[0].Invitees[0].ID)
+	assert.Equal(t, 4, tree.Branch.Users)
+	assert.Equal(t, 3, tree.Branch.ActiveUsers)
+	assert.Equal(t, 2, tree.Branch.Depth)
+	assert.Equal(t, int64(3), tree.Branch.Spots)
+	assert.Equal(t, int64(3), tree.Branch.Photos)
+	assert.Equal(t, int64(4), tree.Branch.Reviews)
+	assert.Equal(t, 2, tree.Invitees[0].Branch.Users)
+}
+
+func TestAdminService_DeactivateUser_DryRun(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAdminService(userRepo, invitationRepo, tokenVersions, nil, newNoopAuditService(t))
+
+	root, child := uint(2), uint(3)
+	userRepo.EXPECT().FindByID(mock.Anything, root).Return(&domain.User{Model: &gorm.Model{ID: root}}, nil)
+	invitationRepo.EXPECT().
+		FindInviteTree(mock.Anything, &root, recursiveDeactivationDepth).
+		Return([]repository.InviteTreeNode{
+			{UserID: root, Depth: 0, Role: domain.RoleUser, IsActive: true},
+			{UserID: child, InvitedBy: &root, Depth: 1, Role: domain.RoleUser, IsActive: true},
+			{UserID: 4, InvitedBy: &root, Depth: 1, Role: domain.RoleAdmin, IsActive: true},
+			{UserID: 5, InvitedBy: &child, Depth: 2, Role: domain.RoleUser, IsActive: false},
+			{UserID: 6, InvitedBy: &child, Depth: 2, Role: domain.RoleUser, IsActive: true},
+		}, nil)
+
+	// Act
+	result, err := svc.DeactivateUser(context.Background(), root, 1, &requests.DeactivateUserRequest{Recursive: true, DryRun: true})
+
+	// Assert - nothing is changed
+	assert.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Len(t, result.Deactivated, 3)
+	assert.Equal(t, uint(6), result.Deactivated[2].ID)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, uint(4), result.Skipped[0].ID)
+	userRepo.AssertNotCalled(t, "DeactivateMany", mock.Anything, mock.Anything)
+	tokenVersions.AssertNotCalled(t, "Invalidate", mock.Anything, mock.Anything)
+}
+
+func TestAdminService_DeactivateUser_DirectInvitees(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAdminService(userRepo, invitationRepo, tokenVersions, nil, newNoopAuditService(t))
+
+	root := uint(2)
+	userRepo.EXPECT().FindByID(mock.Anything, root).Return(&domain.User{Model: &gorm.Model{ID: root}}, nil)
+	invitationRepo.EXPECT().
+		FindInviteTree(mock.Anything, &root, 1).
+		Return([]repository.InviteTreeNode{
+			{UserID: root, Depth: 0, IsActive: true},
+			{UserID: 3, InvitedBy: &root, Depth: 1, IsActive: true},
+		}, nil)
+	userRepo.EXPECT().DeactivateMany(mock.Anything, []uint{2, 3}).Return(nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(2)).Return(nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(3)).Return(nil)
+
+	// Act
+	result, err := svc.DeactivateUser(context.Background(), root, 1, &requests.DeactivateUserRequest{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, result.DryRun)
+	assert.Len(t, result.Deactivated, 2)
+}
+
+func TestAdminService_DeactivateUser_CannotDeactivateSelf(t *testing.T) {
+	// Arrange
+	svc := NewAdminService(nil, nil, nil, nil, newNoopAuditService(t))
+
+	// Act
+	result, err := svc.DeactivateUser(context.Background(), 1, 1, &requests.DeactivateUserRequest{})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrCannotDeactivateSelf)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+)
+
+// Amenity keys are used in query strings (amenities=shade,view)
+var amenityKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type AmenityService interface {
+	List(ctx context.Context) ([]responses.AmenityResponse, error)
+	Create(ctx context.Context, req *requests.CreateAmenityRequest) (*responses.AmenityResponse, error)
+	Update(ctx context.Context, id uint, req *requests.UpdateAmenityRequest) (*responses.AmenityResponse, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type amenityService struct {
+	amenityRepo repository.AmenityRepository
+}
+
+func NewAmenityService(amenityRepo repository.AmenityRepository) AmenityService {
+	return &amenityService{amenityRepo: amenityRepo}
+}
+
+// List implements AmenityService.
+func (s *amenityService) List(ctx context.Context) ([]responses.AmenityResponse, error) {
+	amenities, err := s.amenityRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.AmenitiesToResponse(amenities), nil
+}
+
+// Create implements AmenityService.
+func (s *amenityService) Create(ctx context.Context, req *requests.CreateAmenityRequest) (*responses.AmenityResponse, error) {
+	req.Key = normalizeAmenityKey(req.Key)
+	if !amenityKeyPattern.MatchString(req.Key) {
+		return nil, apperror.ErrAmenityInvalidKey
+	}
+
+	existing, err := s.amenityRepo.FindByKey(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, apperror.ErrAmenityKeyExists
+	}
+
+	amenity := mapper.CreateAmenityRequestToDomain(req)
+	if err := s.amenityRepo.Create(ctx, amenity); err != nil {
+		return nil, err
+	}
+
+	response := mapper.AmenityToResponse(amenity)
+	return &response, nil
+}
+
+// Update implements AmenityService.
+func (s *amenityService) Update(ctx context.Context, id uint, req *requests.UpdateAmenityRequest) (*responses.AmenityResponse, error) {
+	amenity, err := s.amenityRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if amenity == nil {
+		return nil, apperror.ErrAmenityNotFound
+	}
+
+	if req.Name != nil {
+		amenity.Name = *req.Name
+	}
+	if req.Icon != nil {
+		amenity.Icon = *req.Icon
+	}
+
+	if err := s.amenityRepo.Update(ctx, amenity); err != nil {
+		return nil, err
+	}
+
+	response := mapper.AmenityToResponse(amenity)
+	return &response, nil
+}
+
+// Delete implements AmenityService.
+func (s *amenityService) Delete(ctx context.Context, id uint) error {
+	amenity, err := s.amenityRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if amenity == nil {
+		return apperror.ErrAmenityNotFound
+	}
+
+	// has_toilet / has_trash_bin are computed from these
+	if domain.IsLegacyAmenity(amenity.Key) {
+		return apperror.ErrAmenityProtected
+	}
+
+	return s.amenityRepo.Delete(ctx, id)
+}
+
+// normalizeAmenityKey trims and lowercases a key from a request or query string
+func normalizeAmenityKey(key string) string {
+	return strings.ToLower(strings.TrimSpace(key))
+}
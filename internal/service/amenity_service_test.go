@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAmenityService_Create_Success(t *testing.T) {
+	// Arrange
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewAmenityService(amenityRepo)
+
+	req := &requests.CreateAmenityRequest{Key: " Picnic_Table ", Name: "Picnic table", Icon: "table"}
+
+	amenityRepo.EXPECT().
+		FindByKey(mock.Anything, "picnic_table").
+		Return(nil, nil)
+
+	amenityRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.Amenity")).
+		Run(func(ctx context.Context, a *domain.Amenity) {
+			a.ID = 5
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), result.ID)
+	assert.Equal(t, "picnic_table", result.Key)
+	assert.Equal(t, "Picnic table", result.Name)
+}
+
+func TestAmenityService_Create_InvalidKey(t *testing.T) {
+	// Arrange
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewAmenityService(amenityRepo)
+
+	// Act
+	result, err := svc.Create(context.Background(), &requests.CreateAmenityRequest{Key: "shade,view", Name: "Shade"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperror.ErrAmenityInvalidKey)
+}
+
+func TestAmenityService_Create_KeyExists(t *testing.T) {
+	// Arrange
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewAmenityService(amenityRepo)
+
+	amenityRepo.EXPECT().
+		FindByKey(mock.Anything, "shade").
+		Return(&domain.Amenity{ID: 2, Key: "shade"}, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), &requests.CreateAmenityRequest{Key: "shade", Name: "Shade"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperror.ErrAmenityKeyExists)
+}
+
+func TestAmenityService_Delete_LegacyAmenityProtected(t *testing.T) {
+	// Arrange
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewAmenityService(amenityRepo)
+
+	amenityRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.Amenity{ID: 1, Key: domain.AmenityKeyToilet}, nil)
+
+	// Act
+	err := svc.Delete(context.Background(), uint(1))
+
+	// Assert - Delete is never called on the repository
+	assert.ErrorIs(t, err, apperror.ErrAmenityProtected)
+}
+
+func TestAmenityService_Delete_NotFound(t *testing.T) {
+	// Arrange
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewAmenityService(amenityRepo)
+
+	amenityRepo.EXPECT().
+		FindByID(mock.Anything, uint(99)).
+		Return(nil, nil)
+
+	// Act
+	err := svc.Delete(context.Background(), uint(99))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrAmenityNotFound)
+}
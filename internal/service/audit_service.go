@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/logger"
+)
+
+// AuditService keeps the append-only record of who changed what.
+// Events are written for admin actions, moderation of foreign content and auth events.
+type AuditService interface {
+	// Record appends an event. IP and user agent are taken from the request context if the event has none.
+	// A failure is only logged, it never fails the audited action.
+	Record(ctx context.Context, event *domain.AuditEvent)
+	List(ctx context.Context, req *requests.ListAuditEventsRequest) (*responses.PaginatedAuditEventsResponse, error)
+	// PurgeExpired removes the events older than AUDIT_RETENTION_DAYS and returns how many were removed
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+type auditService struct {
+	auditRepo repository.AuditEventRepository
+	config    config.Config
+}
+
+func NewAuditService(auditRepo repository.AuditEventRepository, config config.Config) AuditService {
+	return &auditService{
+		auditRepo: auditRepo,
+		config:    config,
+	}
+}
+
+func (s *auditService) Record(ctx context.Context, event *domain.AuditEvent) {
+	client := requests.ClientInfoFromContext(ctx)
+	if event.IPAddress == "" {
+		event.IPAddress = client.IPAddress
+	}
+	if event.UserAgent == "" {
+		event.UserAgent = client.UserAgent
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	if err := s.auditRepo.Create(ctx, event); err != nil {
+		log := logger.Error().Err(err).Str("action", event.Action).Str("targetType", event.TargetType)
+		if event.ActorID != nil {
+			log = log.Uint("actorID", *event.ActorID)
+		}
+		if event.TargetID != nil {
+			log = log.Uint("targetID", *event.TargetID)
+		}
+		log.Msg("failed to record audit event")
+	}
+}
+
+func (s *auditService) List(ctx context.Context, req *requests.ListAuditEventsRequest) (*responses.PaginatedAuditEventsResponse, error) {
+	filter := repository.AuditEventFilter{
+		Page:       req.Page,
+		Limit:      req.Limit,
+		ActorID:    req.ActorID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Action:     req.Action,
+		From:       req.From,
+		To:         req.To,
+	}
+
+	events, total, err := s.auditRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pagination calculation
+	totalPages := int(total) / req.Limit
+	if int(total)%req.Limit > 0 {
+		totalPages++
+	}
+
+	return &responses.PaginatedAuditEventsResponse{
+		Events: mapper.AuditEventsToResponse(events),
+		Pagination: responses.PaginationResponse{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func (s *auditService) PurgeExpired(ctx context.Context) (int64, error) {
+	// Zero keeps the events forever
+	if s.config.AuditRetention <= 0 {
+		return 0, nil
+	}
+	return s.auditRepo.DeleteBefore(ctx, time.Now().Add(-s.config.AuditRetention))
+}
+
+// newAuditEvent creates the event of an action a logged in user took on a resource
+func newAuditEvent(actorID uint, action, targetType string, targetID uint, changes map[string]domain.AuditChange) *domain.AuditEvent {
+	return &domain.AuditEvent{
+		ActorID:    &actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   &targetID,
+		Changes:    changes,
+	}
+}
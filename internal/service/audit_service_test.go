@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// newNoopAuditService accepts every audit event, for tests that don't check the audit log
+func newNoopAuditService(t *testing.T) *mocks.AuditService {
+	audit := mocks.NewAuditService(t)
+	audit.EXPECT().Record(mock.Anything, mock.Anything).Maybe()
+	return audit
+}
+
+func TestAuditService_Record_TakesClientInfoFromContext(t *testing.T) {
+	// Arrange
+	auditRepo := mocks.NewAuditEventRepository(t)
+	svc := NewAuditService(auditRepo, config.Config{})
+
+	ctx := requests.ContextWithClientInfo(context.Background(), requests.ClientInfo{
+		UserAgent: "HopSpot/1.0",
+		IPAddress: "203.0.113.7",
+	})
+
+	auditRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.AuditEvent")).
+		Run(func(ctx context.Context, event *domain.AuditEvent) {
+			assert.Equal(t, "203.0.113.7", event.IPAddress)
+			assert.Equal(t, "HopSpot/1.0", event.UserAgent)
+			assert.False(t, event.CreatedAt.IsZero())
+		}).
+		Return(nil)
+
+	// Act
+	svc.Record(ctx, newAuditEvent(1, domain.AuditActionUserUnlocked, domain.AuditTargetUser, 2, nil))
+}
+
+func TestAuditService_Record_FailureIsNotFatal(t *testing.T) {
+	// Arrange
+	auditRepo := mocks.NewAuditEventRepository(t)
+	svc := NewAuditService(auditRepo, config.Config{})
+
+	auditRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.AuditEvent")).
+		Return(errors.New("db down"))
+
+	// Act & Assert - only logged
+	assert.NotPanics(t, func() {
+		svc.Record(context.Background(), &domain.AuditEvent{Action: domain.AuditActionLoginFailed})
+	})
+}
+
+func TestAuditService_List_PassesFilter(t *testing.T) {
+	// Arrange
+	auditRepo := mocks.NewAuditEventRepository(t)
+	svc := NewAuditService(auditRepo, config.Config{})
+
+	actorID := uint(1)
+	targetID := uint(5)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &requests.ListAuditEventsRequest{
+		Page:       2,
+		Limit:      10,
+		ActorID:    &actorID,
+		TargetType: domain.AuditTargetSpot,
+		TargetID:   &targetID,
+		Action:     domain.AuditActionSpotDeleted,
+		From:       &from,
+	}
+
+	actor := &domain.User{Model: &gorm.Model{ID: 1}, DisplayName: "Moderator"}
+	events := []domain.AuditEvent{{
+		ID:         7,
+		ActorID:    &actorID,
+		Action:     domain.AuditActionSpotDeleted,
+		TargetType: domain.AuditTargetSpot,
+		TargetID:   &targetID,
+		Changes:    map[string]domain.AuditChange{"name": {Before: "Old Spot"}},
+		Actor:      actor,
+	}}
+
+	auditRepo.EXPECT().
+		FindAll(mock.Anything, repository.AuditEventFilter{
+			Page:       2,
+			Limit:      10,
+			ActorID:    &actorID,
+			TargetType: domain.AuditTargetSpot,
+			TargetID:   &targetID,
+			Action:     domain.AuditActionSpotDeleted,
+			From:       &from,
+		}).
+		Return(events, int64(11), nil)
+
+	// Act
+	result, err := svc.List(context.Background(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Events, 1)
+	assert.Equal(t, uint(1), result.Events[0].Actor.ID)
+	assert.Equal(t, "Old Spot", result.Events[0].Changes["name"].Before)
+	assert.Nil(t, result.Events[0].Changes["name"].After)
+	assert.Equal(t, 2, result.Pagination.TotalPages)
+}
+
+func TestAuditService_PurgeExpired_DeletesBeforeRetention(t *testing.T) {
+	// Arrange
+	auditRepo := mocks.NewAuditEventRepository(t)
+	svc := NewAuditService(auditRepo, config.Config{AuditRetention: 30 * 24 * time.Hour})
+
+	auditRepo.EXPECT().
+		DeleteBefore(mock.Anything, mock.AnythingOfType("time.Time")).
+		Run(func(ctx context.Context, before time.Time) {
+			assert.WithinDuration(t, time.Now().AddDate(0, 0, -30), before, time.Minute)
+		}).
+		Return(int64(3), nil)
+
+	// Act
+	removed, err := svc.PurgeExpired(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), removed)
+}
+
+func TestAuditService_PurgeExpired_ZeroKeepsEvents(t *testing.T) {
+	// Arrange - no repository call expected
+	auditRepo := mocks.NewAuditEventRepository(t)
+	svc := NewAuditService(auditRepo, config.Config{})
+
+	// Act
+	removed, err := svc.PurgeExpired(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Zero(t, removed)
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"hopSpotAPI/internal/config"
@@ -11,35 +13,68 @@ import (
 	"hopSpotAPI/internal/mapper"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
+	"hopSpotAPI/pkg/oidc"
 	"hopSpotAPI/pkg/utils"
 )
 
 type AuthService interface {
 	Register(ctx context.Context, req *requests.RegisterRequest) (*responses.LoginResponse, error)
 	Login(ctx context.Context, req *requests.LoginRequest) (*responses.LoginResponse, error)
+	LoginTwoFactor(ctx context.Context, req *requests.LoginTwoFactorRequest) (*responses.LoginResponse, error)
+	// LoginOIDC logs in with an external account, unknown accounts are registered with an invitation code
+	LoginOIDC(ctx context.Context, provider string, req *requests.OIDCLoginRequest) (*responses.LoginResponse, error)
 	Refresh(ctx context.Context, req *requests.RefreshTokenRequest) (*responses.LoginResponse, error)
 	Logout(ctx context.Context, req *requests.LogoutRequest) error
 	RefreshFCMToken(ctx context.Context, userId uint, fcmToken string) error
+
+	ListSessions(ctx context.Context, userID, currentSessionID uint) ([]responses.SessionResponse, error)
+	RevokeSession(ctx context.Context, userID, sessionID uint) error
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID uint) error
 }
 
 type authService struct {
-	userRepo         repository.UserRepository
-	invitationRepo   repository.InvitationRepository
-	refreshTokenRepo repository.RefreshTokenRepository
-	config           config.Config
+	userRepo          repository.UserRepository
+	invitationRepo    repository.InvitationRepository
+	refreshTokenRepo  repository.RefreshTokenRepository
+	securityEventRepo repository.SecurityEventRepository
+	emailService      EmailVerificationService
+	twoFactorService  TwoFactorService
+	oidcService       OIDCService
+	tokenVersions     TokenVersionService
+	lockouts          AccountLockoutService
+	audit             AuditService
+	jwtKeys           *utils.JWTKeys
+	config            config.Config
 }
 
 func NewAuthService(
 	userRepo repository.UserRepository,
 	invitationRepo repository.InvitationRepository,
 	refreshTokenRepo repository.RefreshTokenRepository,
+	securityEventRepo repository.SecurityEventRepository,
+	emailService EmailVerificationService,
+	twoFactorService TwoFactorService,
+	oidcService OIDCService,
+	tokenVersions TokenVersionService,
+	lockouts AccountLockoutService,
+	audit AuditService,
+	jwtKeys *utils.JWTKeys,
 	config config.Config,
 ) AuthService {
 	return &authService{
-		userRepo:         userRepo,
-		invitationRepo:   invitationRepo,
-		refreshTokenRepo: refreshTokenRepo,
-		config:           config,
+		userRepo:          userRepo,
+		invitationRepo:    invitationRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		securityEventRepo: securityEventRepo,
+		emailService:      emailService,
+		twoFactorService:  twoFactorService,
+		oidcService:       oidcService,
+		tokenVersions:     tokenVersions,
+		lockouts:          lockouts,
+		audit:             audit,
+		jwtKeys:           jwtKeys,
+		config:            config,
 	}
 }
 
@@ -54,16 +89,10 @@ func (s *authService) Register(ctx context.Context, req *requests.RegisterReques
 	}
 
 	// Check if invitation code is valid
-	invitation, err := s.invitationRepo.FindByCode(ctx, req.InvitationCode)
+	invitation, err := s.findRedeemableInvitation(ctx, req.InvitationCode)
 	if err != nil {
 		return nil, err
 	}
-	if invitation == nil {
-		return nil, apperror.ErrInvalidInvitationCode
-	}
-	if invitation.RedeemedBy != nil {
-		return nil, apperror.ErrInvitationCodeAlreadyRedeemed
-	}
 
 	// Hashing password
 	hashedPassword, err := utils.HashPassword(req.Password)
@@ -71,35 +100,21 @@ func (s *authService) Register(ctx context.Context, req *requests.RegisterReques
 		return nil, err
 	}
 
-	userCount, err := s.userRepo.Count(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Determine role: first user becomes admin
-	role := domain.RoleUser
-	if userCount == 0 {
-		role = domain.RoleAdmin
-	}
-
 	// Mapping DTO -> User domain model
 	user := mapper.RegisterRequestToUser(req)
 	user.PasswordHash = hashedPassword
-	user.Role = role
-	user.IsActive = true
 
-	// Creating user
-	if err := s.userRepo.Create(ctx, user); err != nil {
+	if err := s.createUser(ctx, user, invitation, nil); err != nil {
 		return nil, err
 	}
 
-	// Mark invitation as redeemed
-	if err := s.invitationRepo.MarkAsRedeemed(ctx, invitation.ID, user.ID); err != nil {
-		return nil, err
+	// The account is usable right away, the link can be requested again later
+	if err := s.emailService.SendVerification(ctx, user.ID); err != nil {
+		logger.Warn().Err(err).Uint("userID", user.ID).Msg("failed to send verification mail")
 	}
 
 	// Generate tokens
-	return s.generateTokens(ctx, user)
+	return s.generateTokens(ctx, user, req.ClientInfo, false)
 }
 
 func (s *authService) Login(ctx context.Context, req *requests.LoginRequest) (*responses.LoginResponse, error) {
@@ -109,6 +124,7 @@ func (s *authService) Login(ctx context.Context, req *requests.LoginRequest) (*r
 		return nil, err
 	}
 	if user == nil {
+		s.recordAuthEvent(ctx, domain.AuditActionLoginFailed, nil, req.ClientInfo)
 		return nil, apperror.ErrInvalidCredentials
 	}
 
@@ -117,18 +133,86 @@ func (s *authService) Login(ctx context.Context, req *requests.LoginRequest) (*r
 		return nil, apperror.ErrAccountDeactivated
 	}
 
+	// Locked accounts are rejected before the password is checked, so guessing doesn't continue
+	if err := s.lockouts.Check(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
 	// Verify password
 	if !utils.CheckPasswordHash(req.Password, user.PasswordHash) {
+		s.recordAuthEvent(ctx, domain.AuditActionLoginFailed, &user.ID, req.ClientInfo)
+		if err := s.lockouts.RecordFailure(ctx, user.ID, req.ClientInfo); err != nil {
+			return nil, err
+		}
 		return nil, apperror.ErrInvalidCredentials
 	}
 
-	// Revoke all existing refresh tokens (single device policy)
-	if err := s.refreshTokenRepo.RevokeByUserID(ctx, user.ID); err != nil {
+	// With 2FA the login only succeeds with the code, the failures are cleared by LoginTwoFactor
+	if !user.IsTwoFactorEnabled() {
+		if err := s.lockouts.Reset(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.completeLogin(ctx, user, req.ClientInfo)
+}
+
+// LoginTwoFactor implements AuthService.
+// Second step of a login with 2FA, redeems the challenge from Login.
+func (s *authService) LoginTwoFactor(ctx context.Context, req *requests.LoginTwoFactorRequest) (*responses.LoginResponse, error) {
+	user, err := s.twoFactorService.VerifyChallenge(ctx, req.ChallengeToken, req.Code)
+	if err != nil {
+		// Wrong codes count like wrong passwords, otherwise the code could be guessed without limit
+		if user != nil {
+			s.recordAuthEvent(ctx, domain.AuditActionLoginFailed, &user.ID, req.ClientInfo)
+			if lockErr := s.lockouts.RecordFailure(ctx, user.ID, req.ClientInfo); lockErr != nil {
+				return nil, lockErr
+			}
+		}
 		return nil, err
 	}
 
-	// Generate tokens
-	return s.generateTokens(ctx, user)
+	// The account may have been deactivated in between
+	if !user.IsActive {
+		return nil, apperror.ErrAccountDeactivated
+	}
+
+	// A lock from failed codes also rejects a correct one
+	if err := s.lockouts.Check(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if err := s.lockouts.Reset(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	s.recordAuthEvent(ctx, domain.AuditActionLogin, &user.ID, req.ClientInfo)
+	return s.generateTokens(ctx, user, req.ClientInfo, true)
+}
+
+// LoginOIDC implements AuthService.
+// Accounts with the same email are not linked automatically, the user has to log in and link the provider.
+func (s *authService) LoginOIDC(ctx context.Context, provider string, req *requests.OIDCLoginRequest) (*responses.LoginResponse, error) {
+	identity, err := s.oidcService.Verify(ctx, provider, &req.OIDCCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.oidcService.FindLinkedUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		user, err = s.registerExternal(ctx, identity, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !user.IsActive {
+		return nil, apperror.ErrAccountDeactivated
+	}
+
+	return s.completeLogin(ctx, user, req.ClientInfo)
 }
 
 func (s *authService) Refresh(ctx context.Context, req *requests.RefreshTokenRequest) (*responses.LoginResponse, error) {
@@ -141,11 +225,15 @@ func (s *authService) Refresh(ctx context.Context, req *requests.RefreshTokenReq
 		return nil, err
 	}
 	if refreshToken == nil {
+		// An already rotated token means the family is compromised
+		if err := s.handleTokenReuse(ctx, tokenHash, req.ClientInfo); err != nil {
+			return nil, err
+		}
 		return nil, apperror.ErrInvalidRefreshToken
 	}
 
 	// Validate token
-	if !refreshToken.IsValid() {
+	if !refreshToken.IsValid() || refreshToken.ExceedsLifetime(s.config.SessionMaxLifetime) {
 		return nil, apperror.ErrInvalidRefreshToken
 	}
 
@@ -154,13 +242,32 @@ func (s *authService) Refresh(ctx context.Context, req *requests.RefreshTokenReq
 		return nil, apperror.ErrAccountDeactivated
 	}
 
-	// Revoke current token (rotation)
-	if err := s.refreshTokenRepo.RevokeByID(ctx, refreshToken.ID); err != nil {
+	// Rotate the token, the session itself stays the same
+	refreshTokenString, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken.TokenHash = utils.HashToken(refreshTokenString)
+	refreshToken.ExpiresAt = s.sessionExpiry(refreshToken.CreatedAt)
+	refreshToken.LastUsedAt = time.Now()
+	refreshToken.UserAgent = req.UserAgent
+	refreshToken.IPAddress = req.IPAddress
+
+	if err := s.refreshTokenRepo.Rotate(ctx, refreshToken, tokenHash); err != nil {
 		return nil, err
 	}
 
-	// Generate new tokens
-	return s.generateTokens(ctx, &refreshToken.User)
+	accessToken, err := utils.GenerateJWT(&refreshToken.User, refreshToken.ID, refreshToken.TwoFactorVerified, s.jwtKeys, &s.config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.LoginResponse{
+		User:         mapper.UserToResponse(&refreshToken.User),
+		Token:        accessToken,
+		RefreshToken: refreshTokenString,
+	}, nil
 }
 
 func (s *authService) Logout(ctx context.Context, req *requests.LogoutRequest) error {
@@ -184,14 +291,212 @@ func (s *authService) RefreshFCMToken(ctx context.Context, userId uint, fcmToken
 	return s.userRepo.UpdateFCMToken(ctx, userId, fcmToken)
 }
 
-// generateTokens creates both access and refresh tokens
-func (s *authService) generateTokens(ctx context.Context, user *domain.User) (*responses.LoginResponse, error) {
-	// Generate Access Token (JWT)
-	accessToken, err := utils.GenerateJWT(user, &s.config)
+// ListSessions implements AuthService.
+func (s *authService) ListSessions(ctx context.Context, userID, currentSessionID uint) ([]responses.SessionResponse, error) {
+	sessions, err := s.refreshTokenRepo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.SessionsToResponse(sessions, currentSessionID), nil
+}
+
+// RevokeSession implements AuthService.
+// Access tokens carry no session check, so all of them are revoked like in RevokeOtherSessions.
+// The remaining devices, the current one included, get a new one by refreshing.
+func (s *authService) RevokeSession(ctx context.Context, userID, sessionID uint) error {
+	session, err := s.refreshTokenRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if session == nil || session.UserID != userID || !session.IsValid() {
+		return apperror.ErrSessionNotFound
+	}
+
+	if err := s.refreshTokenRepo.RevokeByID(ctx, session.ID); err != nil {
+		return err
+	}
+	return s.tokenVersions.Invalidate(ctx, userID)
+}
+
+// RevokeOtherSessions implements AuthService.
+// Logs the user out everywhere except on the current device.
+// All access tokens are revoked, the current device gets a new one by refreshing.
+func (s *authService) RevokeOtherSessions(ctx context.Context, userID, currentSessionID uint) error {
+	if err := s.refreshTokenRepo.RevokeOthers(ctx, userID, currentSessionID); err != nil {
+		return err
+	}
+	return s.tokenVersions.Invalidate(ctx, userID)
+}
+
+// completeLogin issues the tokens of an authenticated user, or a challenge if 2FA is enabled
+func (s *authService) completeLogin(ctx context.Context, user *domain.User, client requests.ClientInfo) (*responses.LoginResponse, error) {
+	// With 2FA the tokens are only issued by LoginTwoFactor
+	if user.IsTwoFactorEnabled() {
+		challengeToken, err := s.twoFactorService.CreateChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &responses.LoginResponse{
+			TwoFactorRequired: true,
+			ChallengeToken:    challengeToken,
+		}, nil
+	}
+
+	// Generate tokens (new session, other devices stay logged in)
+	s.recordAuthEvent(ctx, domain.AuditActionLogin, &user.ID, client)
+	return s.generateTokens(ctx, user, client, false)
+}
+
+// recordAuthEvent audits an auth event of a user, nil if the account is unknown
+func (s *authService) recordAuthEvent(ctx context.Context, action string, userID *uint, client requests.ClientInfo) {
+	event := &domain.AuditEvent{
+		Action:    action,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+	}
+	if userID != nil {
+		event.TargetType = domain.AuditTargetUser
+		event.TargetID = userID
+		// Only a successful login proves who acted
+		if action == domain.AuditActionLogin {
+			event.ActorID = userID
+		}
+	}
+	s.audit.Record(ctx, event)
+}
+
+// registerExternal creates an account without password for an unknown external identity.
+// The invitation gate of Register applies as well.
+func (s *authService) registerExternal(ctx context.Context, identity *oidc.Identity, req *requests.OIDCLoginRequest) (*domain.User, error) {
+	if identity.Email == "" {
+		return nil, apperror.ErrOIDCEmailMissing
+	}
+
+	existingUser, err := s.userRepo.FindByEmail(ctx, identity.Email)
+	if err != nil {
+		return nil, err
+	}
+	if existingUser != nil {
+		return nil, apperror.ErrEmailAlreadyExists
+	}
+
+	if req.InvitationCode == "" {
+		return nil, apperror.ErrInvitationCodeRequired
+	}
+	invitation, err := s.findRedeemableInvitation(ctx, req.InvitationCode)
 	if err != nil {
 		return nil, err
 	}
 
+	user := &domain.User{
+		Email:       identity.Email,
+		DisplayName: externalDisplayName(identity, req.DisplayName),
+	}
+	if identity.EmailVerified {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+	}
+
+	// The link is created together with the user, an account without it couldn't log in
+	link := &domain.ExternalIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}
+	if err := s.createUser(ctx, user, invitation, link); err != nil {
+		return nil, err
+	}
+
+	if !user.IsEmailVerified() {
+		if err := s.emailService.SendVerification(ctx, user.ID); err != nil {
+			logger.Warn().Err(err).Uint("userID", user.ID).Msg("failed to send verification mail")
+		}
+	}
+
+	return user, nil
+}
+
+// findRedeemableInvitation loads an invitation code that can still be redeemed.
+// The use is only taken in createUser, together with the new account.
+func (s *authService) findRedeemableInvitation(ctx context.Context, code string) (*domain.InvitationCode, error) {
+	invitation, err := s.invitationRepo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, apperror.ErrInvalidInvitationCode
+	}
+	// Codes of deactivated or deleted users stop working
+	if invitation.CreatedBy != nil && (invitation.Creator == nil || !invitation.Creator.IsActive) {
+		return nil, apperror.ErrInvalidInvitationCode
+	}
+	if invitation.IsExpired() {
+		return nil, apperror.ErrInvitationCodeExpired
+	}
+	if invitation.IsUsedUp() {
+		return nil, apperror.ErrInvitationCodeAlreadyRedeemed
+	}
+	return invitation, nil
+}
+
+// createUser stores a new active user and redeems the invitation in one step.
+// An external identity is linked in the same step, nil for sign-ups with password.
+// The first user becomes admin, otherwise the role of the code applies.
+func (s *authService) createUser(ctx context.Context, user *domain.User, invitation *domain.InvitationCode, identity *domain.ExternalIdentity) error {
+	userCount, err := s.userRepo.Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Determine role: first user becomes admin
+	user.Role = domain.RoleUser
+	if invitation.Role != nil {
+		user.Role = *invitation.Role
+	}
+	if userCount == 0 {
+		user.Role = domain.RoleAdmin
+	}
+	user.IsActive = true
+
+	// Creating user, fails if a parallel sign-up took the last use in the meantime
+	redeemed, err := s.invitationRepo.Redeem(ctx, invitation.ID, user, identity)
+	if err != nil {
+		return err
+	}
+	if !redeemed {
+		return apperror.ErrInvitationCodeAlreadyRedeemed
+	}
+
+	s.audit.Record(ctx, &domain.AuditEvent{
+		ActorID:    &user.ID,
+		Action:     domain.AuditActionRegister,
+		TargetType: domain.AuditTargetUser,
+		TargetID:   &user.ID,
+		Changes: domain.AuditDiff(nil, map[string]any{
+			"email":              user.Email,
+			"role":               string(user.Role),
+			"invitation_code_id": invitation.ID,
+		}),
+	})
+	return nil
+}
+
+// externalDisplayName prefers the requested name, then the name at the provider, then the email's local part
+func externalDisplayName(identity *oidc.Identity, requested string) string {
+	if requested != "" {
+		return requested
+	}
+	if identity.Name != "" {
+		return identity.Name
+	}
+	localPart, _, _ := strings.Cut(identity.Email, "@")
+	return localPart
+}
+
+// generateTokens starts a new session and creates both access and refresh tokens.
+// twoFactorVerified marks sessions that passed the second factor, it ends up in the tfa claim.
+func (s *authService) generateTokens(ctx context.Context, user *domain.User, client requests.ClientInfo, twoFactorVerified bool) (*responses.LoginResponse, error) {
 	// Generate Refresh Token
 	refreshTokenString, err := utils.GenerateRefreshToken()
 	if err != nil {
@@ -202,17 +507,105 @@ func (s *authService) generateTokens(ctx context.Context, user *domain.User) (*r
 	refreshToken := &domain.RefreshToken{
 		UserID:    user.ID,
 		TokenHash: utils.HashToken(refreshTokenString),
-		ExpiresAt: time.Now().Add(s.config.RefreshTokenExpire),
+		ExpiresAt: s.sessionExpiry(time.Now()),
 		IsRevoked: false,
+
+		TwoFactorVerified: twoFactorVerified,
+
+		DeviceName: client.DeviceName,
+		Platform:   client.Platform,
+		UserAgent:  client.UserAgent,
+		IPAddress:  client.IPAddress,
+		LastUsedAt: time.Now(),
 	}
 
 	if err := s.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
 		return nil, err
 	}
 
+	if err := s.enforceSessionLimit(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	// Generate Access Token (JWT)
+	accessToken, err := utils.GenerateJWT(user, refreshToken.ID, refreshToken.TwoFactorVerified, s.jwtKeys, &s.config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &responses.LoginResponse{
 		User:         mapper.UserToResponse(user),
 		Token:        accessToken,
 		RefreshToken: refreshTokenString,
 	}, nil
 }
+
+// enforceSessionLimit revokes the least recently used sessions above the configured maximum
+func (s *authService) enforceSessionLimit(ctx context.Context, userID uint) error {
+	if s.config.MaxSessionsPerUser <= 0 {
+		return nil
+	}
+
+	sessions, err := s.refreshTokenRepo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for i := s.config.MaxSessionsPerUser; i < len(sessions); i++ {
+		if err := s.refreshTokenRepo.RevokeByID(ctx, sessions[i].ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sessionExpiry returns the expiry of a refresh token, capped by the absolute
+// lifetime of the session started at the given time
+func (s *authService) sessionExpiry(sessionStart time.Time) time.Time {
+	expiresAt := time.Now().Add(s.config.RefreshTokenExpire)
+	if s.config.SessionMaxLifetime > 0 {
+		if end := sessionStart.Add(s.config.SessionMaxLifetime); end.Before(expiresAt) {
+			return end
+		}
+	}
+	return expiresAt
+}
+
+// handleTokenReuse revokes the family of a replayed rotated token and records a security event
+func (s *authService) handleTokenReuse(ctx context.Context, tokenHash string, client requests.ClientInfo) error {
+	family, err := s.refreshTokenRepo.FindByRotatedHash(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+	if family == nil {
+		return nil // unknown token
+	}
+
+	if !family.IsRevoked {
+		if err := s.refreshTokenRepo.RevokeByID(ctx, family.ID); err != nil {
+			return err
+		}
+	}
+
+	event := &domain.SecurityEvent{
+		UserID:    family.UserID,
+		Type:      domain.SecurityEventRefreshTokenReuse,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		Details:   fmt.Sprintf("rotated refresh token replayed, session %d revoked", family.ID),
+		CreatedAt: time.Now(),
+	}
+	if err := s.securityEventRepo.Create(ctx, event); err != nil {
+		return err
+	}
+	s.recordAuthEvent(ctx, domain.AuditActionRefreshTokenReuse, &family.UserID, client)
+
+	logger.Warn().
+		Uint("userID", family.UserID).
+		Uint("sessionID", family.ID).
+		Str("ip", client.IPAddress).
+		Msg("Refresh token reuse detected, session revoked")
+
+	return nil
+}
@@ -2,6 +2,13 @@ package service
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -9,6 +16,8 @@ import (
 	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
 	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/oidc"
 	"hopSpotAPI/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +30,7 @@ func TestAuthService_Register_Success(t *testing.T) {
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
 	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	emailService := mocks.NewEmailVerificationService(t)
 
 	cfg := config.Config{
 		JWTSecret:          "test-secret-min-32-characters-long",
@@ -30,7 +40,7 @@ func TestAuthService_Register_Success(t *testing.T) {
 		JWTAudience:        "test",
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, emailService, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	req := &requests.RegisterRequest{
 		Email:          "test@example.com",
@@ -50,9 +60,9 @@ func TestAuthService_Register_Success(t *testing.T) {
 	invitationRepo.EXPECT().
 		FindByCode(mock.Anything, "ABC12345").
 		Return(&domain.InvitationCode{
-			Model:      &gorm.Model{ID: 1},
-			Code:       "ABC12345",
-			RedeemedBy: nil,
+			Model:   &gorm.Model{ID: 1},
+			Code:    "ABC12345",
+			MaxUses: 1,
 		}, nil)
 
 	// 3. Count users (0 = first user becomes admin)
@@ -60,23 +70,26 @@ func TestAuthService_Register_Success(t *testing.T) {
 		Count(mock.Anything).
 		Return(int64(0), nil)
 
-	// 4. Create user - IMPORTANT: Set the ID via Run()
-	userRepo.EXPECT().
-		Create(mock.Anything, mock.AnythingOfType("*domain.User")).
-		Run(func(ctx context.Context, user *domain.User) {
+	// 4. Create user and redeem the code - IMPORTANT: Set the ID via Run()
+	invitationRepo.EXPECT().
+		Redeem(mock.Anything, uint(1), mock.AnythingOfType("*domain.User"), (*domain.ExternalIdentity)(nil)).
+		Run(func(ctx context.Context, codeID uint, user *domain.User, identity *domain.ExternalIdentity) {
 			// Simulate what GORM does: set the Model with ID
 			user.Model = &gorm.Model{ID: 1}
 		}).
-		Return(nil)
+		Return(true, nil)
 
-	// 5. Mark invitation as redeemed
-	invitationRepo.EXPECT().
-		MarkAsRedeemed(mock.Anything, uint(1), uint(1)).
+	// 5. Send verification mail
+	emailService.EXPECT().
+		SendVerification(mock.Anything, uint(1)).
 		Return(nil)
 
 	// 6. Create refresh token (in generateTokens)
 	refreshTokenRepo.EXPECT().
 		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			token.Model = &gorm.Model{ID: 1}
+		}).
 		Return(nil)
 
 	// Act
@@ -96,6 +109,7 @@ func TestAuthService_Register_SecondUserBecomesUser(t *testing.T) {
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
 	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	emailService := mocks.NewEmailVerificationService(t)
 
 	cfg := config.Config{
 		JWTSecret:          "test-secret-min-32-characters-long",
@@ -105,7 +119,7 @@ func TestAuthService_Register_SecondUserBecomesUser(t *testing.T) {
 		JWTAudience:        "test",
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, emailService, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	req := &requests.RegisterRequest{
 		Email:          "second@example.com",
@@ -122,9 +136,9 @@ func TestAuthService_Register_SecondUserBecomesUser(t *testing.T) {
 	invitationRepo.EXPECT().
 		FindByCode(mock.Anything, "XYZ98765").
 		Return(&domain.InvitationCode{
-			Model:      &gorm.Model{ID: 2},
-			Code:       "XYZ98765",
-			RedeemedBy: nil,
+			Model:   &gorm.Model{ID: 2},
+			Code:    "XYZ98765",
+			MaxUses: 1,
 		}, nil)
 
 	// Count returns 1 = not first user
@@ -132,19 +146,22 @@ func TestAuthService_Register_SecondUserBecomesUser(t *testing.T) {
 		Count(mock.Anything).
 		Return(int64(1), nil)
 
-	userRepo.EXPECT().
-		Create(mock.Anything, mock.AnythingOfType("*domain.User")).
-		Run(func(ctx context.Context, user *domain.User) {
+	invitationRepo.EXPECT().
+		Redeem(mock.Anything, uint(2), mock.AnythingOfType("*domain.User"), (*domain.ExternalIdentity)(nil)).
+		Run(func(ctx context.Context, codeID uint, user *domain.User, identity *domain.ExternalIdentity) {
 			user.Model = &gorm.Model{ID: 2}
 		}).
-		Return(nil)
+		Return(true, nil)
 
-	invitationRepo.EXPECT().
-		MarkAsRedeemed(mock.Anything, uint(2), uint(2)).
+	emailService.EXPECT().
+		SendVerification(mock.Anything, uint(2)).
 		Return(nil)
 
 	refreshTokenRepo.EXPECT().
 		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			token.Model = &gorm.Model{ID: 1}
+		}).
 		Return(nil)
 
 	// Act
@@ -168,7 +185,7 @@ func TestAuthService_Register_InvalidInvitationCode(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	req := &requests.RegisterRequest{
 		Email:          "test@example.com",
@@ -207,7 +224,7 @@ func TestAuthService_Register_EmailAlreadyExists(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	req := &requests.RegisterRequest{
 		Email:          "existing@example.com",
@@ -247,7 +264,7 @@ func TestAuthService_Register_InvitationCodeAlreadyRedeemed(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	req := &requests.RegisterRequest{
 		Email:          "test@example.com",
@@ -256,8 +273,6 @@ func TestAuthService_Register_InvitationCodeAlreadyRedeemed(t *testing.T) {
 		InvitationCode: "USED123",
 	}
 
-	redeemedByID := uint(99)
-
 	userRepo.EXPECT().
 		FindByEmail(mock.Anything, "test@example.com").
 		Return(nil, nil)
@@ -265,9 +280,10 @@ func TestAuthService_Register_InvitationCodeAlreadyRedeemed(t *testing.T) {
 	invitationRepo.EXPECT().
 		FindByCode(mock.Anything, "USED123").
 		Return(&domain.InvitationCode{
-			Model:      &gorm.Model{ID: 1},
-			Code:       "USED123",
-			RedeemedBy: &redeemedByID, // Already redeemed!
+			Model:    &gorm.Model{ID: 1},
+			Code:     "USED123",
+			MaxUses:  1,
+			UseCount: 1, // Already redeemed!
 		}, nil)
 
 	// Act
@@ -279,11 +295,142 @@ func TestAuthService_Register_InvitationCodeAlreadyRedeemed(t *testing.T) {
 	assert.Contains(t, err.Error(), "already redeemed")
 }
 
+func TestAuthService_Register_InvitationCodeExpired(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewAuthService(userRepo, invitationRepo, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	expiredAt := time.Now().Add(-time.Hour)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(nil, nil)
+	invitationRepo.EXPECT().
+		FindByCode(mock.Anything, "OLD123").
+		Return(&domain.InvitationCode{Model: &gorm.Model{ID: 1}, Code: "OLD123", MaxUses: 5, ExpiresAt: &expiredAt}, nil)
+
+	// Act
+	result, err := svc.Register(context.Background(), &requests.RegisterRequest{
+		Email:          "test@example.com",
+		Password:       "TestPass123!",
+		DisplayName:    "Test User",
+		InvitationCode: "OLD123",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvitationCodeExpired)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_Register_InvitationCodeOfDeactivatedUser(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewAuthService(userRepo, invitationRepo, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	creatorID := uint(9)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(nil, nil)
+	invitationRepo.EXPECT().
+		FindByCode(mock.Anything, "SPAM12").
+		Return(&domain.InvitationCode{
+			Model:     &gorm.Model{ID: 1},
+			Code:      "SPAM12",
+			MaxUses:   5,
+			CreatedBy: &creatorID,
+			Creator:   &domain.User{Model: &gorm.Model{ID: creatorID}, IsActive: false},
+		}, nil)
+
+	// Act
+	result, err := svc.Register(context.Background(), &requests.RegisterRequest{
+		Email:          "test@example.com",
+		Password:       "TestPass123!",
+		DisplayName:    "Test User",
+		InvitationCode: "SPAM12",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidInvitationCode)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_Register_LastUseTakenConcurrently(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewAuthService(userRepo, invitationRepo, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(nil, nil)
+	invitationRepo.EXPECT().
+		FindByCode(mock.Anything, "ABC123").
+		Return(&domain.InvitationCode{Model: &gorm.Model{ID: 1}, Code: "ABC123", MaxUses: 2, UseCount: 1}, nil)
+	userRepo.EXPECT().Count(mock.Anything).Return(int64(5), nil)
+	// Another sign-up redeemed the last use between the check and the redemption
+	invitationRepo.EXPECT().Redeem(mock.Anything, uint(1), mock.AnythingOfType("*domain.User"), (*domain.ExternalIdentity)(nil)).Return(false, nil)
+
+	// Act
+	result, err := svc.Register(context.Background(), &requests.RegisterRequest{
+		Email:          "test@example.com",
+		Password:       "TestPass123!",
+		DisplayName:    "Test User",
+		InvitationCode: "ABC123",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvitationCodeAlreadyRedeemed)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_Register_RoleOfInvitationCode(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	emailService := mocks.NewEmailVerificationService(t)
+
+	cfg := config.Config{
+		JWTSecret:          "test-secret-min-32-characters-long",
+		JWTExpire:          3600 * time.Second,
+		RefreshTokenExpire: 90 * 24 * time.Hour,
+	}
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, emailService, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	role := domain.RoleAdmin
+	userRepo.EXPECT().FindByEmail(mock.Anything, "admin@example.com").Return(nil, nil)
+	invitationRepo.EXPECT().
+		FindByCode(mock.Anything, "ADMIN1").
+		Return(&domain.InvitationCode{Model: &gorm.Model{ID: 3}, Code: "ADMIN1", MaxUses: 1, Role: &role}, nil)
+	userRepo.EXPECT().Count(mock.Anything).Return(int64(4), nil)
+	invitationRepo.EXPECT().
+		Redeem(mock.Anything, uint(3), mock.AnythingOfType("*domain.User"), (*domain.ExternalIdentity)(nil)).
+		Run(func(ctx context.Context, codeID uint, user *domain.User, identity *domain.ExternalIdentity) {
+			user.Model = &gorm.Model{ID: 5}
+		}).
+		Return(true, nil)
+	emailService.EXPECT().SendVerification(mock.Anything, uint(5)).Return(nil)
+	refreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			token.Model = &gorm.Model{ID: 1}
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.Register(context.Background(), &requests.RegisterRequest{
+		Email:          "admin@example.com",
+		Password:       "TestPass123!",
+		DisplayName:    "New Admin",
+		InvitationCode: "ADMIN1",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", result.User.Role)
+}
+
 func TestAuthService_Login_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
 	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
 
 	cfg := config.Config{
 		JWTSecret:          "test-secret-min-32-characters-long",
@@ -293,7 +440,7 @@ func TestAuthService_Login_Success(t *testing.T) {
 		JWTAudience:        "test",
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, lockouts, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	hashedPassword, _ := utils.HashPassword("TestPass123!")
 	user := &domain.User{
@@ -314,13 +461,15 @@ func TestAuthService_Login_Success(t *testing.T) {
 	userRepo.EXPECT().
 		FindByEmail(mock.Anything, "test@example.com").
 		Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(nil)
+	lockouts.EXPECT().Reset(mock.Anything, uint(1)).Return(nil)
 
-	refreshTokenRepo.EXPECT().
-		RevokeByUserID(mock.Anything, uint(1)).
-		Return(nil)
-
+	// Other sessions are kept - only a new one is created
 	refreshTokenRepo.EXPECT().
 		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			token.Model = &gorm.Model{ID: 1}
+		}).
 		Return(nil)
 
 	// Act
@@ -346,7 +495,7 @@ func TestAuthService_Login_UserNotFound(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	req := &requests.LoginRequest{
 		Email:    "notfound@example.com",
@@ -372,6 +521,7 @@ func TestAuthService_Login_WrongPassword(t *testing.T) {
 	userRepo := mocks.NewUserRepository(t)
 	invitationRepo := mocks.NewInvitationRepository(t)
 	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
 
 	cfg := config.Config{
 		JWTSecret:          "test-secret-min-32-characters-long",
@@ -379,7 +529,7 @@ func TestAuthService_Login_WrongPassword(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, lockouts, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	hashedPassword, _ := utils.HashPassword("CorrectPassword!")
 	user := &domain.User{
@@ -400,6 +550,8 @@ func TestAuthService_Login_WrongPassword(t *testing.T) {
 	userRepo.EXPECT().
 		FindByEmail(mock.Anything, "test@example.com").
 		Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(nil)
+	lockouts.EXPECT().RecordFailure(mock.Anything, uint(1), mock.Anything).Return(nil)
 
 	// Act
 	result, err := svc.Login(context.Background(), req)
@@ -410,6 +562,70 @@ func TestAuthService_Login_WrongPassword(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid credentials")
 }
 
+func TestAuthService_Login_WrongPasswordIsAudited(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	audit := mocks.NewAuditService(t)
+	svc := NewAuthService(userRepo, nil, nil, nil, nil, nil, nil, nil, lockouts, audit, nil, config.Config{})
+
+	hashedPassword, _ := utils.HashPassword("CorrectPassword!")
+	user := &domain.User{
+		Model:        &gorm.Model{ID: 1},
+		Email:        "test@example.com",
+		PasswordHash: hashedPassword,
+		IsActive:     true,
+	}
+	req := &requests.LoginRequest{
+		Email:      "test@example.com",
+		Password:   "WrongPassword!",
+		ClientInfo: requests.ClientInfo{IPAddress: "203.0.113.7", UserAgent: "curl/8.0"},
+	}
+
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(nil)
+	lockouts.EXPECT().RecordFailure(mock.Anything, uint(1), mock.Anything).Return(nil)
+	audit.EXPECT().
+		Record(mock.Anything, mock.AnythingOfType("*domain.AuditEvent")).
+		Run(func(ctx context.Context, event *domain.AuditEvent) {
+			assert.Equal(t, domain.AuditActionLoginFailed, event.Action)
+			assert.Nil(t, event.ActorID) // the password didn't prove who acted
+			assert.Equal(t, uint(1), *event.TargetID)
+			assert.Equal(t, "203.0.113.7", event.IPAddress)
+			assert.Equal(t, "curl/8.0", event.UserAgent)
+		}).
+		Once()
+
+	// Act
+	_, err := svc.Login(context.Background(), req)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidCredentials)
+}
+
+func TestAuthService_Login_LockedAccount(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	svc := NewAuthService(userRepo, nil, nil, nil, nil, nil, nil, nil, lockouts, newNoopAuditService(t), nil, config.Config{})
+
+	hashedPassword, _ := utils.HashPassword("TestPass123!")
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", PasswordHash: hashedPassword, IsActive: true}
+
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(user, nil)
+	lockouts.EXPECT().
+		Check(mock.Anything, uint(1)).
+		Return(apperror.WithRetryAfter(apperror.ErrAccountLocked, time.Now().Add(time.Minute)))
+	// Even the correct password is rejected while locked, no session is created
+
+	// Act
+	result, err := svc.Login(context.Background(), &requests.LoginRequest{Email: "test@example.com", Password: "TestPass123!"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrAccountLocked)
+	assert.Nil(t, result)
+}
+
 func TestAuthService_Login_InactiveUser(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
@@ -422,7 +638,7 @@ func TestAuthService_Login_InactiveUser(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	hashedPassword, _ := utils.HashPassword("TestPass123!")
 	user := &domain.User{
@@ -465,7 +681,7 @@ func TestAuthService_Logout_Success(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	refreshToken := "some-refresh-token"
 	tokenHash := utils.HashToken(refreshToken)
@@ -507,7 +723,7 @@ func TestAuthService_Logout_TokenNotFound(t *testing.T) {
 		RefreshTokenExpire: 90 * 24 * time.Hour,
 	}
 
-	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, cfg)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
 
 	refreshToken := "non-existent-token"
 	tokenHash := utils.HashToken(refreshToken)
@@ -527,3 +743,769 @@ func TestAuthService_Logout_TokenNotFound(t *testing.T) {
 	// Assert
 	assert.NoError(t, err) // Logout should succeed even if token doesn't exist
 }
+
+func TestAuthService_Login_RevokesLeastRecentlyUsedSessionAboveLimit(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+
+	cfg := config.Config{
+		JWTSecret:          "test-secret-min-32-characters-long",
+		JWTExpire:          3600 * time.Second,
+		RefreshTokenExpire: 90 * 24 * time.Hour,
+		MaxSessionsPerUser: 2,
+	}
+
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, lockouts, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	hashedPassword, _ := utils.HashPassword("TestPass123!")
+	user := &domain.User{
+		Model:        &gorm.Model{ID: 1},
+		Email:        "test@example.com",
+		PasswordHash: hashedPassword,
+		IsActive:     true,
+	}
+
+	req := &requests.LoginRequest{
+		Email:    "test@example.com",
+		Password: "TestPass123!",
+		ClientInfo: requests.ClientInfo{
+			DeviceName: "Tablet",
+			Platform:   "android",
+			UserAgent:  "HopSpot/1.0",
+			IPAddress:  "10.0.0.1",
+		},
+	}
+
+	userRepo.EXPECT().
+		FindByEmail(mock.Anything, "test@example.com").
+		Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(nil)
+	lockouts.EXPECT().Reset(mock.Anything, uint(1)).Return(nil)
+
+	refreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			assert.Equal(t, "Tablet", token.DeviceName)
+			assert.Equal(t, "android", token.Platform)
+			assert.Equal(t, "HopSpot/1.0", token.UserAgent)
+			assert.Equal(t, "10.0.0.1", token.IPAddress)
+			token.Model = &gorm.Model{ID: 3}
+		}).
+		Return(nil)
+
+	// Most recently used first - the oldest session exceeds the limit
+	refreshTokenRepo.EXPECT().
+		FindActiveByUserID(mock.Anything, uint(1)).
+		Return([]domain.RefreshToken{
+			{Model: &gorm.Model{ID: 3}},
+			{Model: &gorm.Model{ID: 2}},
+			{Model: &gorm.Model{ID: 1}},
+		}, nil)
+
+	refreshTokenRepo.EXPECT().
+		RevokeByID(mock.Anything, uint(1)).
+		Return(nil)
+
+	// Act
+	result, err := svc.Login(context.Background(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	claims, err := utils.ValidateJWT(result.Token, utils.NewHMACJWTKeys(cfg.JWTSecret))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), claims.SessionID)
+}
+
+func TestAuthService_Refresh_RotatesSession(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+
+	cfg := config.Config{
+		JWTSecret:          "test-secret-min-32-characters-long",
+		JWTExpire:          3600 * time.Second,
+		RefreshTokenExpire: 90 * 24 * time.Hour,
+	}
+
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	session := &domain.RefreshToken{
+		Model:      &gorm.Model{ID: 5},
+		UserID:     1,
+		TokenHash:  utils.HashToken("old-token"),
+		ExpiresAt:  time.Now().Add(time.Hour),
+		DeviceName: "Phone",
+		User:       domain.User{Model: &gorm.Model{ID: 1}, IsActive: true},
+	}
+
+	refreshTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("old-token")).
+		Return(session, nil)
+
+	refreshTokenRepo.EXPECT().
+		Rotate(mock.Anything, session, utils.HashToken("old-token")).
+		Run(func(ctx context.Context, token *domain.RefreshToken, previousHash string) {
+			assert.NotEqual(t, utils.HashToken("old-token"), token.TokenHash)
+			assert.Equal(t, "Phone", token.DeviceName)
+			assert.Equal(t, "10.0.0.2", token.IPAddress)
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.Refresh(context.Background(), &requests.RefreshTokenRequest{
+		RefreshToken: "old-token",
+		ClientInfo:   requests.ClientInfo{IPAddress: "10.0.0.2"},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, session.TokenHash, utils.HashToken(result.RefreshToken))
+	claims, err := utils.ValidateJWT(result.Token, utils.NewHMACJWTKeys(cfg.JWTSecret))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), claims.SessionID)
+}
+
+func TestAuthService_Refresh_TwoFactorClaimFromSession(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+
+	cfg := config.Config{
+		JWTSecret:          "test-secret-min-32-characters-long",
+		JWTExpire:          time.Hour,
+		RefreshTokenExpire: 24 * time.Hour,
+	}
+
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	// 2FA was enabled after the session was started without it
+	secret := "JBSWY3DPEHPK3PXP"
+	enabledAt := time.Now()
+	session := &domain.RefreshToken{
+		Model:     &gorm.Model{ID: 5},
+		UserID:    1,
+		TokenHash: utils.HashToken("old-token"),
+		ExpiresAt: time.Now().Add(time.Hour),
+		User: domain.User{
+			Model:         &gorm.Model{ID: 1},
+			Role:          domain.RoleAdmin,
+			IsActive:      true,
+			TOTPSecret:    &secret,
+			TOTPEnabledAt: &enabledAt,
+		},
+	}
+
+	refreshTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("old-token")).
+		Return(session, nil)
+	refreshTokenRepo.EXPECT().
+		Rotate(mock.Anything, session, utils.HashToken("old-token")).
+		Return(nil)
+
+	// Act
+	result, err := svc.Refresh(context.Background(), &requests.RefreshTokenRequest{RefreshToken: "old-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	claims, err := utils.ValidateJWT(result.Token, utils.NewHMACJWTKeys(cfg.JWTSecret))
+	assert.NoError(t, err)
+	assert.False(t, claims.TwoFactor)
+}
+
+func TestAuthService_RevokeSession_OtherUsersSession(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	refreshTokenRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.RefreshToken{
+			Model:     &gorm.Model{ID: 5},
+			UserID:    2,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+
+	// Act
+	err := svc.RevokeSession(context.Background(), uint(1), uint(5))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrSessionNotFound)
+}
+
+func TestAuthService_RevokeSession_InvalidatesAccessTokens(t *testing.T) {
+	// Arrange
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAuthService(nil, nil, refreshTokenRepo, nil, nil, nil, nil, tokenVersions, nil, newNoopAuditService(t), nil, config.Config{})
+
+	refreshTokenRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.RefreshToken{
+			Model:     &gorm.Model{ID: 5},
+			UserID:    1,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+	refreshTokenRepo.EXPECT().RevokeByID(mock.Anything, uint(5)).Return(nil)
+	// The access tokens of the session would stay valid until they expire otherwise
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+
+	// Act
+	err := svc.RevokeSession(context.Background(), uint(1), uint(5))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAuthService_Refresh_ReusedTokenRevokesFamily(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	securityEventRepo := mocks.NewSecurityEventRepository(t)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, securityEventRepo, nil, nil, nil, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	stolenHash := utils.HashToken("stolen-token")
+
+	refreshTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, stolenHash).
+		Return(nil, nil)
+
+	refreshTokenRepo.EXPECT().
+		FindByRotatedHash(mock.Anything, stolenHash).
+		Return(&domain.RefreshToken{
+			Model:     &gorm.Model{ID: 5},
+			UserID:    1,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+
+	refreshTokenRepo.EXPECT().
+		RevokeByID(mock.Anything, uint(5)).
+		Return(nil)
+
+	securityEventRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.SecurityEvent")).
+		Run(func(ctx context.Context, event *domain.SecurityEvent) {
+			assert.Equal(t, uint(1), event.UserID)
+			assert.Equal(t, domain.SecurityEventRefreshTokenReuse, event.Type)
+			assert.Equal(t, "10.0.0.9", event.IPAddress)
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.Refresh(context.Background(), &requests.RefreshTokenRequest{
+		RefreshToken: "stolen-token",
+		ClientInfo:   requests.ClientInfo{IPAddress: "10.0.0.9"},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidRefreshToken)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_Refresh_UnknownTokenIsNoReuse(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	securityEventRepo := mocks.NewSecurityEventRepository(t)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, securityEventRepo, nil, nil, nil, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	refreshTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("unknown")).
+		Return(nil, nil)
+
+	refreshTokenRepo.EXPECT().
+		FindByRotatedHash(mock.Anything, utils.HashToken("unknown")).
+		Return(nil, nil)
+
+	// Act
+	result, err := svc.Refresh(context.Background(), &requests.RefreshTokenRequest{RefreshToken: "unknown"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidRefreshToken)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_Refresh_SessionLifetimeExceeded(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+
+	cfg := config.Config{
+		RefreshTokenExpire: 90 * 24 * time.Hour,
+		SessionMaxLifetime: 180 * 24 * time.Hour,
+	}
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	refreshTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("old-token")).
+		Return(&domain.RefreshToken{
+			Model:     &gorm.Model{ID: 5, CreatedAt: time.Now().Add(-181 * 24 * time.Hour)},
+			UserID:    1,
+			ExpiresAt: time.Now().Add(time.Hour),
+			User:      domain.User{Model: &gorm.Model{ID: 1}, IsActive: true},
+		}, nil)
+
+	// Act
+	result, err := svc.Refresh(context.Background(), &requests.RefreshTokenRequest{RefreshToken: "old-token"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidRefreshToken)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_Refresh_ExpiryCappedBySessionLifetime(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+
+	cfg := config.Config{
+		JWTSecret:          "test-secret-min-32-characters-long",
+		JWTExpire:          3600 * time.Second,
+		RefreshTokenExpire: 90 * 24 * time.Hour,
+		SessionMaxLifetime: 180 * 24 * time.Hour,
+	}
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	started := time.Now().Add(-170 * 24 * time.Hour)
+	session := &domain.RefreshToken{
+		Model:     &gorm.Model{ID: 5, CreatedAt: started},
+		UserID:    1,
+		ExpiresAt: time.Now().Add(time.Hour),
+		User:      domain.User{Model: &gorm.Model{ID: 1}, IsActive: true},
+	}
+
+	refreshTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("old-token")).
+		Return(session, nil)
+
+	refreshTokenRepo.EXPECT().
+		Rotate(mock.Anything, session, utils.HashToken("old-token")).
+		Return(nil)
+
+	// Act
+	_, err := svc.Refresh(context.Background(), &requests.RefreshTokenRequest{RefreshToken: "old-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, started.Add(cfg.SessionMaxLifetime), session.ExpiresAt)
+}
+
+func TestAuthService_Login_TwoFactorReturnsChallenge(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	twoFactorService := mocks.NewTwoFactorService(t)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, twoFactorService, nil, nil, lockouts, newNoopAuditService(t), nil, config.Config{})
+
+	hashedPassword, _ := utils.HashPassword("TestPass123!")
+	secret := "JBSWY3DPEHPK3PXP"
+	enabledAt := time.Now()
+	user := &domain.User{
+		Model:         &gorm.Model{ID: 1},
+		Email:         "test@example.com",
+		PasswordHash:  hashedPassword,
+		IsActive:      true,
+		TOTPSecret:    &secret,
+		TOTPEnabledAt: &enabledAt,
+	}
+
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(nil)
+	// The failures are only reset once the code is verified
+	twoFactorService.EXPECT().CreateChallenge(mock.Anything, uint(1)).Return("challenge-token", nil)
+	// No refresh token is created before the code step
+
+	// Act
+	result, err := svc.Login(context.Background(), &requests.LoginRequest{Email: "test@example.com", Password: "TestPass123!"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.TwoFactorRequired)
+	assert.Equal(t, "challenge-token", result.ChallengeToken)
+	assert.Empty(t, result.Token)
+	assert.Empty(t, result.RefreshToken)
+}
+
+func TestAuthService_LoginTwoFactor_IssuesTokens(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	twoFactorService := mocks.NewTwoFactorService(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	cfg := config.Config{
+		JWTSecret:          "test-secret-min-32-characters-long",
+		JWTExpire:          time.Hour,
+		RefreshTokenExpire: 24 * time.Hour,
+	}
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, twoFactorService, nil, nil, lockouts, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	secret := "JBSWY3DPEHPK3PXP"
+	enabledAt := time.Now()
+	user := &domain.User{
+		Model:         &gorm.Model{ID: 1},
+		Email:         "test@example.com",
+		Role:          domain.RoleAdmin,
+		IsActive:      true,
+		TOTPSecret:    &secret,
+		TOTPEnabledAt: &enabledAt,
+	}
+
+	twoFactorService.EXPECT().VerifyChallenge(mock.Anything, "challenge-token", "123456").Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(nil)
+	lockouts.EXPECT().Reset(mock.Anything, uint(1)).Return(nil)
+	refreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			assert.True(t, token.TwoFactorVerified)
+			token.Model = &gorm.Model{ID: 3}
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.LoginTwoFactor(context.Background(), &requests.LoginTwoFactorRequest{ChallengeToken: "challenge-token", Code: "123456"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.RefreshToken)
+
+	claims, err := utils.ValidateJWT(result.Token, utils.NewHMACJWTKeys(cfg.JWTSecret))
+	assert.NoError(t, err)
+	assert.True(t, claims.TwoFactor)
+}
+
+func TestAuthService_LoginTwoFactor_InvalidCode(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	twoFactorService := mocks.NewTwoFactorService(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, twoFactorService, nil, nil, lockouts, newNoopAuditService(t), nil, config.Config{})
+
+	user := &domain.User{Model: &gorm.Model{ID: 1}, IsActive: true}
+	twoFactorService.EXPECT().VerifyChallenge(mock.Anything, "challenge-token", "000000").Return(user, apperror.ErrInvalidTwoFactorCode)
+	lockouts.EXPECT().RecordFailure(mock.Anything, uint(1), mock.Anything).Return(nil)
+
+	// Act
+	result, err := svc.LoginTwoFactor(context.Background(), &requests.LoginTwoFactorRequest{ChallengeToken: "challenge-token", Code: "000000"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidTwoFactorCode)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_LoginTwoFactor_FailedCodesLockAccount(t *testing.T) {
+	// Arrange
+	twoFactorService := mocks.NewTwoFactorService(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	svc := NewAuthService(nil, nil, nil, nil, nil, twoFactorService, nil, nil, lockouts, newNoopAuditService(t), nil, config.Config{})
+
+	user := &domain.User{Model: &gorm.Model{ID: 1}, IsActive: true}
+	twoFactorService.EXPECT().VerifyChallenge(mock.Anything, "challenge-token", "000000").Return(user, apperror.ErrInvalidTwoFactorCode)
+	lockouts.EXPECT().RecordFailure(mock.Anything, uint(1), mock.Anything).Return(apperror.ErrAccountLocked)
+
+	// Act
+	result, err := svc.LoginTwoFactor(context.Background(), &requests.LoginTwoFactorRequest{ChallengeToken: "challenge-token", Code: "000000"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrAccountLocked)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_LoginTwoFactor_LockedAccountRejectsCorrectCode(t *testing.T) {
+	// Arrange
+	twoFactorService := mocks.NewTwoFactorService(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	svc := NewAuthService(nil, nil, nil, nil, nil, twoFactorService, nil, nil, lockouts, newNoopAuditService(t), nil, config.Config{})
+
+	user := &domain.User{Model: &gorm.Model{ID: 1}, IsActive: true}
+	twoFactorService.EXPECT().VerifyChallenge(mock.Anything, "challenge-token", "123456").Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(apperror.ErrAccountLocked)
+	// No session is created and the failures stay
+
+	// Act
+	result, err := svc.LoginTwoFactor(context.Background(), &requests.LoginTwoFactorRequest{ChallengeToken: "challenge-token", Code: "123456"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrAccountLocked)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_LoginTwoFactor_UnknownChallenge(t *testing.T) {
+	// Arrange
+	twoFactorService := mocks.NewTwoFactorService(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+	svc := NewAuthService(nil, nil, nil, nil, nil, twoFactorService, nil, nil, lockouts, newNoopAuditService(t), nil, config.Config{})
+
+	twoFactorService.EXPECT().VerifyChallenge(mock.Anything, "unknown-token", "123456").Return(nil, apperror.ErrInvalidTwoFactorChallenge)
+	// No account is known, so there's nothing to count the failure against
+
+	// Act
+	result, err := svc.LoginTwoFactor(context.Background(), &requests.LoginTwoFactorRequest{ChallengeToken: "unknown-token", Code: "123456"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidTwoFactorChallenge)
+	assert.Nil(t, result)
+}
+
+// writeTestEd25519Key stores a PEM encoded key as <kid>.pem, only the public part if publicOnly is set
+func writeTestEd25519Key(t *testing.T, dir, kid string, publicOnly bool) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	block := &pem.Block{Type: "PRIVATE KEY"}
+	block.Bytes, err = x509.MarshalPKCS8PrivateKey(priv)
+	if publicOnly {
+		block = &pem.Block{Type: "PUBLIC KEY"}
+		block.Bytes, err = x509.MarshalPKIXPublicKey(pub)
+	}
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), pem.EncodeToMemory(block), 0o600))
+}
+
+func TestAuthService_Login_SignsWithActiveKey(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	lockouts := mocks.NewAccountLockoutService(t)
+
+	dir := t.TempDir()
+	writeTestEd25519Key(t, dir, "2026-01", true) // retired
+	writeTestEd25519Key(t, dir, "2026-06", false)
+
+	cfg := config.Config{
+		JWTAlgorithm:       utils.JWTAlgorithmEdDSA,
+		JWTKeysDir:         dir,
+		JWTActiveKeyID:     "2026-06",
+		JWTExpire:          time.Hour,
+		RefreshTokenExpire: 24 * time.Hour,
+	}
+	jwtKeys, err := utils.LoadJWTKeys(&cfg)
+	assert.NoError(t, err)
+
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, nil, nil, lockouts, newNoopAuditService(t), jwtKeys, cfg)
+
+	hashedPassword, _ := utils.HashPassword("TestPass123!")
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", PasswordHash: hashedPassword, IsActive: true}
+
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(user, nil)
+	lockouts.EXPECT().Check(mock.Anything, uint(1)).Return(nil)
+	lockouts.EXPECT().Reset(mock.Anything, uint(1)).Return(nil)
+	refreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			token.Model = &gorm.Model{ID: 1}
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.Login(context.Background(), &requests.LoginRequest{Email: "test@example.com", Password: "TestPass123!"})
+
+	// Assert
+	assert.NoError(t, err)
+
+	claims, err := utils.ValidateJWT(result.Token, jwtKeys)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", claims.Subject)
+
+	// Both keys are published, the secret of the HS256 mode never is
+	jwks := jwtKeys.JWKS()
+	assert.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "OKP", jwks.Keys[0].KeyType)
+
+	// Tokens of other key sets and HS256 tokens are rejected
+	_, err = utils.ValidateJWT(result.Token, utils.NewHMACJWTKeys("test-secret-min-32-characters-long"))
+	assert.ErrorIs(t, err, apperror.ErrInvalidToken)
+	hmacToken, _ := utils.GenerateJWT(user, 1, false, utils.NewHMACJWTKeys("test-secret-min-32-characters-long"), &cfg)
+	_, err = utils.ValidateJWT(hmacToken, jwtKeys)
+	assert.ErrorIs(t, err, apperror.ErrInvalidToken)
+}
+
+func TestAuthService_RevokeOtherSessions_InvalidatesAccessTokens(t *testing.T) {
+	// Arrange
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewAuthService(nil, nil, refreshTokenRepo, nil, nil, nil, nil, tokenVersions, nil, newNoopAuditService(t), nil, config.Config{})
+
+	refreshTokenRepo.EXPECT().RevokeOthers(mock.Anything, uint(1), uint(5)).Return(nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+
+	// Act
+	err := svc.RevokeOtherSessions(context.Background(), uint(1), uint(5))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func newTestOIDCLoginRequest(invitationCode string) *requests.OIDCLoginRequest {
+	return &requests.OIDCLoginRequest{
+		OIDCCredentials: requests.OIDCCredentials{IDToken: "id-token"},
+		InvitationCode:  invitationCode,
+	}
+}
+
+func TestAuthService_LoginOIDC_LinkedUser(t *testing.T) {
+	// Arrange
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	oidcService := mocks.NewOIDCService(t)
+
+	cfg := config.Config{JWTSecret: "test-secret-min-32-characters-long", JWTExpire: time.Hour, RefreshTokenExpire: time.Hour}
+	svc := NewAuthService(nil, nil, refreshTokenRepo, nil, nil, nil, oidcService, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	req := newTestOIDCLoginRequest("")
+	identity := &oidc.Identity{Provider: "google", Subject: "external-123", Email: "test@example.com"}
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", Role: domain.RoleUser, IsActive: true}
+
+	oidcService.EXPECT().Verify(mock.Anything, "google", &req.OIDCCredentials).Return(identity, nil)
+	oidcService.EXPECT().FindLinkedUser(mock.Anything, identity).Return(user, nil)
+	refreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			token.Model = &gorm.Model{ID: 1}
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.LoginOIDC(context.Background(), "google", req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.Token)
+	assert.Equal(t, uint(1), result.User.ID)
+}
+
+func TestAuthService_LoginOIDC_NewUserNeedsInvitation(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	oidcService := mocks.NewOIDCService(t)
+	svc := NewAuthService(userRepo, nil, nil, nil, nil, nil, oidcService, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	req := newTestOIDCLoginRequest("")
+	identity := &oidc.Identity{Provider: "google", Subject: "external-123", Email: "new@example.com"}
+
+	oidcService.EXPECT().Verify(mock.Anything, "google", &req.OIDCCredentials).Return(identity, nil)
+	oidcService.EXPECT().FindLinkedUser(mock.Anything, identity).Return(nil, nil)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+
+	// Act
+	_, err := svc.LoginOIDC(context.Background(), "google", req)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvitationCodeRequired)
+}
+
+func TestAuthService_LoginOIDC_RegistersWithInvitation(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	oidcService := mocks.NewOIDCService(t)
+
+	cfg := config.Config{JWTSecret: "test-secret-min-32-characters-long", JWTExpire: time.Hour, RefreshTokenExpire: time.Hour}
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, oidcService, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	req := newTestOIDCLoginRequest("ABC12345")
+	identity := &oidc.Identity{Provider: "google", Subject: "external-123", Email: "new@example.com", EmailVerified: true, Name: "New User"}
+
+	oidcService.EXPECT().Verify(mock.Anything, "google", &req.OIDCCredentials).Return(identity, nil)
+	oidcService.EXPECT().FindLinkedUser(mock.Anything, identity).Return(nil, nil)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+	invitationRepo.EXPECT().
+		FindByCode(mock.Anything, "ABC12345").
+		Return(&domain.InvitationCode{Model: &gorm.Model{ID: 4}, Code: "ABC12345", MaxUses: 1}, nil)
+	userRepo.EXPECT().Count(mock.Anything).Return(int64(3), nil)
+	invitationRepo.EXPECT().
+		Redeem(mock.Anything, uint(4), mock.AnythingOfType("*domain.User"), mock.AnythingOfType("*domain.ExternalIdentity")).
+		Run(func(ctx context.Context, codeID uint, user *domain.User, link *domain.ExternalIdentity) {
+			assert.False(t, user.HasPassword())
+			assert.True(t, user.IsEmailVerified()) // verified by the provider, no mail is sent
+			assert.Equal(t, "New User", user.DisplayName)
+			// The link is created in the same transaction as the user
+			assert.Equal(t, "google", link.Provider)
+			assert.Equal(t, "external-123", link.Subject)
+			user.Model = &gorm.Model{ID: 5}
+		}).
+		Return(true, nil)
+	refreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.RefreshToken")).
+		Run(func(ctx context.Context, token *domain.RefreshToken) {
+			token.Model = &gorm.Model{ID: 1}
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.LoginOIDC(context.Background(), "google", req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "user", result.User.Role)
+	assert.Equal(t, "new@example.com", result.User.Email)
+}
+
+func TestAuthService_LoginOIDC_RegistrationRolledBackWhenLinkFails(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	oidcService := mocks.NewOIDCService(t)
+	svc := NewAuthService(userRepo, invitationRepo, refreshTokenRepo, nil, nil, nil, oidcService, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	req := newTestOIDCLoginRequest("ABC12345")
+	identity := &oidc.Identity{Provider: "google", Subject: "external-123", Email: "new@example.com", EmailVerified: true}
+	linkErr := errors.New("duplicate key value violates unique constraint")
+
+	oidcService.EXPECT().Verify(mock.Anything, "google", &req.OIDCCredentials).Return(identity, nil)
+	oidcService.EXPECT().FindLinkedUser(mock.Anything, identity).Return(nil, nil)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+	invitationRepo.EXPECT().
+		FindByCode(mock.Anything, "ABC12345").
+		Return(&domain.InvitationCode{Model: &gorm.Model{ID: 4}, Code: "ABC12345", MaxUses: 1}, nil)
+	userRepo.EXPECT().Count(mock.Anything).Return(int64(3), nil)
+	// The identity was linked in parallel, the transaction creates neither the user nor the link
+	invitationRepo.EXPECT().
+		Redeem(mock.Anything, uint(4), mock.AnythingOfType("*domain.User"), mock.AnythingOfType("*domain.ExternalIdentity")).
+		Return(false, linkErr)
+
+	// Act
+	result, err := svc.LoginOIDC(context.Background(), "google", req)
+
+	// Assert - no session is started for the account
+	assert.ErrorIs(t, err, linkErr)
+	assert.Nil(t, result)
+}
+
+func TestAuthService_LoginOIDC_EmailOfExistingAccount(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	oidcService := mocks.NewOIDCService(t)
+	svc := NewAuthService(userRepo, nil, nil, nil, nil, nil, oidcService, nil, nil, newNoopAuditService(t), nil, config.Config{})
+
+	req := newTestOIDCLoginRequest("ABC12345")
+	identity := &oidc.Identity{Provider: "google", Subject: "external-123", Email: "test@example.com"}
+
+	oidcService.EXPECT().Verify(mock.Anything, "google", &req.OIDCCredentials).Return(identity, nil)
+	oidcService.EXPECT().FindLinkedUser(mock.Anything, identity).Return(nil, nil)
+	userRepo.EXPECT().
+		FindByEmail(mock.Anything, "test@example.com").
+		Return(&domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com"}, nil)
+
+	// Act - the account has to be linked after logging in with the password
+	_, err := svc.LoginOIDC(context.Background(), "google", req)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrEmailAlreadyExists)
+}
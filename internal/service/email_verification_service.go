@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
+	"hopSpotAPI/pkg/mail"
+	"hopSpotAPI/pkg/utils"
+)
+
+type EmailVerificationService interface {
+	SendVerification(ctx context.Context, userID uint) error
+	RequestEmailChange(ctx context.Context, userID uint, req *requests.ChangeEmailRequest) error
+	ConfirmEmail(ctx context.Context, req *requests.ConfirmEmailRequest) (*responses.UserResponse, error)
+}
+
+type emailVerificationService struct {
+	userRepo       repository.UserRepository
+	emailTokenRepo repository.EmailTokenRepository
+	resetTokenRepo repository.PasswordResetTokenRepository
+	mailSender     mail.Sender
+	config         config.Config
+}
+
+func NewEmailVerificationService(
+	userRepo repository.UserRepository,
+	emailTokenRepo repository.EmailTokenRepository,
+	resetTokenRepo repository.PasswordResetTokenRepository,
+	mailSender mail.Sender,
+	config config.Config,
+) EmailVerificationService {
+	return &emailVerificationService{
+		userRepo:       userRepo,
+		emailTokenRepo: emailTokenRepo,
+		resetTokenRepo: resetTokenRepo,
+		mailSender:     mailSender,
+		config:         config,
+	}
+}
+
+// SendVerification implements EmailVerificationService.
+// Mails a confirmation link for the current address, older links stop working.
+func (s *emailVerificationService) SendVerification(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return apperror.ErrUserNotFound
+	}
+	if user.IsEmailVerified() {
+		return apperror.ErrEmailAlreadyVerified
+	}
+
+	tokenString, err := s.createToken(ctx, user.ID, domain.EmailTokenPurposeVerify, user.Email)
+	if err != nil {
+		return err
+	}
+
+	s.send(ctx, user.ID, mail.Message{
+		To:      user.Email,
+		Subject: "E-Mail-Adresse bestätigen",
+		Body: fmt.Sprintf("Hallo %s\n\n"+
+			"Bitte bestätige deine E-Mail-Adresse für HopSpot über folgenden Link:\n%s\n\n"+
+			"Der Link ist %d Stunden gültig.\n",
+			user.DisplayName, tokenLink(s.config.EmailConfirmURL, tokenString), int(s.config.EmailConfirmExpire.Hours())),
+	})
+
+	return nil
+}
+
+// RequestEmailChange implements EmailVerificationService.
+// The address is only changed once the link sent to the new address is confirmed.
+// Accounts without password confirm with their current email, like DeleteAccount.
+func (s *emailVerificationService) RequestEmailChange(ctx context.Context, userID uint, req *requests.ChangeEmailRequest) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return apperror.ErrUserNotFound
+	}
+
+	if !confirmsAccount(user, req.Password, req.Email) {
+		return apperror.ErrInvalidCredentials
+	}
+
+	newEmail := req.NewEmail
+	if strings.EqualFold(newEmail, user.Email) {
+		return apperror.ErrEmailUnchanged
+	}
+
+	existingUser, err := s.userRepo.FindByEmail(ctx, newEmail)
+	if err != nil {
+		return err
+	}
+	if existingUser != nil {
+		return apperror.ErrEmailAlreadyExists
+	}
+
+	tokenString, err := s.createToken(ctx, user.ID, domain.EmailTokenPurposeChange, newEmail)
+	if err != nil {
+		return err
+	}
+
+	s.send(ctx, user.ID, mail.Message{
+		To:      newEmail,
+		Subject: "Neue E-Mail-Adresse bestätigen",
+		Body: fmt.Sprintf("Hallo %s\n\n"+
+			"Bitte bestätige deine neue E-Mail-Adresse für HopSpot über folgenden Link:\n%s\n\n"+
+			"Der Link ist %d Stunden gültig. Bis dahin bleibt deine bisherige Adresse aktiv.\n",
+			user.DisplayName, tokenLink(s.config.EmailConfirmURL, tokenString), int(s.config.EmailConfirmExpire.Hours())),
+	})
+
+	return nil
+}
+
+// ConfirmEmail implements EmailVerificationService.
+func (s *emailVerificationService) ConfirmEmail(ctx context.Context, req *requests.ConfirmEmailRequest) (*responses.UserResponse, error) {
+	token, err := s.emailTokenRepo.FindByTokenHash(ctx, utils.HashToken(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || !token.IsValid() || !token.User.IsActive {
+		return nil, apperror.ErrInvalidEmailToken
+	}
+
+	user := &token.User
+	oldEmail := user.Email
+
+	// A verify link only confirms the address it was sent to, after a change it must not move the account back
+	if token.Purpose == domain.EmailTokenPurposeVerify && !strings.EqualFold(token.Email, user.Email) {
+		return nil, apperror.ErrInvalidEmailToken
+	}
+
+	// The address may have been taken since the change was requested
+	if token.Purpose == domain.EmailTokenPurposeChange {
+		existingUser, err := s.userRepo.FindByEmail(ctx, token.Email)
+		if err != nil {
+			return nil, err
+		}
+		if existingUser != nil && existingUser.ID != user.ID {
+			return nil, apperror.ErrEmailAlreadyExists
+		}
+	}
+
+	// Redeem first, so the token is single-use even for parallel requests
+	redeemed, err := s.emailTokenRepo.MarkAsUsed(ctx, token.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !redeemed {
+		return nil, apperror.ErrInvalidEmailToken
+	}
+
+	if token.Purpose == domain.EmailTokenPurposeChange {
+		// Links mailed to the old address must not outlive the change
+		if err := s.invalidateMailedTokens(ctx, user.ID); err != nil {
+			return nil, err
+		}
+		user.Email = token.Email
+	}
+
+	now := time.Now()
+	user.EmailVerifiedAt = &now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if token.Purpose == domain.EmailTokenPurposeChange {
+		s.send(ctx, user.ID, mail.Message{
+			To:      oldEmail,
+			Subject: "Deine E-Mail-Adresse wurde geändert",
+			Body: fmt.Sprintf("Hallo %s\n\n"+
+				"Die E-Mail-Adresse deines HopSpot-Kontos wurde auf %s geändert.\n\n"+
+				"Falls du das nicht warst, wende dich bitte umgehend an einen Admin.\n",
+				user.DisplayName, user.Email),
+		})
+	}
+
+	response := mapper.UserToResponse(user)
+	return &response, nil
+}
+
+// createToken replaces open tokens of the same purpose and returns the plain token
+func (s *emailVerificationService) createToken(ctx context.Context, userID uint, purpose, email string) (string, error) {
+	if err := s.emailTokenRepo.InvalidateByUserID(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+
+	tokenString, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return "", err
+	}
+
+	token := &domain.EmailToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		Email:     email,
+		TokenHash: utils.HashToken(tokenString),
+		ExpiresAt: time.Now().Add(s.config.EmailConfirmExpire),
+	}
+	if err := s.emailTokenRepo.Create(ctx, token); err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+// invalidateMailedTokens revokes all open email and password reset tokens of a user
+func (s *emailVerificationService) invalidateMailedTokens(ctx context.Context, userID uint) error {
+	for _, purpose := range []string{domain.EmailTokenPurposeVerify, domain.EmailTokenPurposeChange} {
+		if err := s.emailTokenRepo.InvalidateByUserID(ctx, userID, purpose); err != nil {
+			return err
+		}
+	}
+	return s.resetTokenRepo.InvalidateByUserID(ctx, userID)
+}
+
+// send delivers a mail, failures are only logged since the token can be requested again
+func (s *emailVerificationService) send(ctx context.Context, userID uint, msg mail.Message) {
+	if err := s.mailSender.Send(ctx, msg); err != nil {
+		logger.Error().Err(err).Uint("userID", userID).Msg("failed to send email confirmation mail")
+	}
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/mail"
+	"hopSpotAPI/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestEmailConfig() config.Config {
+	return config.Config{
+		EmailConfirmURL:    "https://hopspot.app/confirm-email",
+		EmailConfirmExpire: 48 * time.Hour,
+	}
+}
+
+func TestEmailVerificationService_SendVerification_SendsMail(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", DisplayName: "Test", IsActive: true}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	emailTokenRepo.EXPECT().InvalidateByUserID(mock.Anything, uint(1), domain.EmailTokenPurposeVerify).Return(nil)
+	emailTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.EmailToken")).
+		Run(func(ctx context.Context, token *domain.EmailToken) {
+			assert.Equal(t, domain.EmailTokenPurposeVerify, token.Purpose)
+			assert.Equal(t, "test@example.com", token.Email)
+		}).
+		Return(nil)
+	mailSender.EXPECT().
+		Send(mock.Anything, mock.AnythingOfType("mail.Message")).
+		Run(func(ctx context.Context, msg mail.Message) {
+			assert.Equal(t, "test@example.com", msg.To)
+			assert.Contains(t, msg.Body, "https://hopspot.app/confirm-email?token=")
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.SendVerification(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestEmailVerificationService_SendVerification_AlreadyVerified(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	verifiedAt := time.Now()
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", EmailVerifiedAt: &verifiedAt}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+
+	// Act
+	err := svc.SendVerification(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrEmailAlreadyVerified)
+}
+
+func TestEmailVerificationService_RequestEmailChange_MailsNewAddress(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	hash, _ := utils.HashPassword("TestPass123!")
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "old@example.com", PasswordHash: hash}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+	emailTokenRepo.EXPECT().InvalidateByUserID(mock.Anything, uint(1), domain.EmailTokenPurposeChange).Return(nil)
+	emailTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.EmailToken")).
+		Run(func(ctx context.Context, token *domain.EmailToken) {
+			assert.Equal(t, "new@example.com", token.Email)
+		}).
+		Return(nil)
+	mailSender.EXPECT().
+		Send(mock.Anything, mock.AnythingOfType("mail.Message")).
+		Run(func(ctx context.Context, msg mail.Message) {
+			assert.Equal(t, "new@example.com", msg.To)
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.RequestEmailChange(context.Background(), 1, &requests.ChangeEmailRequest{
+		NewEmail: "new@example.com",
+		Password: "TestPass123!",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "old@example.com", user.Email) // unchanged until confirmed
+}
+
+func TestEmailVerificationService_RequestEmailChange_EmailTaken(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	hash, _ := utils.HashPassword("TestPass123!")
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "old@example.com", PasswordHash: hash}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "taken@example.com").Return(&domain.User{Model: &gorm.Model{ID: 2}}, nil)
+
+	// Act
+	err := svc.RequestEmailChange(context.Background(), 1, &requests.ChangeEmailRequest{
+		NewEmail: "taken@example.com",
+		Password: "TestPass123!",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrEmailAlreadyExists)
+}
+
+func TestEmailVerificationService_RequestEmailChange_WrongPassword(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	hash, _ := utils.HashPassword("TestPass123!")
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "old@example.com", PasswordHash: hash}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+
+	// Act
+	err := svc.RequestEmailChange(context.Background(), 1, &requests.ChangeEmailRequest{
+		NewEmail: "new@example.com",
+		Password: "wrong",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidCredentials)
+}
+
+func TestEmailVerificationService_RequestEmailChange_WithoutPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		wantErr error
+	}{
+		{name: "current email", email: "Old@Example.com"},
+		{name: "other email", email: "someone@example.com", wantErr: apperror.ErrInvalidCredentials},
+		{name: "no confirmation", email: "", wantErr: apperror.ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := mocks.NewUserRepository(t)
+			emailTokenRepo := mocks.NewEmailTokenRepository(t)
+			mailSender := mocks.NewSender(t)
+			svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+			// Registered with an external login, no password to enter
+			user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "old@example.com"}
+
+			userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+			if tt.wantErr == nil {
+				userRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+				emailTokenRepo.EXPECT().InvalidateByUserID(mock.Anything, uint(1), domain.EmailTokenPurposeChange).Return(nil)
+				emailTokenRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.EmailToken")).Return(nil)
+				mailSender.EXPECT().Send(mock.Anything, mock.AnythingOfType("mail.Message")).Return(nil)
+			}
+
+			// Act
+			err := svc.RequestEmailChange(context.Background(), 1, &requests.ChangeEmailRequest{
+				NewEmail: "new@example.com",
+				Email:    tt.email,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmailVerificationService_RequestEmailChange_EmailDoesNotReplacePassword(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewEmailVerificationService(userRepo, nil, nil, nil, newTestEmailConfig())
+
+	hash, _ := utils.HashPassword("TestPass123!")
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "old@example.com", PasswordHash: hash}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+
+	// Act
+	err := svc.RequestEmailChange(context.Background(), 1, &requests.ChangeEmailRequest{
+		NewEmail: "new@example.com",
+		Email:    "old@example.com",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidCredentials)
+}
+
+func TestEmailVerificationService_ConfirmEmail_Verify(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	token := &domain.EmailToken{
+		ID:        5,
+		UserID:    1,
+		Purpose:   domain.EmailTokenPurposeVerify,
+		Email:     "test@example.com",
+		ExpiresAt: time.Now().Add(time.Hour),
+		User:      domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", IsActive: true},
+	}
+
+	emailTokenRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("plain-token")).Return(token, nil)
+	emailTokenRepo.EXPECT().MarkAsUsed(mock.Anything, uint(5)).Return(true, nil)
+	userRepo.EXPECT().
+		Update(mock.Anything, mock.AnythingOfType("*domain.User")).
+		Run(func(ctx context.Context, user *domain.User) {
+			assert.NotNil(t, user.EmailVerifiedAt)
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.ConfirmEmail(context.Background(), &requests.ConfirmEmailRequest{Token: "plain-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result.EmailVerifiedAt)
+}
+
+func TestEmailVerificationService_ConfirmEmail_ChangeNotifiesOldAddress(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	resetTokenRepo := mocks.NewPasswordResetTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, resetTokenRepo, mailSender, newTestEmailConfig())
+
+	token := &domain.EmailToken{
+		ID:        5,
+		UserID:    1,
+		Purpose:   domain.EmailTokenPurposeChange,
+		Email:     "new@example.com",
+		ExpiresAt: time.Now().Add(time.Hour),
+		User:      domain.User{Model: &gorm.Model{ID: 1}, Email: "old@example.com", IsActive: true},
+	}
+
+	emailTokenRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("plain-token")).Return(token, nil)
+	userRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+	emailTokenRepo.EXPECT().MarkAsUsed(mock.Anything, uint(5)).Return(true, nil)
+	// Links mailed to the old address stop working
+	emailTokenRepo.EXPECT().InvalidateByUserID(mock.Anything, uint(1), domain.EmailTokenPurposeVerify).Return(nil)
+	emailTokenRepo.EXPECT().InvalidateByUserID(mock.Anything, uint(1), domain.EmailTokenPurposeChange).Return(nil)
+	resetTokenRepo.EXPECT().InvalidateByUserID(mock.Anything, uint(1)).Return(nil)
+	userRepo.EXPECT().
+		Update(mock.Anything, mock.AnythingOfType("*domain.User")).
+		Run(func(ctx context.Context, user *domain.User) {
+			assert.Equal(t, "new@example.com", user.Email)
+			assert.NotNil(t, user.EmailVerifiedAt)
+		}).
+		Return(nil)
+	mailSender.EXPECT().
+		Send(mock.Anything, mock.AnythingOfType("mail.Message")).
+		Run(func(ctx context.Context, msg mail.Message) {
+			assert.Equal(t, "old@example.com", msg.To)
+			assert.Contains(t, msg.Body, "new@example.com")
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.ConfirmEmail(context.Background(), &requests.ConfirmEmailRequest{Token: "plain-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "new@example.com", result.Email)
+}
+
+func TestEmailVerificationService_ConfirmEmail_VerifyLinkOfPreviousAddress(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	// Sent to the old address before the account moved to a new one
+	token := &domain.EmailToken{
+		ID:        5,
+		UserID:    1,
+		Purpose:   domain.EmailTokenPurposeVerify,
+		Email:     "old@example.com",
+		ExpiresAt: time.Now().Add(time.Hour),
+		User:      domain.User{Model: &gorm.Model{ID: 1}, Email: "new@example.com", IsActive: true},
+	}
+
+	emailTokenRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("plain-token")).Return(token, nil)
+
+	// Act
+	result, err := svc.ConfirmEmail(context.Background(), &requests.ConfirmEmailRequest{Token: "plain-token"})
+
+	// Assert - neither redeemed nor the user updated
+	assert.ErrorIs(t, err, apperror.ErrInvalidEmailToken)
+	assert.Nil(t, result)
+}
+
+func TestEmailVerificationService_ConfirmEmail_UsedToken(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	emailTokenRepo := mocks.NewEmailTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewEmailVerificationService(userRepo, emailTokenRepo, nil, mailSender, newTestEmailConfig())
+
+	usedAt := time.Now().Add(-time.Minute)
+	token := &domain.EmailToken{
+		ID:        5,
+		Purpose:   domain.EmailTokenPurposeVerify,
+		ExpiresAt: time.Now().Add(time.Hour),
+		UsedAt:    &usedAt,
+		User:      domain.User{Model: &gorm.Model{ID: 1}, IsActive: true},
+	}
+
+	emailTokenRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("plain-token")).Return(token, nil)
+
+	// Act
+	result, err := svc.ConfirmEmail(context.Background(), &requests.ConfirmEmailRequest{Token: "plain-token"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidEmailToken)
+	assert.Nil(t, result)
+}
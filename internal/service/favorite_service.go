@@ -6,6 +6,7 @@ import (
 
 	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/pkg/logger"
 	"hopSpotAPI/pkg/storage"
@@ -99,13 +100,16 @@ func (s *favoriteService) List(ctx context.Context, userID uint, page, limit int
 			ID:        fav.ID,
 			CreatedAt: fav.CreatedAt,
 			Spot: responses.FavoriteSpotResponse{
-				ID:          fav.Spot.ID,
-				Name:        fav.Spot.Name,
-				Latitude:    fav.Spot.Latitude,
-				Longitude:   fav.Spot.Longitude,
-				Rating:      fav.Spot.Rating,
-				HasToilet:   fav.Spot.HasToilet,
-				HasTrashBin: fav.Spot.HasTrashBin,
+				ID:            fav.Spot.ID,
+				Name:          fav.Spot.Name,
+				Latitude:      fav.Spot.Latitude,
+				Longitude:     fav.Spot.Longitude,
+				Rating:        mapper.RoundRating(fav.Spot.AverageRating),
+				AverageRating: fav.Spot.AverageRating,
+				ReviewCount:   fav.Spot.ReviewCount,
+				Amenities:     fav.Spot.AmenityKeys(),
+				HasToilet:     fav.Spot.HasAmenity(domain.AmenityKeyToilet),
+				HasTrashBin:   fav.Spot.HasAmenity(domain.AmenityKeyTrashBin),
 			},
 		}
 		// Get main photo URL
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
+)
+
+type FollowService interface {
+	Follow(ctx context.Context, userID, targetID uint) (*responses.FollowResponse, error)
+	Unfollow(ctx context.Context, userID, targetID uint) error
+	Block(ctx context.Context, userID, targetID uint) error
+	Unblock(ctx context.Context, userID, targetID uint) error
+
+	ListFriends(ctx context.Context, userID uint, req *requests.ListFriendsRequest) (*responses.PaginatedFriendsResponse, error)
+	ListRequests(ctx context.Context, userID uint, req *requests.ListFriendsRequest) (*responses.PaginatedFollowRequestsResponse, error)
+	AcceptRequest(ctx context.Context, userID, requestID uint) (*responses.FollowResponse, error)
+	DeclineRequest(ctx context.Context, userID, requestID uint) error
+}
+
+type followService struct {
+	followRepo          repository.FollowRepository
+	userRepo            repository.UserRepository
+	notificationService NotificationService
+}
+
+func NewFollowService(followRepo repository.FollowRepository, userRepo repository.UserRepository, notificationService NotificationService) FollowService {
+	return &followService{
+		followRepo:          followRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// Follow implements FollowService.
+// Sends a friend request, or accepts the pending request of the target.
+func (s *followService) Follow(ctx context.Context, userID, targetID uint) (*responses.FollowResponse, error) {
+	if userID == targetID {
+		return nil, apperror.ErrCannotFollowSelf
+	}
+
+	target, err := s.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil || !target.IsActive {
+		return nil, apperror.ErrUserNotFound
+	}
+
+	existing, err := s.followRepo.Find(ctx, userID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if existing.Status == domain.FollowStatusBlocked {
+			return nil, apperror.ErrFollowBlocked
+		}
+		return nil, apperror.ErrFollowAlreadyExists
+	}
+
+	reverse, err := s.followRepo.Find(ctx, targetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if reverse != nil {
+		switch reverse.Status {
+		case domain.FollowStatusBlocked:
+			return nil, apperror.ErrFollowBlocked
+		case domain.FollowStatusAccepted:
+			return nil, apperror.ErrFollowAlreadyExists
+		case domain.FollowStatusPending:
+			// Both want it - accept the target's request
+			return s.accept(ctx, reverse, target)
+		}
+	}
+
+	follow := &domain.Follow{
+		FollowerID: userID,
+		FolloweeID: targetID,
+		Status:     domain.FollowStatusPending,
+	}
+	if err := s.followRepo.Create(ctx, follow); err != nil {
+		return nil, err
+	}
+
+	// Notify the target (async)
+	go func() {
+		if err := s.notificationService.NotifyFollowRequest(context.Background(), userID, targetID); err != nil {
+			logger.Warn().Err(err).Uint("followeeID", targetID).Msg("failed to send follow request notification")
+		}
+	}()
+
+	response := mapper.FollowToResponse(follow, target)
+	return &response, nil
+}
+
+// Unfollow implements FollowService.
+// Ends a friendship or withdraws a pending request.
+func (s *followService) Unfollow(ctx context.Context, userID, targetID uint) error {
+	existing, err := s.followRepo.Find(ctx, userID, targetID)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.Status == domain.FollowStatusBlocked {
+		return apperror.ErrFollowNotFound
+	}
+
+	return s.followRepo.DeleteBetween(ctx, userID, targetID)
+}
+
+// Block implements FollowService.
+func (s *followService) Block(ctx context.Context, userID, targetID uint) error {
+	if userID == targetID {
+		return apperror.ErrCannotFollowSelf
+	}
+
+	target, err := s.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return apperror.ErrUserNotFound
+	}
+
+	existing, err := s.followRepo.Find(ctx, userID, targetID)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Status == domain.FollowStatusBlocked {
+		return nil // already blocked
+	}
+
+	return s.followRepo.Block(ctx, userID, targetID)
+}
+
+// Unblock implements FollowService.
+func (s *followService) Unblock(ctx context.Context, userID, targetID uint) error {
+	existing, err := s.followRepo.Find(ctx, userID, targetID)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.Status != domain.FollowStatusBlocked {
+		return apperror.ErrFollowNotFound
+	}
+
+	return s.followRepo.Delete(ctx, existing.ID)
+}
+
+// ListFriends implements FollowService.
+func (s *followService) ListFriends(ctx context.Context, userID uint, req *requests.ListFriendsRequest) (*responses.PaginatedFriendsResponse, error) {
+	filter := repository.FollowFilter{
+		Page:   req.Page,
+		Limit:  req.Limit,
+		Status: domain.FollowStatusAccepted,
+	}
+
+	follows, total, err := s.followRepo.FindByFollower(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int(total) / req.Limit
+	if int(total)%req.Limit > 0 {
+		totalPages++
+	}
+
+	return &responses.PaginatedFriendsResponse{
+		Friends: mapper.FriendsToResponse(follows),
+		Pagination: responses.PaginationResponse{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// ListRequests implements FollowService.
+// Returns the pending requests other users sent to the user.
+func (s *followService) ListRequests(ctx context.Context, userID uint, req *requests.ListFriendsRequest) (*responses.PaginatedFollowRequestsResponse, error) {
+	filter := repository.FollowFilter{
+		Page:   req.Page,
+		Limit:  req.Limit,
+		Status: domain.FollowStatusPending,
+	}
+
+	follows, total, err := s.followRepo.FindByFollowee(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int(total) / req.Limit
+	if int(total)%req.Limit > 0 {
+		totalPages++
+	}
+
+	return &responses.PaginatedFollowRequestsResponse{
+		Requests: mapper.FollowRequestsToResponse(follows),
+		Pagination: responses.PaginationResponse{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// AcceptRequest implements FollowService.
+func (s *followService) AcceptRequest(ctx context.Context, userID, requestID uint) (*responses.FollowResponse, error) {
+	request, err := s.findIncomingRequest(ctx, userID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.accept(ctx, request, &request.Follower)
+}
+
+// DeclineRequest implements FollowService.
+func (s *followService) DeclineRequest(ctx context.Context, userID, requestID uint) error {
+	request, err := s.findIncomingRequest(ctx, userID, requestID)
+	if err != nil {
+		return err
+	}
+
+	return s.followRepo.Delete(ctx, request.ID)
+}
+
+// accept turns a pending request into a friendship and notifies the requester
+func (s *followService) accept(ctx context.Context, request *domain.Follow, requester *domain.User) (*responses.FollowResponse, error) {
+	if err := s.followRepo.Accept(ctx, request); err != nil {
+		return nil, err
+	}
+
+	followerID, followeeID := request.FollowerID, request.FolloweeID
+	go func() {
+		if err := s.notificationService.NotifyFollowAccepted(context.Background(), followerID, followeeID); err != nil {
+			logger.Warn().Err(err).Uint("followerID", followerID).Msg("failed to send follow accepted notification")
+		}
+	}()
+
+	response := mapper.FollowToResponse(request, requester)
+	return &response, nil
+}
+
+// findIncomingRequest loads a pending request and hides requests to other users as not found
+func (s *followService) findIncomingRequest(ctx context.Context, userID, requestID uint) (*domain.Follow, error) {
+	request, err := s.followRepo.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil || request.FolloweeID != userID || request.Status != domain.FollowStatusPending {
+		return nil, apperror.ErrFollowRequestNotFound
+	}
+
+	return request, nil
+}
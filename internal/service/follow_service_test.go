@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestFollowService_Follow_Self(t *testing.T) {
+	// Arrange
+	followRepo := mocks.NewFollowRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	notificationService := mocks.NewNotificationService(t)
+	svc := NewFollowService(followRepo, userRepo, notificationService)
+
+	// Act
+	result, err := svc.Follow(context.Background(), uint(1), uint(1))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrCannotFollowSelf)
+	assert.Nil(t, result)
+}
+
+func TestFollowService_Follow_CreatesPendingRequest(t *testing.T) {
+	// Arrange
+	followRepo := mocks.NewFollowRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	notificationService := mocks.NewNotificationService(t)
+	svc := NewFollowService(followRepo, userRepo, notificationService)
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(2)).
+		Return(&domain.User{Model: &gorm.Model{ID: 2}, DisplayName: "Friend", IsActive: true}, nil)
+
+	followRepo.EXPECT().Find(mock.Anything, uint(1), uint(2)).Return(nil, nil)
+	followRepo.EXPECT().Find(mock.Anything, uint(2), uint(1)).Return(nil, nil)
+
+	followRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.Follow")).
+		Run(func(ctx context.Context, f *domain.Follow) {
+			assert.Equal(t, domain.FollowStatusPending, f.Status)
+			f.ID = 10
+		}).
+		Return(nil)
+
+	notificationService.EXPECT().
+		NotifyFollowRequest(mock.Anything, uint(1), uint(2)).
+		Return(nil).
+		Maybe() // async call
+
+	// Act
+	result, err := svc.Follow(context.Background(), uint(1), uint(2))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(10), result.ID)
+	assert.Equal(t, "pending", result.Status)
+	assert.Equal(t, uint(2), result.User.ID)
+}
+
+func TestFollowService_Follow_AcceptsMutualRequest(t *testing.T) {
+	// Arrange
+	followRepo := mocks.NewFollowRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	notificationService := mocks.NewNotificationService(t)
+	svc := NewFollowService(followRepo, userRepo, notificationService)
+
+	reverse := &domain.Follow{ID: 7, FollowerID: 2, FolloweeID: 1, Status: domain.FollowStatusPending}
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(2)).
+		Return(&domain.User{Model: &gorm.Model{ID: 2}, DisplayName: "Friend", IsActive: true}, nil)
+
+	followRepo.EXPECT().Find(mock.Anything, uint(1), uint(2)).Return(nil, nil)
+	followRepo.EXPECT().Find(mock.Anything, uint(2), uint(1)).Return(reverse, nil)
+
+	followRepo.EXPECT().
+		Accept(mock.Anything, reverse).
+		Run(func(ctx context.Context, f *domain.Follow) {
+			f.Status = domain.FollowStatusAccepted
+		}).
+		Return(nil)
+
+	notificationService.EXPECT().
+		NotifyFollowAccepted(mock.Anything, uint(2), uint(1)).
+		Return(nil).
+		Maybe() // async call
+
+	// Act
+	result, err := svc.Follow(context.Background(), uint(1), uint(2))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "accepted", result.Status)
+}
+
+func TestFollowService_Follow_Blocked(t *testing.T) {
+	// Arrange
+	followRepo := mocks.NewFollowRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	notificationService := mocks.NewNotificationService(t)
+	svc := NewFollowService(followRepo, userRepo, notificationService)
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(2)).
+		Return(&domain.User{Model: &gorm.Model{ID: 2}, IsActive: true}, nil)
+
+	followRepo.EXPECT().Find(mock.Anything, uint(1), uint(2)).Return(nil, nil)
+	followRepo.EXPECT().
+		Find(mock.Anything, uint(2), uint(1)).
+		Return(&domain.Follow{ID: 3, FollowerID: 2, FolloweeID: 1, Status: domain.FollowStatusBlocked}, nil)
+
+	// Act
+	result, err := svc.Follow(context.Background(), uint(1), uint(2))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrFollowBlocked)
+	assert.Nil(t, result)
+}
+
+func TestFollowService_AcceptRequest_OtherUsersRequest(t *testing.T) {
+	// Arrange
+	followRepo := mocks.NewFollowRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	notificationService := mocks.NewNotificationService(t)
+	svc := NewFollowService(followRepo, userRepo, notificationService)
+
+	followRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.Follow{ID: 5, FollowerID: 2, FolloweeID: 3, Status: domain.FollowStatusPending}, nil)
+
+	// Act
+	result, err := svc.AcceptRequest(context.Background(), uint(1), uint(5))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrFollowRequestNotFound)
+	assert.Nil(t, result)
+}
+
+func TestFollowService_DeclineRequest_Success(t *testing.T) {
+	// Arrange
+	followRepo := mocks.NewFollowRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	notificationService := mocks.NewNotificationService(t)
+	svc := NewFollowService(followRepo, userRepo, notificationService)
+
+	followRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.Follow{ID: 5, FollowerID: 2, FolloweeID: 1, Status: domain.FollowStatusPending}, nil)
+
+	followRepo.EXPECT().Delete(mock.Anything, uint(5)).Return(nil)
+
+	// Act
+	err := svc.DeclineRequest(context.Background(), uint(1), uint(5))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestFollowService_ListFriends_FiltersAccepted(t *testing.T) {
+	// Arrange
+	followRepo := mocks.NewFollowRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	notificationService := mocks.NewNotificationService(t)
+	svc := NewFollowService(followRepo, userRepo, notificationService)
+
+	follows := []domain.Follow{
+		{ID: 1, FollowerID: 1, FolloweeID: 2, Status: domain.FollowStatusAccepted, Followee: domain.User{Model: &gorm.Model{ID: 2}, DisplayName: "Friend"}},
+	}
+
+	followRepo.EXPECT().
+		FindByFollower(mock.Anything, uint(1), repository.FollowFilter{Page: 1, Limit: 50, Status: domain.FollowStatusAccepted}).
+		Return(follows, int64(1), nil)
+
+	// Act
+	result, err := svc.ListFriends(context.Background(), uint(1), &requests.ListFriendsRequest{Page: 1, Limit: 50})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Friends, 1)
+	assert.Equal(t, "Friend", result.Friends[0].User.DisplayName)
+	assert.Equal(t, 1, result.Pagination.TotalPages)
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/utils"
+)
+
+// InvitationService lets users invite friends within their quota. Admins manage all codes in AdminService.
+type InvitationService interface {
+	List(ctx context.Context, userID uint) (*responses.UserInvitationsResponse, error)
+	Create(ctx context.Context, userID uint, req *requests.CreateInvitationRequest) (*responses.InvitationResponse, error)
+	Revoke(ctx context.Context, userID, id uint) error
+}
+
+type invitationService struct {
+	invitationRepo repository.InvitationRepository
+	userRepo       repository.UserRepository
+	config         config.Config
+}
+
+func NewInvitationService(invitationRepo repository.InvitationRepository, userRepo repository.UserRepository, cfg config.Config) InvitationService {
+	return &invitationService{
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		config:         cfg,
+	}
+}
+
+// List implements InvitationService.
+func (s *invitationService) List(ctx context.Context, userID uint) (*responses.UserInvitationsResponse, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, _, err := s.invitationRepo.FindAll(ctx, repository.InvitationFilter{CreatedBy: &userID})
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := s.invitationRepo.CountQuotaUsed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := responses.InvitationQuotaResponse{Used: used}
+	if !user.Role.Can(domain.PermissionInvitationManage) {
+		limit := s.config.InvitationQuota
+		remaining := max(limit-int(used), 0)
+		quota.Limit = &limit
+		quota.Remaining = &remaining
+	}
+
+	return &responses.UserInvitationsResponse{
+		Invitations: mapper.InvitationsToResponse(codes),
+		Quota:       quota,
+	}, nil
+}
+
+// Create implements InvitationService.
+// Codes of users always expire and never carry a role. Admins aren't limited by the quota.
+func (s *invitationService) Create(ctx context.Context, userID uint, req *requests.CreateInvitationRequest) (*responses.InvitationResponse, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := utils.GenerateInvitationCode(6)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.config.InvitationExpire)
+	invitationCode := &domain.InvitationCode{
+		Code:      code,
+		Comment:   req.Comment,
+		CreatedBy: &userID,
+		MaxUses:   1,
+		ExpiresAt: &expiresAt,
+	}
+	if req.MaxUses != nil {
+		invitationCode.MaxUses = *req.MaxUses
+	}
+
+	if user.Role.Can(domain.PermissionInvitationManage) {
+		if err := s.invitationRepo.Create(ctx, invitationCode); err != nil {
+			return nil, err
+		}
+	} else {
+		created, err := s.invitationRepo.CreateWithinQuota(ctx, invitationCode, s.config.InvitationQuota)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			return nil, apperror.ErrInvitationQuotaExceeded
+		}
+	}
+
+	response := mapper.InvitationToResponse(invitationCode)
+	return &response, nil
+}
+
+// Revoke implements InvitationService.
+// Unused uses of a revoked code are given back to the quota.
+func (s *invitationService) Revoke(ctx context.Context, userID, id uint) error {
+	code, err := s.invitationRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if code == nil || code.CreatedBy == nil || *code.CreatedBy != userID {
+		return apperror.ErrInvitationCodeNotFound
+	}
+
+	// Used up codes stay as record of who was invited
+	if code.IsUsedUp() {
+		return apperror.ErrCannotDeleteRedeemedCode
+	}
+
+	return s.invitationRepo.Delete(ctx, id)
+}
+
+func (s *invitationService) findUser(ctx context.Context, userID uint) (*domain.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, apperror.ErrUserNotFound
+	}
+	return user, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestInvitationService_Create_WithinQuota(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewInvitationService(invitationRepo, userRepo, config.Config{InvitationQuota: 5, InvitationExpire: 14 * 24 * time.Hour})
+
+	maxUses := 2
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.User{Model: &gorm.Model{ID: 1}, Role: domain.RoleUser}, nil)
+	invitationRepo.EXPECT().
+		CreateWithinQuota(mock.Anything, mock.AnythingOfType("*domain.InvitationCode"), 5).
+		Run(func(ctx context.Context, code *domain.InvitationCode, quota int) {
+			assert.Equal(t, 2, code.MaxUses)
+			assert.Nil(t, code.Role)
+			assert.NotNil(t, code.ExpiresAt)
+			code.Model = &gorm.Model{ID: 3}
+		}).
+		Return(true, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), 1, &requests.CreateInvitationRequest{Comment: "For Anna", MaxUses: &maxUses})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), result.ID)
+	assert.Equal(t, 2, result.MaxUses)
+	assert.NotNil(t, result.ExpiresAt)
+}
+
+func TestInvitationService_Create_QuotaExceeded(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewInvitationService(invitationRepo, userRepo, config.Config{InvitationQuota: 5, InvitationExpire: time.Hour})
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.User{Model: &gorm.Model{ID: 1}, Role: domain.RoleUser}, nil)
+	invitationRepo.EXPECT().
+		CreateWithinQuota(mock.Anything, mock.AnythingOfType("*domain.InvitationCode"), 5).
+		Return(false, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), 1, &requests.CreateInvitationRequest{})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvitationQuotaExceeded)
+	assert.Nil(t, result)
+}
+
+func TestInvitationService_List_Quota(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewInvitationService(invitationRepo, userRepo, config.Config{InvitationQuota: 5})
+
+	userID := uint(1)
+	userRepo.EXPECT().FindByID(mock.Anything, userID).Return(&domain.User{Model: &gorm.Model{ID: 1}, Role: domain.RoleUser}, nil)
+	invitationRepo.EXPECT().
+		FindAll(mock.Anything, mock.MatchedBy(func(f repository.InvitationFilter) bool {
+			return f.CreatedBy != nil && *f.CreatedBy == userID
+		})).
+		Return([]domain.InvitationCode{{
+			Model:     &gorm.Model{ID: 3},
+			Code:      "ABC123",
+			CreatedBy: &userID,
+			MaxUses:   2,
+			UseCount:  1,
+			Redemptions: []domain.InvitationRedemption{
+				{ID: 1, InvitationCodeID: 3, UserID: 7, User: domain.User{Model: &gorm.Model{ID: 7}, DisplayName: "Anna"}},
+			},
+		}}, int64(1), nil)
+	invitationRepo.EXPECT().CountQuotaUsed(mock.Anything, userID).Return(int64(2), nil)
+
+	// Act
+	result, err := svc.List(context.Background(), userID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Invitations, 1)
+	assert.Equal(t, "Anna", result.Invitations[0].InvitedUsers[0].DisplayName)
+	assert.Equal(t, 5, *result.Quota.Limit)
+	assert.Equal(t, int64(2), result.Quota.Used)
+	assert.Equal(t, 3, *result.Quota.Remaining)
+}
+
+func TestInvitationService_Revoke_CodeOfOtherUser(t *testing.T) {
+	// Arrange
+	invitationRepo := mocks.NewInvitationRepository(t)
+	svc := NewInvitationService(invitationRepo, nil, config.Config{})
+
+	otherUserID := uint(2)
+	invitationRepo.EXPECT().
+		FindByID(mock.Anything, uint(3)).
+		Return(&domain.InvitationCode{Model: &gorm.Model{ID: 3}, CreatedBy: &otherUserID, MaxUses: 1}, nil)
+
+	// Act
+	err := svc.Revoke(context.Background(), 1, 3)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvitationCodeNotFound)
+}
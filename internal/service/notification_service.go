@@ -3,8 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/pkg/apperror"
 	"hopSpotAPI/pkg/notification"
@@ -12,28 +16,74 @@ import (
 
 type NotificationService interface {
 	NotifyNewSpot(ctx context.Context, spot *domain.Spot, creatorID uint) error
+	NotifyFollowRequest(ctx context.Context, followerID, followeeID uint) error
+	NotifyFollowAccepted(ctx context.Context, followerID, followeeID uint) error
+
+	List(ctx context.Context, userID uint, req *requests.ListNotificationsRequest) (*responses.PaginatedNotificationsResponse, error)
+	GetUnreadCount(ctx context.Context, userID uint) (*responses.UnreadCountResponse, error)
+	MarkAsRead(ctx context.Context, notificationID, userID uint) error
+	MarkAllAsRead(ctx context.Context, userID uint) error
+	Delete(ctx context.Context, notificationID, userID uint) error
 }
 
 type notificationService struct {
-	fcmClient *notification.FCMClient
-	userRepo  repository.UserRepository
+	fcmClient        *notification.FCMClient
+	userRepo         repository.UserRepository
+	notificationRepo repository.NotificationRepository
 }
 
-func NewNotificationService(fcmClient *notification.FCMClient, userRepo repository.UserRepository) NotificationService {
+func NewNotificationService(fcmClient *notification.FCMClient, userRepo repository.UserRepository, notificationRepo repository.NotificationRepository) NotificationService {
 	return &notificationService{
-		fcmClient: fcmClient,
-		userRepo:  userRepo,
+		fcmClient:        fcmClient,
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
 	}
 }
 
 // NotifyNewSpot implements NotificationService.
 func (s *notificationService) NotifyNewSpot(ctx context.Context, spot *domain.Spot, creatorID uint) error {
-	// Skip if FCM not configured
+	user, err := s.userRepo.FindByID(ctx, creatorID)
+	if err != nil {
+		return fmt.Errorf("failed to get notification creator: %w", err)
+	}
+	if user == nil {
+		return apperror.ErrUserNotFound
+	}
+
+	title := "Neuer HopSpot"
+	body := fmt.Sprintf("%s hat einen neuen HopSpot hinzugefügt: %s", user.DisplayName, spot.Name)
+
+	// Only friends of the creator - inbox entries also for users without FCM token
+	recipientIDs, err := s.userRepo.GetActiveFollowerIDs(ctx, creatorID)
+	if err != nil {
+		return fmt.Errorf("failed to read notification recipients: %w", err)
+	}
+
+	spotID := spot.ID
+	sentAt := time.Now()
+	notifications := make([]domain.Notification, len(recipientIDs))
+	for i, recipientID := range recipientIDs {
+		notifications[i] = domain.Notification{
+			UserID:        recipientID,
+			Type:          domain.NotificationTypeNewSpot,
+			Title:         title,
+			Message:       body,
+			RelatedSpotID: &spotID,
+			RelatedUserID: &creatorID,
+			SentAt:        sentAt,
+		}
+	}
+
+	if err := s.notificationRepo.CreateBatch(ctx, notifications); err != nil {
+		return fmt.Errorf("failed to save notifications: %w", err)
+	}
+
+	// Skip push if FCM not configured
 	if s.fcmClient == nil {
 		return nil
 	}
 
-	tokens, err := s.userRepo.GetAllFCMTokens(ctx, creatorID)
+	tokens, err := s.userRepo.GetFollowerFCMTokens(ctx, creatorID)
 	if err != nil {
 		return fmt.Errorf("failed to read FCM tokens: %w", err)
 	}
@@ -42,26 +92,158 @@ func (s *notificationService) NotifyNewSpot(ctx context.Context, spot *domain.Sp
 		return nil
 	}
 
-	user, err := s.userRepo.FindByID(ctx, creatorID)
+	data := map[string]string{
+		"spot_id": fmt.Sprintf("%d", spot.ID),
+		"type":    domain.NotificationTypeNewSpot,
+	}
+
+	err = s.fcmClient.SendToMultiple(ctx, tokens, title, body, data)
 	if err != nil {
-		return fmt.Errorf("failed to get notification creator: %w", err)
+		return fmt.Errorf("failed to send notification: %w", err)
 	}
-	if user == nil {
+
+	return nil
+}
+
+// NotifyFollowRequest implements NotificationService.
+func (s *notificationService) NotifyFollowRequest(ctx context.Context, followerID, followeeID uint) error {
+	follower, err := s.userRepo.FindByID(ctx, followerID)
+	if err != nil {
+		return fmt.Errorf("failed to get follower: %w", err)
+	}
+	if follower == nil {
 		return apperror.ErrUserNotFound
 	}
 
-	data := map[string]string{
-		"spot_id": fmt.Sprintf("%d", spot.ID),
-		"type":    "new_spot",
+	body := fmt.Sprintf("%s möchte mit dir befreundet sein", follower.DisplayName)
+	return s.notifyUser(ctx, followeeID, domain.NotificationTypeFollowRequest, "Neue Freundschaftsanfrage", body, followerID)
+}
+
+// NotifyFollowAccepted implements NotificationService.
+func (s *notificationService) NotifyFollowAccepted(ctx context.Context, followerID, followeeID uint) error {
+	followee, err := s.userRepo.FindByID(ctx, followeeID)
+	if err != nil {
+		return fmt.Errorf("failed to get followee: %w", err)
+	}
+	if followee == nil {
+		return apperror.ErrUserNotFound
 	}
 
-	title := "Neuer HopSpot"
-	body := fmt.Sprintf("%s hat einen neuen HopSpot hinzugefügt: %s", user.DisplayName, spot.Name)
+	body := fmt.Sprintf("%s hat deine Freundschaftsanfrage angenommen", followee.DisplayName)
+	return s.notifyUser(ctx, followerID, domain.NotificationTypeFollowAccepted, "Freundschaftsanfrage angenommen", body, followeeID)
+}
 
-	err = s.fcmClient.SendToMultiple(ctx, tokens, title, body, data)
+// notifyUser saves an inbox entry for a single user and pushes it if the user has a device
+func (s *notificationService) notifyUser(ctx context.Context, userID uint, notificationType, title, body string, relatedUserID uint) error {
+	notification := &domain.Notification{
+		UserID:        userID,
+		Type:          notificationType,
+		Title:         title,
+		Message:       body,
+		RelatedUserID: &relatedUserID,
+		SentAt:        time.Now(),
+	}
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+
+	// Skip push if FCM not configured
+	if s.fcmClient == nil {
+		return nil
+	}
+
+	recipient, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
+		return fmt.Errorf("failed to get notification recipient: %w", err)
+	}
+	if recipient == nil || recipient.FcmToken == nil || !recipient.IsActive {
+		return nil
+	}
+
+	data := map[string]string{
+		"user_id": fmt.Sprintf("%d", relatedUserID),
+		"type":    notificationType,
+	}
+
+	if err := s.fcmClient.SendToDevice(ctx, *recipient.FcmToken, title, body, data); err != nil {
 		return fmt.Errorf("failed to send notification: %w", err)
 	}
 
 	return nil
 }
+
+// List implements NotificationService.
+func (s *notificationService) List(ctx context.Context, userID uint, req *requests.ListNotificationsRequest) (*responses.PaginatedNotificationsResponse, error) {
+	filter := repository.NotificationFilter{
+		Page:       req.Page,
+		Limit:      req.Limit,
+		UnreadOnly: req.UnreadOnly,
+	}
+
+	notifications, total, err := s.notificationRepo.FindByUserID(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate pagination info
+	totalPages := int(total) / req.Limit
+	if int(total)%req.Limit > 0 {
+		totalPages++
+	}
+
+	return &responses.PaginatedNotificationsResponse{
+		Notifications: mapper.NotificationsToResponse(notifications),
+		Pagination: responses.PaginationResponse{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// GetUnreadCount implements NotificationService.
+func (s *notificationService) GetUnreadCount(ctx context.Context, userID uint) (*responses.UnreadCountResponse, error) {
+	count, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.UnreadCountResponse{UnreadCount: count}, nil
+}
+
+// MarkAsRead implements NotificationService.
+func (s *notificationService) MarkAsRead(ctx context.Context, notificationID, userID uint) error {
+	if _, err := s.findOwnNotification(ctx, notificationID, userID); err != nil {
+		return err
+	}
+
+	return s.notificationRepo.MarkAsRead(ctx, notificationID)
+}
+
+// MarkAllAsRead implements NotificationService.
+func (s *notificationService) MarkAllAsRead(ctx context.Context, userID uint) error {
+	return s.notificationRepo.MarkAllAsRead(ctx, userID)
+}
+
+// Delete implements NotificationService.
+func (s *notificationService) Delete(ctx context.Context, notificationID, userID uint) error {
+	if _, err := s.findOwnNotification(ctx, notificationID, userID); err != nil {
+		return err
+	}
+
+	return s.notificationRepo.Delete(ctx, notificationID)
+}
+
+// findOwnNotification loads a notification and hides other users' entries as not found
+func (s *notificationService) findOwnNotification(ctx context.Context, notificationID, userID uint) (*domain.Notification, error) {
+	notification, err := s.notificationRepo.FindByID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	if notification == nil || notification.UserID != userID {
+		return nil, apperror.ErrNotificationNotFound
+	}
+
+	return notification, nil
+}
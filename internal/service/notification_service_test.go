@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestNotificationService_NotifyNewSpot_SavesInboxEntries(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	notificationRepo := mocks.NewNotificationRepository(t)
+	svc := NewNotificationService(nil, userRepo, notificationRepo) // FCM not configured
+
+	spot := &domain.Spot{ID: 7, Name: "Aussichtspunkt"}
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.User{Model: &gorm.Model{ID: 1}, DisplayName: "Creator"}, nil)
+
+	userRepo.EXPECT().
+		GetActiveFollowerIDs(mock.Anything, uint(1)).
+		Return([]uint{2, 3}, nil)
+
+	notificationRepo.EXPECT().
+		CreateBatch(mock.Anything, mock.AnythingOfType("[]domain.Notification")).
+		Run(func(ctx context.Context, notifications []domain.Notification) {
+			assert.Len(t, notifications, 2)
+			assert.Equal(t, uint(2), notifications[0].UserID)
+			assert.Equal(t, uint(3), notifications[1].UserID)
+			for _, n := range notifications {
+				assert.Equal(t, domain.NotificationTypeNewSpot, n.Type)
+				assert.Equal(t, uint(7), *n.RelatedSpotID)
+				assert.Equal(t, uint(1), *n.RelatedUserID)
+				assert.Contains(t, n.Message, "Aussichtspunkt")
+				assert.False(t, n.IsRead)
+			}
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.NotifyNewSpot(context.Background(), spot, uint(1))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestNotificationService_NotifyFollowRequest_SavesInboxEntry(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	notificationRepo := mocks.NewNotificationRepository(t)
+	svc := NewNotificationService(nil, userRepo, notificationRepo) // FCM not configured
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.User{Model: &gorm.Model{ID: 1}, DisplayName: "Requester"}, nil)
+
+	notificationRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.Notification")).
+		Run(func(ctx context.Context, n *domain.Notification) {
+			assert.Equal(t, uint(2), n.UserID)
+			assert.Equal(t, domain.NotificationTypeFollowRequest, n.Type)
+			assert.Equal(t, uint(1), *n.RelatedUserID)
+			assert.Contains(t, n.Message, "Requester")
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.NotifyFollowRequest(context.Background(), uint(1), uint(2))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestNotificationService_List_Pagination(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	notificationRepo := mocks.NewNotificationRepository(t)
+	svc := NewNotificationService(nil, userRepo, notificationRepo)
+
+	notifications := []domain.Notification{
+		{Model: &gorm.Model{ID: 1}, UserID: 2, Type: domain.NotificationTypeNewSpot, Title: "Neuer HopSpot"},
+		{Model: &gorm.Model{ID: 2}, UserID: 2, Type: domain.NotificationTypeNewSpot, Title: "Neuer HopSpot", IsRead: true},
+	}
+
+	notificationRepo.EXPECT().
+		FindByUserID(mock.Anything, uint(2), mock.MatchedBy(func(f repository.NotificationFilter) bool {
+			return f.Page == 2 && f.Limit == 2 && !f.UnreadOnly
+		})).
+		Return(notifications, int64(5), nil)
+
+	// Act
+	result, err := svc.List(context.Background(), uint(2), &requests.ListNotificationsRequest{Page: 2, Limit: 2})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Notifications, 2)
+	assert.True(t, result.Notifications[1].IsRead)
+	assert.Equal(t, int64(5), result.Pagination.Total)
+	assert.Equal(t, 3, result.Pagination.TotalPages)
+}
+
+func TestNotificationService_MarkAsRead_OtherUsersNotification(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	notificationRepo := mocks.NewNotificationRepository(t)
+	svc := NewNotificationService(nil, userRepo, notificationRepo)
+
+	notificationRepo.EXPECT().
+		FindByID(mock.Anything, uint(10)).
+		Return(&domain.Notification{Model: &gorm.Model{ID: 10}, UserID: 3}, nil)
+
+	// Act - user 2 tries to mark user 3's notification
+	err := svc.MarkAsRead(context.Background(), uint(10), uint(2))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrNotificationNotFound)
+}
+
+func TestNotificationService_Delete_Success(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	notificationRepo := mocks.NewNotificationRepository(t)
+	svc := NewNotificationService(nil, userRepo, notificationRepo)
+
+	notificationRepo.EXPECT().
+		FindByID(mock.Anything, uint(10)).
+		Return(&domain.Notification{Model: &gorm.Model{ID: 10}, UserID: 2}, nil)
+
+	notificationRepo.EXPECT().
+		Delete(mock.Anything, uint(10)).
+		Return(nil)
+
+	// Act
+	err := svc.Delete(context.Background(), uint(10), uint(2))
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
+	"hopSpotAPI/pkg/oidc"
+	"hopSpotAPI/pkg/utils"
+)
+
+type OIDCService interface {
+	ListProviders() []responses.OIDCProviderResponse
+	// Authorize starts the authorization code flow and returns the URL to open in the browser
+	Authorize(ctx context.Context, provider string) (*responses.OIDCAuthorizeResponse, error)
+	// IssueNonce starts a login with the ID token of a native sign-in SDK, the token has to carry the nonce
+	IssueNonce(ctx context.Context, provider string) (*responses.OIDCNonceResponse, error)
+	// Verify redeems an authorization code or checks an ID token and returns the proven identity
+	Verify(ctx context.Context, provider string, credentials *requests.OIDCCredentials) (*oidc.Identity, error)
+	// FindLinkedUser returns the user an identity is linked to and records the login, nil if it is unknown
+	FindLinkedUser(ctx context.Context, identity *oidc.Identity) (*domain.User, error)
+	CreateLink(ctx context.Context, userID uint, identity *oidc.Identity) (*domain.ExternalIdentity, error)
+
+	ListIdentities(ctx context.Context, userID uint) ([]responses.ExternalIdentityResponse, error)
+	LinkIdentity(ctx context.Context, userID uint, provider string, credentials *requests.OIDCCredentials) (*responses.ExternalIdentityResponse, error)
+	UnlinkIdentity(ctx context.Context, userID uint, provider string) error
+}
+
+type oidcService struct {
+	userRepo        repository.UserRepository
+	identityRepo    repository.ExternalIdentityRepository
+	authRequestRepo repository.OIDCAuthRequestRepository
+	providers       map[string]*oidc.Provider
+	config          config.Config
+}
+
+func NewOIDCService(
+	userRepo repository.UserRepository,
+	identityRepo repository.ExternalIdentityRepository,
+	authRequestRepo repository.OIDCAuthRequestRepository,
+	config config.Config,
+) OIDCService {
+	providers := make(map[string]*oidc.Provider, len(config.OIDCProviders))
+	for _, providerConfig := range config.OIDCProviders {
+		providers[providerConfig.Name] = oidc.NewProvider(providerConfig)
+	}
+
+	return &oidcService{
+		userRepo:        userRepo,
+		identityRepo:    identityRepo,
+		authRequestRepo: authRequestRepo,
+		providers:       providers,
+		config:          config,
+	}
+}
+
+// ListProviders implements OIDCService.
+func (s *oidcService) ListProviders() []responses.OIDCProviderResponse {
+	result := make([]responses.OIDCProviderResponse, len(s.config.OIDCProviders))
+	for i, providerConfig := range s.config.OIDCProviders {
+		result[i] = responses.OIDCProviderResponse{Name: providerConfig.Name}
+	}
+	return result
+}
+
+// Authorize implements OIDCService.
+// State, nonce and PKCE verifier are kept server-side until the code comes back.
+func (s *oidcService) Authorize(ctx context.Context, provider string) (*responses.OIDCAuthorizeResponse, error) {
+	p, err := s.getProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := oidc.GenerateCodeVerifier()
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationURL, err := p.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	authRequest := &domain.OIDCAuthRequest{
+		Provider:     p.Name(),
+		StateHash:    utils.HashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    time.Now().Add(s.config.OIDCAuthRequestExpire),
+	}
+	if err := s.authRequestRepo.Create(ctx, authRequest); err != nil {
+		return nil, err
+	}
+
+	return &responses.OIDCAuthorizeResponse{
+		AuthorizationURL: authorizationURL,
+		State:            state,
+	}, nil
+}
+
+// IssueNonce implements OIDCService.
+// Only the hash is stored, like the state of the code flow.
+func (s *oidcService) IssueNonce(ctx context.Context, provider string) (*responses.OIDCNonceResponse, error) {
+	p, err := s.getProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	authRequest := &domain.OIDCAuthRequest{
+		Provider:  p.Name(),
+		StateHash: utils.HashToken(nonce),
+		ExpiresAt: time.Now().Add(s.config.OIDCAuthRequestExpire),
+	}
+	if err := s.authRequestRepo.Create(ctx, authRequest); err != nil {
+		return nil, err
+	}
+
+	return &responses.OIDCNonceResponse{Nonce: nonce}, nil
+}
+
+// Verify implements OIDCService.
+func (s *oidcService) Verify(ctx context.Context, provider string, credentials *requests.OIDCCredentials) (*oidc.Identity, error) {
+	p, err := s.getProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	var identity *oidc.Identity
+	if credentials.Code != "" {
+		authRequest, err := s.redeemAuthRequest(ctx, p.Name(), credentials.State)
+		if err != nil {
+			return nil, err
+		}
+		// A nonce of IssueNonce has no PKCE verifier and is no state
+		if authRequest.CodeVerifier == "" {
+			return nil, apperror.ErrInvalidOIDCState
+		}
+		identity, err = p.Exchange(ctx, credentials.Code, authRequest.CodeVerifier, authRequest.Nonce)
+		if err != nil {
+			logger.Warn().Err(err).Str("provider", p.Name()).Msg("OIDC code exchange failed")
+			return nil, apperror.ErrOIDCLoginFailed
+		}
+	} else {
+		// The nonce is single-use, so a leaked ID token can't be replayed
+		authRequest, err := s.redeemAuthRequest(ctx, p.Name(), credentials.Nonce)
+		if err != nil {
+			return nil, err
+		}
+		// States of the code flow have a PKCE verifier and are no nonce
+		if authRequest.CodeVerifier != "" {
+			return nil, apperror.ErrInvalidOIDCState
+		}
+		identity, err = p.VerifyIDToken(ctx, credentials.IDToken, credentials.Nonce)
+		if err != nil {
+			logger.Warn().Err(err).Str("provider", p.Name()).Msg("OIDC ID token rejected")
+			return nil, apperror.ErrOIDCLoginFailed
+		}
+	}
+
+	return identity, nil
+}
+
+// FindLinkedUser implements OIDCService.
+func (s *oidcService) FindLinkedUser(ctx context.Context, identity *oidc.Identity) (*domain.User, error) {
+	link, err := s.identityRepo.FindByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil {
+		return nil, nil
+	}
+
+	user, err := s.userRepo.FindByID(ctx, link.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		// The user was deleted, the identity may be used for a new account
+		if err := s.identityRepo.Delete(ctx, link.ID); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	if err := s.identityRepo.UpdateLastLogin(ctx, link.ID); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// CreateLink implements OIDCService.
+// A user can link one account per provider, an account can only be linked to one user.
+func (s *oidcService) CreateLink(ctx context.Context, userID uint, identity *oidc.Identity) (*domain.ExternalIdentity, error) {
+	existing, err := s.identityRepo.FindByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if existing.UserID == userID {
+			return nil, apperror.ErrExternalIdentityAlreadyLinked
+		}
+		return nil, apperror.ErrExternalIdentityInUse
+	}
+
+	linked, err := s.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range linked {
+		if link.Provider == identity.Provider {
+			return nil, apperror.ErrExternalIdentityAlreadyLinked
+		}
+	}
+
+	link := &domain.ExternalIdentity{
+		UserID:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}
+	if err := s.identityRepo.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// ListIdentities implements OIDCService.
+func (s *oidcService) ListIdentities(ctx context.Context, userID uint) ([]responses.ExternalIdentityResponse, error) {
+	identities, err := s.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ExternalIdentitiesToResponse(identities), nil
+}
+
+// LinkIdentity implements OIDCService.
+func (s *oidcService) LinkIdentity(ctx context.Context, userID uint, provider string, credentials *requests.OIDCCredentials) (*responses.ExternalIdentityResponse, error) {
+	identity, err := s.Verify(ctx, provider, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.CreateLink(ctx, userID, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	response := mapper.ExternalIdentityToResponse(link)
+	return &response, nil
+}
+
+// UnlinkIdentity implements OIDCService.
+// Accounts without password keep at least one identity, otherwise they couldn't log in anymore.
+func (s *oidcService) UnlinkIdentity(ctx context.Context, userID uint, provider string) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return apperror.ErrUserNotFound
+	}
+
+	identities, err := s.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, identity := range identities {
+		if identity.Provider != provider {
+			continue
+		}
+		if !user.HasPassword() && len(identities) == 1 {
+			return apperror.ErrLastLoginMethod
+		}
+		return s.identityRepo.Delete(ctx, identity.ID)
+	}
+
+	return apperror.ErrExternalIdentityNotFound
+}
+
+// redeemAuthRequest loads the auth request of a state and marks it as used
+func (s *oidcService) redeemAuthRequest(ctx context.Context, provider, state string) (*domain.OIDCAuthRequest, error) {
+	authRequest, err := s.authRequestRepo.FindByStateHash(ctx, utils.HashToken(state))
+	if err != nil {
+		return nil, err
+	}
+	if authRequest == nil || !authRequest.IsValid() || authRequest.Provider != provider {
+		return nil, apperror.ErrInvalidOIDCState
+	}
+
+	// Redeem first, so the state is single-use even for parallel requests
+	redeemed, err := s.authRequestRepo.MarkAsUsed(ctx, authRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !redeemed {
+		return nil, apperror.ErrInvalidOIDCState
+	}
+
+	return authRequest, nil
+}
+
+func (s *oidcService) getProvider(name string) (*oidc.Provider, error) {
+	p, ok := s.providers[name]
+	if !ok {
+		return nil, apperror.ErrOIDCProviderNotFound
+	}
+	return p, nil
+}
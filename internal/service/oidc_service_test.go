@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/oidc"
+	"hopSpotAPI/pkg/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// testIssuer is a local OpenID Connect provider with discovery, JWKS and token endpoint
+type testIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	// authorization code the token endpoint accepts and what it was issued for
+	code          string
+	codeChallenge string
+	nonce         string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	issuer := &testIssuer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.server.URL,
+			"authorization_endpoint": issuer.server.URL + "/authorize",
+			"token_endpoint":         issuer.server.URL + "/token",
+			"jwks_uri":               issuer.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"use": "sig",
+				"kid": "test-key",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.Form.Get("code") != issuer.code || oidc.CodeChallenge(r.Form.Get("code_verifier")) != issuer.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"id_token": issuer.idToken(t, "hopspot-web", issuer.nonce),
+		})
+	})
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *testIssuer) idToken(t *testing.T, audience, nonce string) string {
+	claims := jwt.MapClaims{
+		"iss":            i.server.URL,
+		"sub":            "external-123",
+		"aud":            audience,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"email":          "user@example.com",
+		"email_verified": true,
+		"name":           "Test User",
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	signed, err := token.SignedString(i.key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func (i *testIssuer) config() config.Config {
+	return config.Config{
+		OIDCProviders: []config.OIDCProviderConfig{{
+			Name:        "test",
+			IssuerURL:   i.server.URL,
+			ClientID:    "hopspot-web",
+			RedirectURL: "https://hopspot.app/auth/callback",
+			Scopes:      []string{"openid", "email"},
+			Audiences:   []string{"hopspot-ios"},
+		}},
+		OIDCAuthRequestExpire: 10 * time.Minute,
+	}
+}
+
+// expectNonce lets the repository redeem a nonce issued for the test provider
+func expectNonce(authRequestRepo *mocks.OIDCAuthRequestRepository, nonce string) {
+	authRequestRepo.EXPECT().
+		FindByStateHash(mock.Anything, utils.HashToken(nonce)).
+		Return(&domain.OIDCAuthRequest{ID: 9, Provider: "test", ExpiresAt: time.Now().Add(time.Minute)}, nil)
+	authRequestRepo.EXPECT().MarkAsUsed(mock.Anything, uint(9)).Return(true, nil)
+}
+
+func TestOIDCService_IssueNonce(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	var stored *domain.OIDCAuthRequest
+	authRequestRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.OIDCAuthRequest")).
+		Run(func(ctx context.Context, request *domain.OIDCAuthRequest) {
+			stored = request
+		}).
+		Return(nil)
+
+	// Act
+	result, err := svc.IssueNonce(context.Background(), "test")
+
+	// Assert - only the hash is stored
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.Nonce)
+	assert.Equal(t, utils.HashToken(result.Nonce), stored.StateHash)
+	assert.Empty(t, stored.Nonce)
+	assert.Empty(t, stored.CodeVerifier)
+}
+
+func TestOIDCService_Verify_IDToken(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	expectNonce(authRequestRepo, "app-nonce")
+
+	// Act - the mobile app is an additional audience
+	identity, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{
+		IDToken: issuer.idToken(t, "hopspot-ios", "app-nonce"),
+		Nonce:   "app-nonce",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "test", identity.Provider)
+	assert.Equal(t, "external-123", identity.Subject)
+	assert.Equal(t, "user@example.com", identity.Email)
+	assert.True(t, identity.EmailVerified)
+}
+
+func TestOIDCService_Verify_IDTokenOfOtherClient(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	expectNonce(authRequestRepo, "app-nonce")
+
+	// Act
+	_, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{
+		IDToken: issuer.idToken(t, "other-app", "app-nonce"),
+		Nonce:   "app-nonce",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrOIDCLoginFailed)
+}
+
+func TestOIDCService_Verify_NonceMismatch(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	expectNonce(authRequestRepo, "app-nonce")
+
+	// Act
+	_, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{
+		IDToken: issuer.idToken(t, "hopspot-web", "replayed-nonce"),
+		Nonce:   "app-nonce",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrOIDCLoginFailed)
+}
+
+func TestOIDCService_Verify_IDTokenWithoutNonce(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	expectNonce(authRequestRepo, "app-nonce")
+
+	// Act - the token was requested without a nonce
+	_, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{
+		IDToken: issuer.idToken(t, "hopspot-ios", ""),
+		Nonce:   "app-nonce",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrOIDCLoginFailed)
+}
+
+func TestOIDCService_Verify_UnissuedNonce(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	authRequestRepo.EXPECT().FindByStateHash(mock.Anything, utils.HashToken("own-nonce")).Return(nil, nil)
+
+	// Act - a nonce the client picked itself
+	_, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{
+		IDToken: issuer.idToken(t, "hopspot-ios", "own-nonce"),
+		Nonce:   "own-nonce",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidOIDCState)
+}
+
+func TestOIDCService_Verify_StateUsedAsNonce(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	authRequestRepo.EXPECT().
+		FindByStateHash(mock.Anything, utils.HashToken("state")).
+		Return(&domain.OIDCAuthRequest{ID: 7, Provider: "test", CodeVerifier: "verifier", ExpiresAt: time.Now().Add(time.Minute)}, nil)
+	authRequestRepo.EXPECT().MarkAsUsed(mock.Anything, uint(7)).Return(true, nil)
+
+	// Act
+	_, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{
+		IDToken: issuer.idToken(t, "hopspot-ios", "state"),
+		Nonce:   "state",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidOIDCState)
+}
+
+func TestOIDCService_Verify_UnknownProvider(t *testing.T) {
+	// Arrange
+	svc := NewOIDCService(nil, nil, nil, config.Config{})
+
+	// Act
+	_, err := svc.Verify(context.Background(), "unknown", &requests.OIDCCredentials{IDToken: "token"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrOIDCProviderNotFound)
+}
+
+func TestOIDCService_AuthorizationCodeFlow(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	var stored *domain.OIDCAuthRequest
+	authRequestRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.OIDCAuthRequest")).
+		Run(func(ctx context.Context, request *domain.OIDCAuthRequest) {
+			request.ID = 7
+			stored = request
+		}).
+		Return(nil)
+
+	// Act - start the flow
+	authorize, err := svc.Authorize(context.Background(), "test")
+	assert.NoError(t, err)
+
+	// Assert - PKCE and nonce are sent to the provider
+	authorizationURL, err := url.Parse(authorize.AuthorizationURL)
+	assert.NoError(t, err)
+	query := authorizationURL.Query()
+	assert.Equal(t, issuer.server.URL+"/authorize", authorizationURL.Scheme+"://"+authorizationURL.Host+authorizationURL.Path)
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, oidc.CodeChallenge(stored.CodeVerifier), query.Get("code_challenge"))
+	assert.Equal(t, stored.Nonce, query.Get("nonce"))
+	assert.Equal(t, authorize.State, query.Get("state"))
+	assert.Equal(t, utils.HashToken(authorize.State), stored.StateHash)
+
+	// The provider redirects back with a code
+	issuer.code = "auth-code"
+	issuer.codeChallenge = query.Get("code_challenge")
+	issuer.nonce = query.Get("nonce")
+
+	authRequestRepo.EXPECT().FindByStateHash(mock.Anything, utils.HashToken(authorize.State)).Return(stored, nil)
+	authRequestRepo.EXPECT().MarkAsUsed(mock.Anything, uint(7)).Return(true, nil)
+
+	// Act - finish the flow
+	identity, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{
+		Code:  "auth-code",
+		State: authorize.State,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "external-123", identity.Subject)
+}
+
+func TestOIDCService_Verify_UsedState(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	authRequestRepo := mocks.NewOIDCAuthRequestRepository(t)
+	svc := NewOIDCService(nil, nil, authRequestRepo, issuer.config())
+
+	usedAt := time.Now()
+	authRequestRepo.EXPECT().
+		FindByStateHash(mock.Anything, utils.HashToken("state")).
+		Return(&domain.OIDCAuthRequest{ID: 7, Provider: "test", ExpiresAt: time.Now().Add(time.Minute), UsedAt: &usedAt}, nil)
+
+	// Act
+	_, err := svc.Verify(context.Background(), "test", &requests.OIDCCredentials{Code: "auth-code", State: "state"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidOIDCState)
+}
+
+func TestOIDCService_CreateLink_IdentityOfOtherUser(t *testing.T) {
+	// Arrange
+	identityRepo := mocks.NewExternalIdentityRepository(t)
+	svc := NewOIDCService(nil, identityRepo, nil, config.Config{})
+
+	identityRepo.EXPECT().
+		FindByProviderSubject(mock.Anything, "test", "external-123").
+		Return(&domain.ExternalIdentity{ID: 3, UserID: 2, Provider: "test", Subject: "external-123"}, nil)
+
+	// Act
+	_, err := svc.CreateLink(context.Background(), 1, &oidc.Identity{Provider: "test", Subject: "external-123"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrExternalIdentityInUse)
+}
+
+func TestOIDCService_UnlinkIdentity_LastLoginMethod(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	identityRepo := mocks.NewExternalIdentityRepository(t)
+	svc := NewOIDCService(userRepo, identityRepo, nil, config.Config{})
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.User{Model: &gorm.Model{ID: 1}}, nil)
+	identityRepo.EXPECT().
+		FindByUserID(mock.Anything, uint(1)).
+		Return([]domain.ExternalIdentity{{ID: 3, UserID: 1, Provider: "test"}}, nil)
+
+	// Act
+	err := svc.UnlinkIdentity(context.Background(), 1, "test")
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrLastLoginMethod)
+}
+
+func TestOIDCService_UnlinkIdentity_WithPassword(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	identityRepo := mocks.NewExternalIdentityRepository(t)
+	svc := NewOIDCService(userRepo, identityRepo, nil, config.Config{})
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.User{Model: &gorm.Model{ID: 1}, PasswordHash: "hash"}, nil)
+	identityRepo.EXPECT().
+		FindByUserID(mock.Anything, uint(1)).
+		Return([]domain.ExternalIdentity{{ID: 3, UserID: 1, Provider: "test"}}, nil)
+	identityRepo.EXPECT().Delete(mock.Anything, uint(3)).Return(nil)
+
+	// Act
+	err := svc.UnlinkIdentity(context.Background(), 1, "test")
+
+	// Assert
+	assert.NoError(t, err)
+}
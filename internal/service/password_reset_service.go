@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
+	"hopSpotAPI/pkg/mail"
+	"hopSpotAPI/pkg/utils"
+)
+
+type PasswordResetService interface {
+	ForgotPassword(ctx context.Context, req *requests.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req *requests.ResetPasswordRequest) error
+}
+
+type passwordResetService struct {
+	userRepo         repository.UserRepository
+	resetTokenRepo   repository.PasswordResetTokenRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	mailSender       mail.Sender
+	tokenVersions    TokenVersionService
+	config           config.Config
+}
+
+func NewPasswordResetService(
+	userRepo repository.UserRepository,
+	resetTokenRepo repository.PasswordResetTokenRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	mailSender mail.Sender,
+	tokenVersions TokenVersionService,
+	config config.Config,
+) PasswordResetService {
+	return &passwordResetService{
+		userRepo:         userRepo,
+		resetTokenRepo:   resetTokenRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		mailSender:       mailSender,
+		tokenVersions:    tokenVersions,
+		config:           config,
+	}
+}
+
+// ForgotPassword implements PasswordResetService.
+// Always succeeds for unknown or deactivated accounts, so emails cannot be probed.
+func (s *passwordResetService) ForgotPassword(ctx context.Context, req *requests.ForgotPasswordRequest) error {
+	user, err := s.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsActive {
+		return nil
+	}
+
+	// Only the newest link works
+	if err := s.resetTokenRepo.InvalidateByUserID(ctx, user.ID); err != nil {
+		return err
+	}
+
+	tokenString, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	token := &domain.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: utils.HashToken(tokenString),
+		ExpiresAt: time.Now().Add(s.config.PasswordResetExpire),
+	}
+	if err := s.resetTokenRepo.Create(ctx, token); err != nil {
+		return err
+	}
+
+	msg := mail.Message{
+		To:      user.Email,
+		Subject: "Passwort zurücksetzen",
+		Body: fmt.Sprintf("Hallo %s\n\n"+
+			"Du kannst dein HopSpot-Passwort über folgenden Link zurücksetzen:\n%s\n\n"+
+			"Der Link ist %d Minuten gültig. Falls du das nicht angefordert hast, kannst du diese Mail ignorieren.\n",
+			user.DisplayName, tokenLink(s.config.PasswordResetURL, tokenString), int(s.config.PasswordResetExpire.Minutes())),
+	}
+
+	// The response must not reveal whether sending worked
+	if err := s.mailSender.Send(ctx, msg); err != nil {
+		logger.Error().Err(err).Uint("userID", user.ID).Msg("failed to send password reset mail")
+	}
+
+	return nil
+}
+
+// ResetPassword implements PasswordResetService.
+func (s *passwordResetService) ResetPassword(ctx context.Context, req *requests.ResetPasswordRequest) error {
+	token, err := s.resetTokenRepo.FindByTokenHash(ctx, utils.HashToken(req.Token))
+	if err != nil {
+		return err
+	}
+	if token == nil || !token.IsValid() || !token.User.IsActive {
+		return apperror.ErrInvalidResetToken
+	}
+
+	// Redeem first, so the token is single-use even for parallel requests
+	redeemed, err := s.resetTokenRepo.MarkAsUsed(ctx, token.ID)
+	if err != nil {
+		return err
+	}
+	if !redeemed {
+		return apperror.ErrInvalidResetToken
+	}
+
+	hashedPassword, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	user := &token.User
+	user.PasswordHash = hashedPassword
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Log out everywhere
+	if err := s.refreshTokenRepo.RevokeByUserID(ctx, user.ID); err != nil {
+		return err
+	}
+	return s.tokenVersions.Invalidate(ctx, user.ID)
+}
+
+// tokenLink appends a mailed token as query parameter to a configured URL
+func tokenLink(baseURL, token string) string {
+	separator := "?"
+	if strings.Contains(baseURL, "?") {
+		separator = "&"
+	}
+	return baseURL + separator + "token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/mail"
+	"hopSpotAPI/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestPasswordResetConfig() config.Config {
+	return config.Config{
+		PasswordResetURL:    "https://hopspot.app/reset",
+		PasswordResetExpire: time.Hour,
+	}
+}
+
+func TestPasswordResetService_ForgotPassword_SendsMail(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	resetTokenRepo := mocks.NewPasswordResetTokenRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewPasswordResetService(userRepo, resetTokenRepo, refreshTokenRepo, mailSender, nil, newTestPasswordResetConfig())
+
+	user := &domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", DisplayName: "Test", IsActive: true}
+
+	userRepo.EXPECT().FindByEmail(mock.Anything, "test@example.com").Return(user, nil)
+	resetTokenRepo.EXPECT().InvalidateByUserID(mock.Anything, uint(1)).Return(nil)
+
+	var storedHash string
+	resetTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.PasswordResetToken")).
+		Run(func(ctx context.Context, token *domain.PasswordResetToken) {
+			assert.Equal(t, uint(1), token.UserID)
+			assert.True(t, token.ExpiresAt.After(time.Now()))
+			storedHash = token.TokenHash
+		}).
+		Return(nil)
+
+	mailSender.EXPECT().
+		Send(mock.Anything, mock.AnythingOfType("mail.Message")).
+		Run(func(ctx context.Context, msg mail.Message) {
+			assert.Equal(t, "test@example.com", msg.To)
+
+			// The mail contains the plain token, only its hash is stored
+			link := msg.Body[strings.Index(msg.Body, "https://hopspot.app/reset?token="):]
+			token, err := url.QueryUnescape(strings.TrimPrefix(strings.Fields(link)[0], "https://hopspot.app/reset?token="))
+			assert.NoError(t, err)
+			assert.Equal(t, storedHash, utils.HashToken(token))
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.ForgotPassword(context.Background(), &requests.ForgotPasswordRequest{Email: "test@example.com"})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestPasswordResetService_ForgotPassword_UnknownEmail(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	resetTokenRepo := mocks.NewPasswordResetTokenRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	mailSender := mocks.NewSender(t)
+	svc := NewPasswordResetService(userRepo, resetTokenRepo, refreshTokenRepo, mailSender, nil, newTestPasswordResetConfig())
+
+	userRepo.EXPECT().FindByEmail(mock.Anything, "nobody@example.com").Return(nil, nil)
+
+	// Act
+	err := svc.ForgotPassword(context.Background(), &requests.ForgotPasswordRequest{Email: "nobody@example.com"})
+
+	// Assert - same response, no token, no mail
+	assert.NoError(t, err)
+}
+
+func TestPasswordResetService_ResetPassword_Success(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	resetTokenRepo := mocks.NewPasswordResetTokenRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewPasswordResetService(userRepo, resetTokenRepo, refreshTokenRepo, nil, tokenVersions, newTestPasswordResetConfig())
+
+	resetToken := &domain.PasswordResetToken{
+		ID:        3,
+		UserID:    1,
+		ExpiresAt: time.Now().Add(time.Hour),
+		User:      domain.User{Model: &gorm.Model{ID: 1}, IsActive: true, PasswordHash: "old"},
+	}
+
+	resetTokenRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("reset-token")).Return(resetToken, nil)
+	resetTokenRepo.EXPECT().MarkAsUsed(mock.Anything, uint(3)).Return(true, nil)
+
+	userRepo.EXPECT().
+		Update(mock.Anything, mock.AnythingOfType("*domain.User")).
+		Run(func(ctx context.Context, user *domain.User) {
+			assert.True(t, utils.CheckPasswordHash("NewPass123!", user.PasswordHash))
+		}).
+		Return(nil)
+
+	refreshTokenRepo.EXPECT().RevokeByUserID(mock.Anything, uint(1)).Return(nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+
+	// Act
+	err := svc.ResetPassword(context.Background(), &requests.ResetPasswordRequest{Token: "reset-token", NewPassword: "NewPass123!"})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestPasswordResetService_ResetPassword_UsedToken(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	resetTokenRepo := mocks.NewPasswordResetTokenRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	svc := NewPasswordResetService(userRepo, resetTokenRepo, refreshTokenRepo, nil, nil, newTestPasswordResetConfig())
+
+	usedAt := time.Now().Add(-time.Minute)
+	resetTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("reset-token")).
+		Return(&domain.PasswordResetToken{
+			ID:        3,
+			ExpiresAt: time.Now().Add(time.Hour),
+			UsedAt:    &usedAt,
+			User:      domain.User{Model: &gorm.Model{ID: 1}, IsActive: true},
+		}, nil)
+
+	// Act
+	err := svc.ResetPassword(context.Background(), &requests.ResetPasswordRequest{Token: "reset-token", NewPassword: "NewPass123!"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidResetToken)
+}
+
+func TestPasswordResetService_ResetPassword_ExpiredToken(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	resetTokenRepo := mocks.NewPasswordResetTokenRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	svc := NewPasswordResetService(userRepo, resetTokenRepo, refreshTokenRepo, nil, nil, newTestPasswordResetConfig())
+
+	resetTokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("reset-token")).
+		Return(&domain.PasswordResetToken{
+			ID:        3,
+			ExpiresAt: time.Now().Add(-time.Minute),
+			User:      domain.User{Model: &gorm.Model{ID: 1}, IsActive: true},
+		}, nil)
+
+	// Act
+	err := svc.ResetPassword(context.Background(), &requests.ResetPasswordRequest{Token: "reset-token", NewPassword: "NewPass123!"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidResetToken)
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/utils"
+)
+
+// personalAccessTokenPrefixLength is the part of a token kept in clear to recognize it, "hsp_" plus 8 characters
+const personalAccessTokenPrefixLength = 12
+
+// lastUsedInterval limits last_used_at writes of tokens used by busy scripts
+const lastUsedInterval = time.Minute
+
+type PersonalAccessTokenService interface {
+	// Create returns the new token, the only time it is shown
+	Create(ctx context.Context, userID uint, req *requests.CreatePersonalAccessTokenRequest) (*responses.CreatedPersonalAccessTokenResponse, error)
+	List(ctx context.Context, userID uint) ([]responses.PersonalAccessTokenResponse, error)
+	Revoke(ctx context.Context, userID, id uint) error
+	// Authenticate returns the token of a request together with its user
+	Authenticate(ctx context.Context, rawToken string) (*domain.PersonalAccessToken, error)
+}
+
+type personalAccessTokenService struct {
+	tokenRepo repository.PersonalAccessTokenRepository
+	userRepo  repository.UserRepository
+}
+
+func NewPersonalAccessTokenService(tokenRepo repository.PersonalAccessTokenRepository, userRepo repository.UserRepository) PersonalAccessTokenService {
+	return &personalAccessTokenService{
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Create implements PersonalAccessTokenService.
+func (s *personalAccessTokenService) Create(ctx context.Context, userID uint, req *requests.CreatePersonalAccessTokenRequest) (*responses.CreatedPersonalAccessTokenResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, apperror.ErrUserNotFound
+	}
+
+	var scopes []domain.Scope
+	for _, scope := range req.Scopes {
+		if !scope.IsValid() {
+			return nil, apperror.ErrInvalidScope
+		}
+		// Only staff gets the admin scope, the routes still check the permissions of the current role
+		if scope == domain.ScopeAdmin && !user.Role.IsStaff() {
+			return nil, apperror.ErrInvalidScope
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	secret, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	rawToken := domain.PersonalAccessTokenPrefix + strings.TrimRight(secret, "=")
+
+	token := &domain.PersonalAccessToken{
+		UserID:      userID,
+		Name:        req.Name,
+		TokenHash:   utils.HashToken(rawToken),
+		TokenPrefix: rawToken[:personalAccessTokenPrefixLength],
+		Scopes:      scopes,
+	}
+	if req.ExpiresInDays != nil {
+		expiresAt := time.Now().AddDate(0, 0, *req.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := s.tokenRepo.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return &responses.CreatedPersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: mapper.PersonalAccessTokenToResponse(token),
+		Token:                       rawToken,
+	}, nil
+}
+
+// List implements PersonalAccessTokenService.
+func (s *personalAccessTokenService) List(ctx context.Context, userID uint) ([]responses.PersonalAccessTokenResponse, error) {
+	tokens, err := s.tokenRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.PersonalAccessTokensToResponse(tokens), nil
+}
+
+// Revoke implements PersonalAccessTokenService.
+func (s *personalAccessTokenService) Revoke(ctx context.Context, userID, id uint) error {
+	deleted, err := s.tokenRepo.DeleteByUser(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return apperror.ErrPersonalAccessTokenNotFound
+	}
+	return nil
+}
+
+// Authenticate implements PersonalAccessTokenService.
+// The user is loaded with every request, so deactivation and role changes apply right away.
+func (s *personalAccessTokenService) Authenticate(ctx context.Context, rawToken string) (*domain.PersonalAccessToken, error) {
+	token, err := s.tokenRepo.FindByTokenHash(ctx, utils.HashToken(rawToken))
+	if err != nil {
+		return nil, err
+	}
+	// A deleted user is not preloaded
+	if token == nil || token.IsExpired() || token.User.Model == nil {
+		return nil, apperror.ErrInvalidToken
+	}
+	if !token.User.IsActive {
+		return nil, apperror.ErrAccountDeactivated
+	}
+
+	if err := s.tokenRepo.UpdateLastUsed(ctx, token.ID, time.Now().Add(-lastUsedInterval)); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
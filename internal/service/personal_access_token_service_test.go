@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestPersonalAccessTokenService_Create_StoresHashOnly(t *testing.T) {
+	// Arrange
+	tokenRepo := mocks.NewPersonalAccessTokenRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewPersonalAccessTokenService(tokenRepo, userRepo)
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.User{Model: &gorm.Model{ID: 1}, Role: domain.RoleUser}, nil)
+
+	var stored *domain.PersonalAccessToken
+	tokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.PersonalAccessToken")).
+		Run(func(ctx context.Context, token *domain.PersonalAccessToken) {
+			token.ID = 5
+			stored = token
+		}).
+		Return(nil)
+
+	days := 30
+	req := &requests.CreatePersonalAccessTokenRequest{
+		Name:          "bench import",
+		Scopes:        []domain.Scope{domain.ScopeSpotsRead, domain.ScopeSpotsWrite, domain.ScopeSpotsRead},
+		ExpiresInDays: &days,
+	}
+
+	// Act
+	result, err := svc.Create(context.Background(), 1, req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), result.ID)
+	assert.True(t, strings.HasPrefix(result.Token, domain.PersonalAccessTokenPrefix))
+	assert.Equal(t, utils.HashToken(result.Token), stored.TokenHash)
+	assert.Equal(t, result.Token[:len(stored.TokenPrefix)], stored.TokenPrefix)
+	assert.Equal(t, []domain.Scope{domain.ScopeSpotsRead, domain.ScopeSpotsWrite}, stored.Scopes)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), *stored.ExpiresAt, time.Minute)
+}
+
+func TestPersonalAccessTokenService_Create_UnknownScope(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewPersonalAccessTokenService(nil, userRepo)
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.User{Model: &gorm.Model{ID: 1}, Role: domain.RoleUser}, nil)
+
+	// Act
+	_, err := svc.Create(context.Background(), 1, &requests.CreatePersonalAccessTokenRequest{
+		Name:   "script",
+		Scopes: []domain.Scope{"spots:delete"},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidScope)
+}
+
+func TestPersonalAccessTokenService_Create_AdminScopeForUser(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewPersonalAccessTokenService(nil, userRepo)
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.User{Model: &gorm.Model{ID: 1}, Role: domain.RoleUser}, nil)
+
+	// Act
+	_, err := svc.Create(context.Background(), 1, &requests.CreatePersonalAccessTokenRequest{
+		Name:   "script",
+		Scopes: []domain.Scope{domain.ScopeAdmin},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidScope)
+}
+
+func TestPersonalAccessTokenService_Authenticate_Success(t *testing.T) {
+	// Arrange
+	tokenRepo := mocks.NewPersonalAccessTokenRepository(t)
+	svc := NewPersonalAccessTokenService(tokenRepo, nil)
+
+	token := &domain.PersonalAccessToken{
+		ID:     5,
+		UserID: 1,
+		Scopes: []domain.Scope{domain.ScopeVisitsWrite},
+		User:   domain.User{Model: &gorm.Model{ID: 1}, IsActive: true},
+	}
+	tokenRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("hsp_secret")).Return(token, nil)
+	tokenRepo.EXPECT().UpdateLastUsed(mock.Anything, uint(5), mock.AnythingOfType("time.Time")).Return(nil)
+
+	// Act
+	result, err := svc.Authenticate(context.Background(), "hsp_secret")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.HasScope(domain.ScopeVisitsWrite))
+	assert.False(t, result.HasScope(domain.ScopeSpotsWrite))
+}
+
+func TestPersonalAccessTokenService_Authenticate_Expired(t *testing.T) {
+	// Arrange
+	tokenRepo := mocks.NewPersonalAccessTokenRepository(t)
+	svc := NewPersonalAccessTokenService(tokenRepo, nil)
+
+	expiredAt := time.Now().Add(-time.Hour)
+	tokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("hsp_secret")).
+		Return(&domain.PersonalAccessToken{ID: 5, UserID: 1, ExpiresAt: &expiredAt, User: domain.User{Model: &gorm.Model{ID: 1}, IsActive: true}}, nil)
+
+	// Act
+	_, err := svc.Authenticate(context.Background(), "hsp_secret")
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidToken)
+}
+
+func TestPersonalAccessTokenService_Authenticate_DeactivatedUser(t *testing.T) {
+	// Arrange
+	tokenRepo := mocks.NewPersonalAccessTokenRepository(t)
+	svc := NewPersonalAccessTokenService(tokenRepo, nil)
+
+	tokenRepo.EXPECT().
+		FindByTokenHash(mock.Anything, utils.HashToken("hsp_secret")).
+		Return(&domain.PersonalAccessToken{ID: 5, UserID: 1, User: domain.User{Model: &gorm.Model{ID: 1}, IsActive: false}}, nil)
+
+	// Act
+	_, err := svc.Authenticate(context.Background(), "hsp_secret")
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrAccountDeactivated)
+}
+
+func TestPersonalAccessTokenService_Revoke_OtherUsersToken(t *testing.T) {
+	// Arrange
+	tokenRepo := mocks.NewPersonalAccessTokenRepository(t)
+	svc := NewPersonalAccessTokenService(tokenRepo, nil)
+
+	tokenRepo.EXPECT().DeleteByUser(mock.Anything, uint(5), uint(2)).Return(false, nil)
+
+	// Act
+	err := svc.Revoke(context.Background(), 2, 5)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrPersonalAccessTokenNotFound)
+}
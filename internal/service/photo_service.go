@@ -24,8 +24,8 @@ const (
 
 type PhotoService interface {
 	Upload(ctx context.Context, spotID uint, userID uint, file *multipart.FileHeader, isMain bool) (*responses.PhotoResponse, error)
-	Delete(ctx context.Context, photoID uint, userID uint, isAdmin bool) error
-	SetMainPhoto(ctx context.Context, photoID uint, userID uint, isAdmin bool) error
+	Delete(ctx context.Context, photoID uint, principal domain.Principal) error
+	SetMainPhoto(ctx context.Context, photoID uint, principal domain.Principal) error
 	GetBySpotID(ctx context.Context, spotID uint) ([]responses.PhotoResponse, error)
 	GetPresignedURL(ctx context.Context, photoID uint, size string) (string, error)
 }
@@ -34,13 +34,15 @@ type photoService struct {
 	photoRepo   repository.PhotoRepository
 	spotRepo    repository.SpotRepository
 	minioClient *storage.MinioClient
+	audit       AuditService
 }
 
-func NewPhotoService(photoRepo repository.PhotoRepository, spotRepo repository.SpotRepository, minioClient *storage.MinioClient) PhotoService {
+func NewPhotoService(photoRepo repository.PhotoRepository, spotRepo repository.SpotRepository, minioClient *storage.MinioClient, audit AuditService) PhotoService {
 	return &photoService{
 		photoRepo:   photoRepo,
 		spotRepo:    spotRepo,
 		minioClient: minioClient,
+		audit:       audit,
 	}
 }
 
@@ -157,7 +159,7 @@ func (s *photoService) Upload(ctx context.Context, spotID uint, userID uint, fil
 }
 
 // Delete implements PhotoService.
-func (s *photoService) Delete(ctx context.Context, photoID uint, userID uint, isAdmin bool) error {
+func (s *photoService) Delete(ctx context.Context, photoID uint, principal domain.Principal) error {
 	// Fetch the photo
 	photo, err := s.photoRepo.FindByID(ctx, photoID)
 	if err != nil {
@@ -167,8 +169,8 @@ func (s *photoService) Delete(ctx context.Context, photoID uint, userID uint, is
 		return apperror.ErrPhotoNotFound
 	}
 
-	// Authorization check: only admin or uploader can delete
-	if photo.UploadedBy != userID && !isAdmin {
+	// Authorization check: uploader or moderation
+	if !principal.CanActOn(photo.UploadedBy, domain.PermissionPhotoDeleteAny) {
 		return apperror.ErrForbidden
 	}
 
@@ -194,6 +196,17 @@ func (s *photoService) Delete(ctx context.Context, photoID uint, userID uint, is
 		return err
 	}
 
+	// Only moderation of foreign photos is audited
+	if photo.UploadedBy != principal.UserID {
+		s.audit.Record(ctx, newAuditEvent(principal.UserID, domain.AuditActionPhotoDeleted, domain.AuditTargetPhoto, photo.ID,
+			domain.AuditDiff(map[string]any{
+				"spot_id":     photo.SpotID,
+				"uploaded_by": photo.UploadedBy,
+				"is_main":     photo.IsMain,
+				"file_path":   photo.FilePathOriginal,
+			}, nil)))
+	}
+
 	// If it was the main photo, set another as main
 	if photo.IsMain {
 		photos, err := s.photoRepo.FindBySpotID(ctx, photo.SpotID)
@@ -208,7 +221,7 @@ func (s *photoService) Delete(ctx context.Context, photoID uint, userID uint, is
 }
 
 // SetMainPhoto implements PhotoService.
-func (s *photoService) SetMainPhoto(ctx context.Context, photoID uint, userID uint, isAdmin bool) error {
+func (s *photoService) SetMainPhoto(ctx context.Context, photoID uint, principal domain.Principal) error {
 	// Fetch the photo
 	photo, err := s.photoRepo.FindByID(ctx, photoID)
 	if err != nil {
@@ -227,8 +240,8 @@ func (s *photoService) SetMainPhoto(ctx context.Context, photoID uint, userID ui
 		return apperror.ErrSpotNotFound
 	}
 
-	// Authorization check: only admin or spot owner can set main photo
-	if spot.CreatedBy != userID && !isAdmin {
+	// Authorization check: spot owner or moderation
+	if !principal.CanActOn(spot.CreatedBy, domain.PermissionPhotoUpdateAny) {
 		return apperror.ErrForbidden
 	}
 
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/mapper"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+)
+
+type ReviewService interface {
+	Create(ctx context.Context, spotID uint, req *requests.CreateReviewRequest, userID uint) (*responses.ReviewResponse, error)
+	List(ctx context.Context, spotID uint, req *requests.ListReviewsRequest) (*responses.PaginatedReviewsResponse, error)
+	Update(ctx context.Context, spotID, reviewID uint, req *requests.UpdateReviewRequest, principal domain.Principal) (*responses.ReviewResponse, error)
+	Delete(ctx context.Context, spotID, reviewID uint, principal domain.Principal) error
+}
+
+type reviewService struct {
+	reviewRepo repository.SpotReviewRepository
+	spotRepo   repository.SpotRepository
+}
+
+func NewReviewService(reviewRepo repository.SpotReviewRepository, spotRepo repository.SpotRepository) ReviewService {
+	return &reviewService{
+		reviewRepo: reviewRepo,
+		spotRepo:   spotRepo,
+	}
+}
+
+// Create implements ReviewService.
+func (s *reviewService) Create(ctx context.Context, spotID uint, req *requests.CreateReviewRequest, userID uint) (*responses.ReviewResponse, error) {
+	// Check if the referenced spot exists
+	spot, err := s.spotRepo.FindByID(ctx, spotID)
+	if err != nil {
+		return nil, err
+	}
+	if spot == nil {
+		return nil, apperror.ErrSpotNotFound
+	}
+
+	// One review per user and spot
+	existing, err := s.reviewRepo.FindByUserAndSpot(ctx, userID, spotID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, apperror.ErrReviewAlreadyExists
+	}
+
+	review := mapper.CreateReviewRequestToDomain(req, spotID, userID)
+	created, err := s.reviewRepo.Create(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	// A parallel request created the review after the check
+	if !created {
+		return nil, apperror.ErrReviewAlreadyExists
+	}
+
+	// Reload with User
+	review, err = s.reviewRepo.FindByID(ctx, review.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := mapper.ReviewToResponse(review)
+	return &response, nil
+}
+
+// List implements ReviewService.
+func (s *reviewService) List(ctx context.Context, spotID uint, req *requests.ListReviewsRequest) (*responses.PaginatedReviewsResponse, error) {
+	spot, err := s.spotRepo.FindByID(ctx, spotID)
+	if err != nil {
+		return nil, err
+	}
+	if spot == nil {
+		return nil, apperror.ErrSpotNotFound
+	}
+
+	filter := repository.ReviewFilter{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+
+	reviews, total, err := s.reviewRepo.FindBySpotID(ctx, spotID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pagination calculation
+	totalPages := int(total) / req.Limit
+	if int(total)%req.Limit > 0 {
+		totalPages++
+	}
+
+	return &responses.PaginatedReviewsResponse{
+		Reviews: mapper.ReviewsToResponse(reviews),
+		Pagination: responses.PaginationResponse{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// Update implements ReviewService.
+func (s *reviewService) Update(ctx context.Context, spotID, reviewID uint, req *requests.UpdateReviewRequest, principal domain.Principal) (*responses.ReviewResponse, error) {
+	review, err := s.reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review == nil || review.SpotID != spotID {
+		return nil, apperror.ErrReviewNotFound
+	}
+
+	// Check permissions
+	if !principal.CanActOn(review.UserID, domain.PermissionReviewUpdateAny) {
+		return nil, apperror.ErrForbidden
+	}
+
+	if req.Stars != nil {
+		review.Stars = *req.Stars
+	}
+	if req.Comment != nil {
+		review.Comment = *req.Comment
+	}
+
+	if err := s.reviewRepo.Update(ctx, review); err != nil {
+		return nil, err
+	}
+
+	response := mapper.ReviewToResponse(review)
+	return &response, nil
+}
+
+// Delete implements ReviewService.
+func (s *reviewService) Delete(ctx context.Context, spotID, reviewID uint, principal domain.Principal) error {
+	review, err := s.reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if review == nil || review.SpotID != spotID {
+		return apperror.ErrReviewNotFound
+	}
+
+	// Check permissions
+	if !principal.CanActOn(review.UserID, domain.PermissionReviewDeleteAny) {
+		return apperror.ErrForbidden
+	}
+
+	return s.reviewRepo.Delete(ctx, reviewID)
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestReviewService_Create_Success(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewReviewService(reviewRepo, spotRepo)
+
+	comment := "Great view"
+	req := &requests.CreateReviewRequest{
+		Stars:   4,
+		Comment: &comment,
+	}
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.Spot{ID: 1, Name: "Test Spot"}, nil)
+
+	reviewRepo.EXPECT().
+		FindByUserAndSpot(mock.Anything, uint(2), uint(1)).
+		Return(nil, nil)
+
+	reviewRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.SpotReview")).
+		Run(func(ctx context.Context, review *domain.SpotReview) {
+			assert.Equal(t, uint(1), review.SpotID)
+			assert.Equal(t, uint(2), review.UserID)
+			assert.Equal(t, 4, review.Stars)
+			review.ID = 10
+		}).
+		Return(true, nil)
+
+	// Reload with User
+	reviewRepo.EXPECT().
+		FindByID(mock.Anything, uint(10)).
+		Return(&domain.SpotReview{
+			ID:      10,
+			SpotID:  1,
+			UserID:  2,
+			Stars:   4,
+			Comment: comment,
+			User: domain.User{
+				Model:       &gorm.Model{ID: 2},
+				DisplayName: "Reviewer",
+			},
+		}, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), uint(1), req, uint(2))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 4, result.Stars)
+	assert.Equal(t, "Great view", result.Comment)
+	assert.Equal(t, "Reviewer", result.User.DisplayName)
+}
+
+func TestReviewService_Create_AlreadyReviewed(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewReviewService(reviewRepo, spotRepo)
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.Spot{ID: 1}, nil)
+
+	reviewRepo.EXPECT().
+		FindByUserAndSpot(mock.Anything, uint(2), uint(1)).
+		Return(&domain.SpotReview{ID: 5, SpotID: 1, UserID: 2, Stars: 3}, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), uint(1), &requests.CreateReviewRequest{Stars: 5}, uint(2))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrReviewAlreadyExists)
+	assert.Nil(t, result)
+}
+
+func TestReviewService_Create_ParallelReview(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewReviewService(reviewRepo, spotRepo)
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.Spot{ID: 1}, nil)
+
+	reviewRepo.EXPECT().
+		FindByUserAndSpot(mock.Anything, uint(2), uint(1)).
+		Return(nil, nil)
+
+	// The other request inserted between the check and the insert
+	reviewRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.SpotReview")).
+		Return(false, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), uint(1), &requests.CreateReviewRequest{Stars: 5}, uint(2))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrReviewAlreadyExists)
+	assert.Nil(t, result)
+}
+
+func TestReviewService_Create_SpotNotFound(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewReviewService(reviewRepo, spotRepo)
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(999)).
+		Return(nil, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), uint(999), &requests.CreateReviewRequest{Stars: 5}, uint(2))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrSpotNotFound)
+	assert.Nil(t, result)
+}
+
+func TestReviewService_Update_Forbidden(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewReviewService(reviewRepo, spotRepo)
+
+	stars := 1
+	reviewRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.SpotReview{ID: 5, SpotID: 1, UserID: 2, Stars: 4}, nil)
+
+	// Act - user 3 tries to change user 2's review
+	result, err := svc.Update(context.Background(), uint(1), uint(5), &requests.UpdateReviewRequest{Stars: &stars}, domain.Principal{UserID: 3, Role: domain.RoleUser})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrForbidden)
+	assert.Nil(t, result)
+}
+
+func TestReviewService_Update_WrongSpot(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewReviewService(reviewRepo, spotRepo)
+
+	stars := 1
+	reviewRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.SpotReview{ID: 5, SpotID: 7, UserID: 2, Stars: 4}, nil)
+
+	// Act - review 5 does not belong to spot 1
+	result, err := svc.Update(context.Background(), uint(1), uint(5), &requests.UpdateReviewRequest{Stars: &stars}, domain.Principal{UserID: 2, Role: domain.RoleUser})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrReviewNotFound)
+	assert.Nil(t, result)
+}
+
+func TestReviewService_Delete_AsAdmin(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewReviewService(reviewRepo, spotRepo)
+
+	reviewRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.SpotReview{ID: 5, SpotID: 1, UserID: 2, Stars: 4}, nil)
+
+	reviewRepo.EXPECT().
+		Delete(mock.Anything, uint(5)).
+		Return(nil)
+
+	// Act - admin deletes someone else's review
+	err := svc.Delete(context.Background(), uint(1), uint(5), domain.Principal{UserID: 99, Role: domain.RoleAdmin})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestReviewService_Delete_AsModerator(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	svc := NewReviewService(reviewRepo, nil)
+
+	reviewRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.SpotReview{ID: 5, SpotID: 1, UserID: 2, Stars: 1}, nil)
+	reviewRepo.EXPECT().Delete(mock.Anything, uint(5)).Return(nil)
+
+	// Act - moderators clean up reviews of others
+	err := svc.Delete(context.Background(), uint(1), uint(5), domain.Principal{UserID: 99, Role: domain.RoleModerator})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestReviewService_Update_AsModeratorForbidden(t *testing.T) {
+	// Arrange
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	svc := NewReviewService(reviewRepo, nil)
+
+	stars := 5
+	reviewRepo.EXPECT().
+		FindByID(mock.Anything, uint(5)).
+		Return(&domain.SpotReview{ID: 5, SpotID: 1, UserID: 2, Stars: 1}, nil)
+
+	// Act - moderators can remove reviews, but not put words in the author's mouth
+	result, err := svc.Update(context.Background(), uint(1), uint(5), &requests.UpdateReviewRequest{Stars: &stars}, domain.Principal{UserID: 99, Role: domain.RoleModerator})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrForbidden)
+	assert.Nil(t, result)
+}
@@ -2,8 +2,16 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"math"
-	"sort"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
@@ -11,6 +19,7 @@ import (
 	"hopSpotAPI/internal/mapper"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/geoformat"
 	"hopSpotAPI/pkg/logger"
 	"hopSpotAPI/pkg/storage"
 	"hopSpotAPI/pkg/utils"
@@ -21,10 +30,25 @@ type SpotService interface {
 	GetByID(ctx context.Context, id uint) (*responses.SpotResponse, error)
 	GetRandom(ctx context.Context) (*responses.SpotResponse, error)
 	List(ctx context.Context, req *requests.ListSpotsRequest) (*responses.PaginatedSpotsResponse, error)
-	Update(ctx context.Context, id uint, req *requests.UpdateSpotRequest, userID uint, isAdmin bool) (*responses.SpotResponse, error)
-	Delete(ctx context.Context, id uint, userID uint, isAdmin bool) error
+	ListNearest(ctx context.Context, req *requests.NearestSpotsRequest) ([]responses.SpotListResponse, error)
+	GetMap(ctx context.Context, req *requests.MapSpotsRequest) (*responses.SpotMapResponse, error)
+	Export(ctx context.Context, req *requests.ExportSpotsRequest, w io.Writer) error
+	Import(ctx context.Context, data []byte, userID uint) (*responses.ImportSpotsResponse, error)
+	Update(ctx context.Context, id uint, req *requests.UpdateSpotRequest, principal domain.Principal) (*responses.SpotResponse, error)
+	Delete(ctx context.Context, id uint, principal domain.Principal) error
+	Merge(ctx context.Context, req *requests.MergeSpotsRequest, userID uint) (*responses.SpotResponse, error)
 }
 
+const (
+	// Up to this zoom level the map gets clusters instead of single markers
+	mapClusterMaxZoom = 13
+	// Upper bound for markers in one viewport
+	mapMaxMarkers = 2000
+
+	// Spots loaded per query while exporting
+	exportBatchSize = 500
+)
+
 type spotService struct {
 	spotRepo            repository.SpotRepository
 	photoRepo           repository.PhotoRepository
@@ -32,9 +56,13 @@ type spotService struct {
 	favoriteRepo        repository.FavoriteRepository
 	activityRepo        repository.ActivityRepository
 	notificationRepo    repository.NotificationRepository
+	reviewRepo          repository.SpotReviewRepository
+	amenityRepo         repository.AmenityRepository
 	minioClient         *storage.MinioClient
 	notificationService NotificationService
 	activityService     ActivityService
+	audit               AuditService
+	duplicateRadius     float64 // meters
 }
 
 func NewSpotService(
@@ -44,9 +72,13 @@ func NewSpotService(
 	favoriteRepo repository.FavoriteRepository,
 	activityRepo repository.ActivityRepository,
 	notificationRepo repository.NotificationRepository,
+	reviewRepo repository.SpotReviewRepository,
+	amenityRepo repository.AmenityRepository,
 	minioClient *storage.MinioClient,
 	notificationService NotificationService,
 	activityService ActivityService,
+	audit AuditService,
+	duplicateRadius float64,
 ) SpotService {
 	return &spotService{
 		spotRepo:            spotRepo,
@@ -55,27 +87,81 @@ func NewSpotService(
 		favoriteRepo:        favoriteRepo,
 		activityRepo:        activityRepo,
 		notificationRepo:    notificationRepo,
+		reviewRepo:          reviewRepo,
+		amenityRepo:         amenityRepo,
 		minioClient:         minioClient,
 		notificationService: notificationService,
 		activityService:     activityService,
+		audit:               audit,
+		duplicateRadius:     duplicateRadius,
 	}
 }
 
 // Create implements SpotService.
 func (s *spotService) Create(ctx context.Context, req *requests.CreateSpotRequest, userID uint) (*responses.SpotResponse, error) {
+	if !req.Force {
+		if err := s.checkDuplicates(ctx, req.Latitude, req.Longitude, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	spot, err := s.create(ctx, req, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	response := mapper.SpotToResponse(spot)
+	return &response, nil
+}
+
+// create stores a spot with the creator's initial review.
+// announce=false skips push notification and activity (bulk import).
+func (s *spotService) create(ctx context.Context, req *requests.CreateSpotRequest, userID uint, announce bool) (*domain.Spot, error) {
 	spot := mapper.CreateSpotRequestToDomain(req)
 	spot.CreatedBy = userID
 
+	// Legacy flags are stored as amenities
+	keys := req.Amenities
+	if req.HasToilet {
+		keys = append(keys, domain.AmenityKeyToilet)
+	}
+	if req.HasTrashBin {
+		keys = append(keys, domain.AmenityKeyTrashBin)
+	}
+
+	amenities, err := s.resolveAmenities(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	spot.Amenities = amenities
+
 	if err := s.spotRepo.Create(ctx, spot); err != nil {
 		return nil, err
 	}
 
+	// Initial rating becomes the creator's review
+	if req.Rating != nil {
+		review := &domain.SpotReview{
+			SpotID: spot.ID,
+			UserID: userID,
+			Stars:  *req.Rating,
+		}
+		// The spot is new, so there can't be a review of the user yet
+		if _, err := s.reviewRepo.Create(ctx, review); err != nil {
+			return nil, err
+		}
+	}
+
 	// Reload mit Creator
-	spot, err := s.spotRepo.FindByID(ctx, spot.ID)
+	spot, err = s.spotRepo.FindByID(ctx, spot.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	if !announce {
+		return spot, nil
+	}
+
 	// Notify about new Spot (async)
 	go func() {
 		if err := s.notificationService.NotifyNewSpot(context.Background(), spot, userID); err != nil {
@@ -93,8 +179,7 @@ func (s *spotService) Create(ctx context.Context, req *requests.CreateSpotReques
 		}
 	}()
 
-	response := mapper.SpotToResponse(spot)
-	return &response, nil
+	return spot, nil
 }
 
 // GetByID implements SpotService.
@@ -140,86 +225,372 @@ func (s *spotService) GetRandom(ctx context.Context) (*responses.SpotResponse, e
 }
 
 func (s *spotService) List(ctx context.Context, req *requests.ListSpotsRequest) (*responses.PaginatedSpotsResponse, error) {
-	// Define Filter
-	filter := repository.SpotFilter{
-		Page:        req.Page,
-		Limit:       req.Limit,
-		SortBy:      req.SortBy,
-		SortOrder:   req.SortOrder,
-		HasToilet:   req.HasToilet,
-		HasTrashBin: req.HasTrashBin,
-		MinRating:   req.MinRating,
-		Search:      req.Search,
-		Lat:         req.Lat,
-		Lon:         req.Lon,
-		Radius:      req.Radius,
+	filter, err := newSpotFilter(req)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.Lat != nil && req.Lon != nil {
-		if err := utils.ValidateCoordinates(*req.Lat, *req.Lon); err != nil {
+	// Load Spots from Repo
+	spots, total, err := s.spotRepo.FindAll(ctx, *filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Radius filter, distance and distance sorting are done by the database
+	spotResponses := make([]responses.SpotListResponse, len(spots))
+
+	for i, spot := range spots {
+		spotResponses[i] = mapper.SpotToListResponse(&spot)
+
+		mainPhotoURL, err := s.getMainPhotoURL(ctx, spot.ID)
+		if err != nil {
+			logger.Warn().Err(err).Uint("spotID", spot.ID).Msg("failed to get main photo URL")
+		}
+
+		spotResponses[i].MainPhotoURL = mainPhotoURL
+		spotResponses[i].Distance = spot.Distance
+	}
+
+	response := &responses.PaginatedSpotsResponse{
+		Spots: spotResponses,
+		Pagination: responses.PaginationResponse{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      total,
+			TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+		},
+	}
+
+	// A full page means there may be more - hand out a cursor for the next one
+	if len(spots) > 0 && len(spots) == req.Limit {
+		nextCursor, err := encodeSpotCursor(&spots[len(spots)-1], req.SortBy)
+		if err != nil {
 			return nil, err
 		}
+		response.NextCursor = &nextCursor
 	}
 
-	// Load Spots from Repo
-	spots, _, err := s.spotRepo.FindAll(ctx, filter)
+	return response, nil
+}
+
+// ListNearest implements SpotService.
+func (s *spotService) ListNearest(ctx context.Context, req *requests.NearestSpotsRequest) ([]responses.SpotListResponse, error) {
+	spots, err := s.spotRepo.FindNearest(ctx, *req.Lat, *req.Lon, req.Limit)
 	if err != nil {
 		return nil, err
 	}
 
-	// If coordinates are given: calculate distance + filter by radius
-	spotResponses := make([]responses.SpotListResponse, 0, len(spots))
-
-	for _, spot := range spots {
-		resp := mapper.SpotToListResponse(&spot)
+	spotResponses := make([]responses.SpotListResponse, len(spots))
+	for i, spot := range spots {
+		spotResponses[i] = mapper.SpotToListResponse(&spot)
 
 		mainPhotoURL, err := s.getMainPhotoURL(ctx, spot.ID)
 		if err != nil {
 			logger.Warn().Err(err).Uint("spotID", spot.ID).Msg("failed to get main photo URL")
 		}
 
-		resp.MainPhotoURL = mainPhotoURL
+		spotResponses[i].MainPhotoURL = mainPhotoURL
+		spotResponses[i].Distance = spot.Distance
+	}
+
+	return spotResponses, nil
+}
+
+// GetMap implements SpotService.
+func (s *spotService) GetMap(ctx context.Context, req *requests.MapSpotsRequest) (*responses.SpotMapResponse, error) {
+	bounds, err := parseBoundingBox(req.BBox)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &responses.SpotMapResponse{Zoom: req.Zoom}
 
-		if req.Lat != nil && req.Lon != nil {
-			distance := utils.DistanceMeters(*req.Lat, *req.Lon, spot.Latitude, spot.Longitude)
+	if req.Zoom <= mapClusterMaxZoom {
+		// Grid cells of roughly a quarter tile at this zoom level
+		cellSize := 360.0 / math.Pow(2, float64(req.Zoom)) / 4
 
-			// Filter by Radius
-			if req.Radius != nil && distance > float64(*req.Radius) {
-				continue // Skip Spots outside the radius
+		clusters, err := s.spotRepo.FindClustersInBounds(ctx, *bounds, cellSize)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Mode = "clusters"
+		response.Clusters = make([]responses.SpotClusterResponse, len(clusters))
+		for i, cluster := range clusters {
+			response.Clusters[i] = responses.SpotClusterResponse{
+				Count:     cluster.Count,
+				Latitude:  cluster.Latitude,
+				Longitude: cluster.Longitude,
+				SpotID:    cluster.SpotID,
 			}
+		}
 
-			resp.Distance = &distance
+		return response, nil
+	}
+
+	markers, err := s.spotRepo.FindMarkersInBounds(ctx, *bounds, mapMaxMarkers)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Mode = "markers"
+	response.Truncated = len(markers) == mapMaxMarkers
+	response.Markers = make([]responses.SpotMarkerResponse, len(markers))
+	for i, marker := range markers {
+		response.Markers[i] = responses.SpotMarkerResponse{
+			ID:            marker.ID,
+			Latitude:      marker.Latitude,
+			Longitude:     marker.Longitude,
+			AverageRating: marker.AverageRating,
+		}
+		if marker.ThumbnailPath != nil {
+			url := s.minioClient.GetPublicURL(*marker.ThumbnailPath)
+			response.Markers[i].ThumbnailURL = &url
 		}
+	}
+
+	return response, nil
+}
+
+// Export implements SpotService.
+func (s *spotService) Export(ctx context.Context, req *requests.ExportSpotsRequest, w io.Writer) error {
+	filter, err := newSpotFilter(&req.ListSpotsRequest)
+	if err != nil {
+		return err
+	}
 
-		spotResponses = append(spotResponses, resp)
+	// Page through everything with the keyset cursor
+	filter.Page = 1
+	filter.Limit = exportBatchSize
+
+	spots, _, err := s.spotRepo.FindAll(ctx, *filter)
+	if err != nil {
+		return err
+	}
+
+	encoder, err := geoformat.NewEncoder(req.Format, w)
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.Begin(); err != nil {
+		return err
 	}
 
-	// Order by Distance if requested
-	if req.SortBy == "distance" && req.Lat != nil && req.Lon != nil {
-		sort.Slice(spotResponses, func(i, j int) bool {
-			if req.SortOrder == "desc" {
-				return *spotResponses[i].Distance > *spotResponses[j].Distance
+	for {
+		for i := range spots {
+			if err := encoder.Encode(spotToFeature(&spots[i])); err != nil {
+				return err
 			}
-			return *spotResponses[i].Distance < *spotResponses[j].Distance
-		})
+		}
+
+		if len(spots) < exportBatchSize {
+			break
+		}
+
+		last := &spots[len(spots)-1]
+		filter.Cursor = &repository.SpotCursor{ID: last.ID, Value: spotSortValue(last, req.SortBy)}
+
+		spots, _, err = s.spotRepo.FindAll(ctx, *filter)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Pagination
-	filteredTotal := int64(len(spotResponses))
+	return encoder.End()
+}
 
-	return &responses.PaginatedSpotsResponse{
-		Spots: spotResponses,
-		Pagination: responses.PaginationResponse{
-			Page:       req.Page,
-			Limit:      req.Limit,
-			Total:      filteredTotal,
-			TotalPages: int(math.Ceil(float64(filteredTotal) / float64(req.Limit))),
-		},
-	}, nil
+// spotToFeature maps a spot to an export feature
+func spotToFeature(spot *domain.Spot) geoformat.Feature {
+	properties := map[string]interface{}{
+		"id":            spot.ID,
+		"review_count":  spot.ReviewCount,
+		"amenities":     spot.AmenityKeys(),
+		"has_toilet":    spot.HasAmenity(domain.AmenityKeyToilet),
+		"has_trash_bin": spot.HasAmenity(domain.AmenityKeyTrashBin),
+	}
+	if spot.AverageRating != nil {
+		properties["average_rating"] = *spot.AverageRating
+		properties["rating"] = *mapper.RoundRating(spot.AverageRating)
+	}
+
+	return geoformat.Feature{
+		Name:        spot.Name,
+		Description: spot.Description,
+		Latitude:    spot.Latitude,
+		Longitude:   spot.Longitude,
+		Properties:  properties,
+	}
+}
+
+// Import implements SpotService.
+func (s *spotService) Import(ctx context.Context, data []byte, userID uint) (*responses.ImportSpotsResponse, error) {
+	features, format, err := geoformat.Decode(data)
+	if err != nil {
+		return nil, apperror.ErrInvalidImportFile
+	}
+
+	report := &responses.ImportSpotsResponse{
+		Format:  format,
+		Total:   len(features),
+		Results: make([]responses.ImportFeatureResult, len(features)),
+	}
+
+	for i, feature := range features {
+		result := s.importFeature(ctx, feature, userID)
+		result.Index = i
+		report.Results[i] = result
+
+		switch result.Status {
+		case responses.ImportStatusCreated:
+			report.Created++
+		case responses.ImportStatusDuplicate:
+			report.Duplicates++
+		case responses.ImportStatusInvalid:
+			report.Invalid++
+		default:
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+// importFeature validates one feature, checks for duplicates and creates the spot
+func (s *spotService) importFeature(ctx context.Context, feature geoformat.Feature, userID uint) responses.ImportFeatureResult {
+	result := responses.ImportFeatureResult{Name: feature.Name}
+
+	invalid := func(message string) responses.ImportFeatureResult {
+		result.Status = responses.ImportStatusInvalid
+		result.Message = message
+		return result
+	}
+
+	// Same rules as CreateSpotRequest
+	name := strings.TrimSpace(feature.Name)
+	if feature.GeometryType != "Point" {
+		return invalid("only point geometries are supported")
+	}
+	if name == "" || len(name) > 255 {
+		return invalid("name is required and must be at most 255 characters")
+	}
+	if err := utils.ValidateCoordinates(feature.Latitude, feature.Longitude); err != nil {
+		return invalid(err.Error())
+	}
+	if len(feature.Description) > 5000 {
+		return invalid("description must be at most 5000 characters")
+	}
+
+	req := &requests.CreateSpotRequest{
+		Name:      name,
+		Latitude:  feature.Latitude,
+		Longitude: feature.Longitude,
+	}
+	if feature.Description != "" {
+		description := feature.Description
+		req.Description = &description
+	}
+	if amenities, ok := feature.Strings("amenities"); ok {
+		req.Amenities = amenities
+	}
+	if hasToilet, ok := feature.Bool("has_toilet"); ok {
+		req.HasToilet = hasToilet
+	}
+	if hasTrashBin, ok := feature.Bool("has_trash_bin"); ok {
+		req.HasTrashBin = hasTrashBin
+	}
+
+	// Own exports carry the average, other tools a plain rating
+	rating, ok := feature.Float("rating")
+	if !ok {
+		rating, ok = feature.Float("average_rating")
+	}
+	if ok {
+		stars := int(math.Round(rating))
+		if stars < 1 || stars > 5 {
+			return invalid("rating must be between 1 and 5")
+		}
+		req.Rating = &stars
+	}
+
+	// Duplicate by name
+	existing, err := s.spotRepo.FindByName(ctx, name)
+	if err != nil {
+		result.Status = responses.ImportStatusFailed
+		result.Message = err.Error()
+		return result
+	}
+	if existing == nil {
+		// Duplicate by proximity
+		nearest, err := s.spotRepo.FindNearest(ctx, req.Latitude, req.Longitude, 1)
+		if err != nil {
+			result.Status = responses.ImportStatusFailed
+			result.Message = err.Error()
+			return result
+		}
+		if len(nearest) > 0 && nearest[0].Distance != nil && *nearest[0].Distance <= s.duplicateRadius {
+			existing = &nearest[0]
+		}
+	}
+	if existing != nil {
+		duplicateOf := existing.ID
+		result.Status = responses.ImportStatusDuplicate
+		result.DuplicateOf = &duplicateOf
+		result.Message = fmt.Sprintf("duplicate of spot %q", existing.Name)
+		return result
+	}
+
+	// No notification or activity per imported spot
+	spot, err := s.create(ctx, req, userID, false)
+	if errors.Is(err, apperror.ErrAmenityUnknown) {
+		return invalid(err.Error())
+	}
+	if err != nil {
+		result.Status = responses.ImportStatusFailed
+		result.Message = err.Error()
+		return result
+	}
+
+	spotID := spot.ID
+	result.Status = responses.ImportStatusCreated
+	result.SpotID = &spotID
+	return result
+}
+
+// parseBoundingBox parses "minLon,minLat,maxLon,maxLat"
+func parseBoundingBox(bbox string) (*repository.BoundingBox, error) {
+	parts := strings.Split(bbox, ",")
+	if len(parts) != 4 {
+		return nil, apperror.ErrInvalidBoundingBox
+	}
+
+	values := make([]float64, len(parts))
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, apperror.ErrInvalidBoundingBox
+		}
+		values[i] = value
+	}
+
+	bounds := &repository.BoundingBox{
+		MinLon: values[0],
+		MinLat: values[1],
+		MaxLon: values[2],
+		MaxLat: values[3],
+	}
+
+	if utils.ValidateCoordinates(bounds.MinLat, bounds.MinLon) != nil ||
+		utils.ValidateCoordinates(bounds.MaxLat, bounds.MaxLon) != nil ||
+		bounds.MinLon > bounds.MaxLon || bounds.MinLat > bounds.MaxLat {
+		return nil, apperror.ErrInvalidBoundingBox
+	}
+
+	return bounds, nil
 }
 
 // Update implements SpotService.
-func (s *spotService) Update(ctx context.Context, id uint, req *requests.UpdateSpotRequest, userID uint, isAdmin bool) (*responses.SpotResponse, error) {
+func (s *spotService) Update(ctx context.Context, id uint, req *requests.UpdateSpotRequest, principal domain.Principal) (*responses.SpotResponse, error) {
 	// Get existing spot
 	spot, err := s.spotRepo.FindByID(ctx, id)
 	if err != nil {
@@ -230,10 +601,15 @@ func (s *spotService) Update(ctx context.Context, id uint, req *requests.UpdateS
 	}
 
 	// Check permissions
-	if spot.CreatedBy != userID && !isAdmin {
+	if !principal.CanActOn(spot.CreatedBy, domain.PermissionSpotUpdateAny) {
 		return nil, apperror.ErrForbidden
 	}
 
+	// Moving a spot must not put it on top of another one
+	moved := (req.Latitude != nil && *req.Latitude != spot.Latitude) ||
+		(req.Longitude != nil && *req.Longitude != spot.Longitude)
+	before := auditSpotFields(spot)
+
 	// Prepare fields to update
 	if req.Name != nil {
 		spot.Name = *req.Name
@@ -247,14 +623,28 @@ func (s *spotService) Update(ctx context.Context, id uint, req *requests.UpdateS
 	if req.Description != nil {
 		spot.Description = *req.Description
 	}
-	if req.Rating != nil {
-		spot.Rating = req.Rating
-	}
-	if req.HasToilet != nil {
-		spot.HasToilet = *req.HasToilet
+
+	// Amenities are only touched if requested - legacy flags toggle single keys
+	updateAmenities := req.Amenities != nil || req.HasToilet != nil || req.HasTrashBin != nil
+	var amenities []domain.Amenity
+	if updateAmenities {
+		keys := req.Amenities
+		if keys == nil {
+			keys = spot.AmenityKeys()
+		}
+		keys = toggleAmenityKey(keys, domain.AmenityKeyToilet, req.HasToilet)
+		keys = toggleAmenityKey(keys, domain.AmenityKeyTrashBin, req.HasTrashBin)
+
+		amenities, err = s.resolveAmenities(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if req.HasTrashBin != nil {
-		spot.HasTrashBin = *req.HasTrashBin
+
+	if moved && !req.Force {
+		if err := s.checkDuplicates(ctx, spot.Latitude, spot.Longitude, spot.ID); err != nil {
+			return nil, err
+		}
 	}
 
 	// Update spot
@@ -262,6 +652,20 @@ func (s *spotService) Update(ctx context.Context, id uint, req *requests.UpdateS
 		return nil, err
 	}
 
+	if updateAmenities {
+		if err := s.spotRepo.ReplaceAmenities(ctx, spot.ID, amenities); err != nil {
+			return nil, err
+		}
+		spot.Amenities = amenities
+	}
+
+	// Owners editing their own spot are not audited, moderation of foreign spots is
+	if spot.CreatedBy != principal.UserID {
+		if changes := domain.AuditDiff(before, auditSpotFields(spot)); len(changes) > 0 {
+			s.audit.Record(ctx, newAuditEvent(principal.UserID, domain.AuditActionSpotUpdated, domain.AuditTargetSpot, spot.ID, changes))
+		}
+	}
+
 	response := mapper.SpotToResponse(spot)
 
 	// Get main photo URL
@@ -276,7 +680,7 @@ func (s *spotService) Update(ctx context.Context, id uint, req *requests.UpdateS
 }
 
 // Delete implements SpotService.
-func (s *spotService) Delete(ctx context.Context, id uint, userID uint, isAdmin bool) error {
+func (s *spotService) Delete(ctx context.Context, id uint, principal domain.Principal) error {
 	// Get existing spot
 	spot, err := s.spotRepo.FindByID(ctx, id)
 	if err != nil {
@@ -287,11 +691,11 @@ func (s *spotService) Delete(ctx context.Context, id uint, userID uint, isAdmin
 	}
 
 	// Check permissions
-	if spot.CreatedBy != userID && !isAdmin {
+	if !principal.CanActOn(spot.CreatedBy, domain.PermissionSpotDeleteAny) {
 		return apperror.ErrForbidden
 	}
 
-	// Delete dependent records in order: notifications, favorites, visits, activities, photos
+	// Delete dependent records in order: notifications, favorites, reviews, visits, activities, photos
 	// Errors are logged but don't stop the deletion process
 
 	// 1. Delete notifications with related_spot_id
@@ -315,7 +719,14 @@ func (s *spotService) Delete(ctx context.Context, id uint, userID uint, isAdmin
 		}
 	}
 
-	// 3. Delete visits (including soft-deleted ones)
+	// 3. Delete reviews
+	if s.reviewRepo != nil {
+		if err := s.reviewRepo.DeleteBySpotID(ctx, id); err != nil {
+			logger.Warn().Err(err).Uint("spotID", id).Msg("failed to delete reviews")
+		}
+	}
+
+	// 4. Delete visits (including soft-deleted ones)
 	if s.visitRepo != nil {
 		visits, err := s.visitRepo.FindBySpotIDUnscoped(ctx, id)
 		if err != nil {
@@ -329,14 +740,14 @@ func (s *spotService) Delete(ctx context.Context, id uint, userID uint, isAdmin
 		}
 	}
 
-	// 4. Delete activities
+	// 5. Delete activities
 	if s.activityRepo != nil {
 		if err := s.activityRepo.DeleteBySpotID(ctx, id); err != nil {
 			logger.Warn().Err(err).Uint("spotID", id).Msg("failed to delete activities")
 		}
 	}
 
-	// 5. Get all photos for this spot (including soft-deleted ones)
+	// 6. Get all photos for this spot (including soft-deleted ones)
 	photos, err := s.photoRepo.FindBySpotIDUnscoped(ctx, id)
 	if err != nil {
 		logger.Warn().Err(err).Uint("spotID", id).Msg("failed to get photos for deletion")
@@ -370,7 +781,164 @@ func (s *spotService) Delete(ctx context.Context, id uint, userID uint, isAdmin
 	}
 
 	// Delete spot
-	return s.spotRepo.Delete(ctx, id)
+	if err := s.spotRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if spot.CreatedBy != principal.UserID {
+		s.audit.Record(ctx, newAuditEvent(principal.UserID, domain.AuditActionSpotDeleted, domain.AuditTargetSpot, id,
+			domain.AuditDiff(auditSpotFields(spot), nil)))
+	}
+	return nil
+}
+
+// auditSpotFields are the fields of a spot recorded when someone else than the creator changes it
+func auditSpotFields(spot *domain.Spot) map[string]any {
+	// Sorted, the order of the keys is no change
+	amenities := spot.AmenityKeys()
+	slices.Sort(amenities)
+
+	return map[string]any{
+		"name":        spot.Name,
+		"description": spot.Description,
+		"latitude":    spot.Latitude,
+		"longitude":   spot.Longitude,
+		"amenities":   amenities,
+		"created_by":  spot.CreatedBy,
+	}
+}
+
+// Merge implements SpotService.
+func (s *spotService) Merge(ctx context.Context, req *requests.MergeSpotsRequest, userID uint) (*responses.SpotResponse, error) {
+	if req.SourceID == req.TargetID {
+		return nil, apperror.ErrSpotMergeSelf
+	}
+
+	source, err := s.spotRepo.FindByID(ctx, req.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, apperror.ErrSpotNotFound
+	}
+
+	target, err := s.spotRepo.FindByID(ctx, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, apperror.ErrSpotNotFound
+	}
+
+	if err := s.spotRepo.Merge(ctx, source.ID, target.ID); err != nil {
+		return nil, err
+	}
+
+	logger.Info().Uint("sourceID", source.ID).Uint("targetID", target.ID).Msg("spots merged")
+
+	// Recorded on the source, it is gone afterwards
+	changes := domain.AuditDiff(auditSpotFields(source), nil)
+	changes["merged_into"] = domain.AuditChange{After: target.ID}
+	s.audit.Record(ctx, newAuditEvent(userID, domain.AuditActionSpotMerged, domain.AuditTargetSpot, source.ID, changes))
+
+	// Reload - rating aggregates changed
+	return s.GetByID(ctx, target.ID)
+}
+
+// resolveAmenities loads the amenities for the keys and fails with the unknown keys if any
+func (s *spotService) resolveAmenities(ctx context.Context, keys []string) ([]domain.Amenity, error) {
+	keys = normalizeAmenityKeys(keys)
+	if len(keys) == 0 {
+		return []domain.Amenity{}, nil
+	}
+
+	amenities, err := s.amenityRepo.FindByKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(amenities) != len(keys) {
+		known := make(map[string]bool, len(amenities))
+		for _, amenity := range amenities {
+			known[amenity.Key] = true
+		}
+
+		unknown := responses.UnknownAmenitiesResponse{Keys: []string{}}
+		for _, key := range keys {
+			if !known[key] {
+				unknown.Keys = append(unknown.Keys, key)
+			}
+		}
+		return nil, apperror.WithData(apperror.ErrAmenityUnknown, unknown)
+	}
+
+	return amenities, nil
+}
+
+// normalizeAmenityKeys lowercases the keys and drops empty entries and duplicates
+func normalizeAmenityKeys(keys []string) []string {
+	seen := make(map[string]bool, len(keys))
+	result := make([]string, 0, len(keys))
+	for _, key := range keys {
+		key = normalizeAmenityKey(key)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, key)
+	}
+	return result
+}
+
+// toggleAmenityKey adds or removes a key depending on a legacy boolean flag (nil = unchanged)
+func toggleAmenityKey(keys []string, key string, enabled *bool) []string {
+	if enabled == nil {
+		return keys
+	}
+
+	result := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		if k != key {
+			result = append(result, k)
+		}
+	}
+	if *enabled {
+		result = append(result, key)
+	}
+	return result
+}
+
+// checkDuplicates fails with the nearby spots if any spot lies within the duplicate radius
+func (s *spotService) checkDuplicates(ctx context.Context, lat, lon float64, excludeID uint) error {
+	if s.duplicateRadius <= 0 {
+		return nil
+	}
+
+	nearby, err := s.spotRepo.FindWithinRadius(ctx, lat, lon, s.duplicateRadius, excludeID)
+	if err != nil {
+		return err
+	}
+	if len(nearby) == 0 {
+		return nil
+	}
+
+	duplicates := responses.DuplicateSpotsResponse{
+		Radius: s.duplicateRadius,
+		Spots:  make([]responses.DuplicateSpotResponse, len(nearby)),
+	}
+	for i, spot := range nearby {
+		duplicates.Spots[i] = responses.DuplicateSpotResponse{
+			ID:        spot.ID,
+			Name:      spot.Name,
+			Latitude:  spot.Latitude,
+			Longitude: spot.Longitude,
+		}
+		if spot.Distance != nil {
+			duplicates.Spots[i].Distance = *spot.Distance
+		}
+	}
+
+	return apperror.WithData(apperror.ErrSpotDuplicate, duplicates)
 }
 
 // getMainPhotoURL lädt das Hauptfoto eines Spots und generiert eine presigned URL
@@ -387,3 +955,143 @@ func (s *spotService) getMainPhotoURL(ctx context.Context, spotID uint) (*string
 
 	return &url, nil
 }
+
+// newSpotFilter builds the repository filter from the list query parameters
+func newSpotFilter(req *requests.ListSpotsRequest) (*repository.SpotFilter, error) {
+	filter := &repository.SpotFilter{
+		Page:      req.Page,
+		Limit:     req.Limit,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		MinRating: req.MinRating,
+		Search:    req.Search,
+		Lat:       req.Lat,
+		Lon:       req.Lon,
+		Radius:    req.Radius,
+	}
+
+	var amenities []string
+	if req.Amenities != "" {
+		amenities = strings.Split(req.Amenities, ",")
+	}
+
+	// Legacy flags map to amenity keys
+	legacyFlags := []struct {
+		key  string
+		flag *bool
+	}{
+		{domain.AmenityKeyToilet, req.HasToilet},
+		{domain.AmenityKeyTrashBin, req.HasTrashBin},
+	}
+	for _, legacy := range legacyFlags {
+		if legacy.flag == nil {
+			continue
+		}
+		if *legacy.flag {
+			amenities = append(amenities, legacy.key)
+		} else {
+			filter.WithoutAmenities = append(filter.WithoutAmenities, legacy.key)
+		}
+	}
+	filter.Amenities = normalizeAmenityKeys(amenities)
+
+	if req.Lat != nil && req.Lon != nil {
+		if err := utils.ValidateCoordinates(*req.Lat, *req.Lon); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeSpotCursor(req.Cursor, req.SortBy)
+		if err != nil {
+			return nil, err
+		}
+		filter.Cursor = cursor
+	}
+
+	return filter, nil
+}
+
+// spotCursor is the JSON payload behind the opaque next_cursor string
+type spotCursor struct {
+	ID    uint            `json:"id"`
+	Value json.RawMessage `json:"v"`
+}
+
+// encodeSpotCursor builds the cursor pointing at the given spot for the sort field
+func encodeSpotCursor(spot *domain.Spot, sortBy string) (string, error) {
+	raw, err := json.Marshal(spotSortValue(spot, sortBy))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(spotCursor{ID: spot.ID, Value: raw})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// spotSortValue returns the value of the sort field, nil for unrated spots
+func spotSortValue(spot *domain.Spot, sortBy string) interface{} {
+	switch sortBy {
+	case "name":
+		return spot.Name
+	case "rating":
+		if spot.AverageRating == nil {
+			return nil
+		}
+		return *spot.AverageRating
+	case "distance":
+		if spot.Distance == nil {
+			return nil
+		}
+		return *spot.Distance
+	default:
+		return spot.CreatedAt
+	}
+}
+
+// decodeSpotCursor parses a cursor and types its value for the sort field
+func decodeSpotCursor(encoded string, sortBy string) (*repository.SpotCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, apperror.ErrInvalidCursor
+	}
+
+	var c spotCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == 0 {
+		return nil, apperror.ErrInvalidCursor
+	}
+
+	cursor := &repository.SpotCursor{ID: c.ID}
+
+	// Only unrated spots have no sort value
+	if len(c.Value) == 0 || string(c.Value) == "null" {
+		if sortBy != "rating" {
+			return nil, apperror.ErrInvalidCursor
+		}
+		return cursor, nil
+	}
+
+	switch sortBy {
+	case "name":
+		var name string
+		err = json.Unmarshal(c.Value, &name)
+		cursor.Value = name
+	case "rating", "distance":
+		var number float64
+		err = json.Unmarshal(c.Value, &number)
+		cursor.Value = number
+	default:
+		var createdAt time.Time
+		err = json.Unmarshal(c.Value, &createdAt)
+		cursor.Value = createdAt
+	}
+	if err != nil {
+		return nil, apperror.ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
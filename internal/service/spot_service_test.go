@@ -1,13 +1,20 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/geoformat"
 	"hopSpotAPI/pkg/storage"
 
 	"github.com/stretchr/testify/assert"
@@ -15,13 +22,17 @@ import (
 	"gorm.io/gorm"
 )
 
+// Same default as DUPLICATE_SPOT_RADIUS_METERS
+const testDuplicateRadius = 15.0
+
 func TestSpotService_Create_Success(t *testing.T) {
 	// Arrange
 	spotRepo := mocks.NewSpotRepository(t)
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
+	amenityRepo := mocks.NewAmenityRepository(t)
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, amenityRepo, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	description := "A nice spot"
 	req := &requests.CreateSpotRequest{
@@ -29,10 +40,14 @@ func TestSpotService_Create_Success(t *testing.T) {
 		Latitude:    47.3769,
 		Longitude:   8.5417,
 		Description: &description,
+		Amenities:   []string{"Shade"},
 		HasToilet:   true,
 		HasTrashBin: false,
 	}
 
+	shade := domain.Amenity{ID: 3, Key: "shade", Name: "Shade"}
+	toilet := domain.Amenity{ID: 1, Key: domain.AmenityKeyToilet, Name: "Toilet"}
+
 	creator := domain.User{
 		Model:       &gorm.Model{ID: 1},
 		Email:       "creator@example.com",
@@ -40,10 +55,21 @@ func TestSpotService_Create_Success(t *testing.T) {
 		Role:        domain.RoleUser,
 	}
 
+	// No spots nearby
+	spotRepo.EXPECT().
+		FindWithinRadius(mock.Anything, 47.3769, 8.5417, testDuplicateRadius, uint(0)).
+		Return(nil, nil)
+
+	// Keys are normalized, the legacy flag becomes the toilet amenity
+	amenityRepo.EXPECT().
+		FindByKeys(mock.Anything, []string{"shade", domain.AmenityKeyToilet}).
+		Return([]domain.Amenity{shade, toilet}, nil)
+
 	// Mock Create - set ID
 	spotRepo.EXPECT().
 		Create(mock.Anything, mock.AnythingOfType("*domain.Spot")).
 		Run(func(ctx context.Context, s *domain.Spot) {
+			assert.Len(t, s.Amenities, 2)
 			s.ID = 1
 		}).
 		Return(nil)
@@ -57,8 +83,7 @@ func TestSpotService_Create_Success(t *testing.T) {
 			Latitude:    47.3769,
 			Longitude:   8.5417,
 			Description: "A nice spot",
-			HasToilet:   true,
-			HasTrashBin: false,
+			Amenities:   []domain.Amenity{shade, toilet},
 			CreatedBy:   1,
 			Creator:     creator,
 		}, nil)
@@ -78,6 +103,7 @@ func TestSpotService_Create_Success(t *testing.T) {
 	assert.Equal(t, "Park Spot", result.Name)
 	assert.Equal(t, 47.3769, result.Latitude)
 	assert.Equal(t, 8.5417, result.Longitude)
+	assert.Len(t, result.Amenities, 2)
 	assert.True(t, result.HasToilet)
 	assert.False(t, result.HasTrashBin)
 }
@@ -88,7 +114,7 @@ func TestSpotService_GetByID_Success(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	creator := domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -131,7 +157,7 @@ func TestSpotService_GetByID_NotFound(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spotRepo.EXPECT().
 		FindByID(mock.Anything, uint(999)).
@@ -152,7 +178,7 @@ func TestSpotService_List_Success(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spots := []domain.Spot{
 		{
@@ -202,22 +228,17 @@ func TestSpotService_List_WithCoordinatesAndRadius(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
-	// Spot 1: very close (should be included)
-	// Spot 2: far away (should be excluded by radius)
+	// Radius filter runs in the database - only the close spot comes back
+	distance := 13.4
 	spots := []domain.Spot{
 		{
 			ID:        1,
 			Name:      "Close Spot",
-			Latitude:  47.3770, // Very close to search point
+			Latitude:  47.3770,
 			Longitude: 8.5418,
-		},
-		{
-			ID:        2,
-			Name:      "Far Spot",
-			Latitude:  48.0, // Far from search point
-			Longitude: 9.0,
+			Distance:  &distance,
 		},
 	}
 
@@ -234,27 +255,25 @@ func TestSpotService_List_WithCoordinatesAndRadius(t *testing.T) {
 	}
 
 	spotRepo.EXPECT().
-		FindAll(mock.Anything, mock.AnythingOfType("repository.SpotFilter")).
-		Return(spots, int64(2), nil)
+		FindAll(mock.Anything, mock.MatchedBy(func(f repository.SpotFilter) bool {
+			return *f.Lat == lat && *f.Lon == lon && *f.Radius == radius
+		})).
+		Return(spots, int64(1), nil)
 
 	photoRepo.EXPECT().
 		GetMainPhoto(mock.Anything, uint(1)).
 		Return(nil, nil).Maybe()
 
-	photoRepo.EXPECT().
-		GetMainPhoto(mock.Anything, uint(2)).
-		Return(nil, nil).Maybe()
-
 	// Act
 	result, err := svc.List(context.Background(), req)
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	// Only close spot should be included (far spot is outside 1km)
 	assert.Len(t, result.Spots, 1)
 	assert.Equal(t, "Close Spot", result.Spots[0].Name)
-	assert.NotNil(t, result.Spots[0].Distance)
+	assert.Equal(t, 13.4, *result.Spots[0].Distance)
+	assert.Equal(t, int64(1), result.Pagination.Total)
 }
 
 func TestSpotService_Update_Success_AsOwner(t *testing.T) {
@@ -263,7 +282,8 @@ func TestSpotService_Update_Success_AsOwner(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	audit := mocks.NewAuditService(t) // owners editing their own spot are not audited
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, audit, testDuplicateRadius)
 
 	creator := domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -299,7 +319,7 @@ func TestSpotService_Update_Success_AsOwner(t *testing.T) {
 		Return(nil, nil)
 
 	// Act
-	result, err := svc.Update(context.Background(), uint(1), req, uint(1), false)
+	result, err := svc.Update(context.Background(), uint(1), req, domain.Principal{UserID: 1, Role: domain.RoleUser})
 
 	// Assert
 	assert.NoError(t, err)
@@ -313,7 +333,7 @@ func TestSpotService_Update_Success_AsAdmin(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	creator := domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -345,7 +365,7 @@ func TestSpotService_Update_Success_AsAdmin(t *testing.T) {
 		Return(nil, nil)
 
 	// Act - user 2 is admin
-	result, err := svc.Update(context.Background(), uint(1), req, uint(2), true)
+	result, err := svc.Update(context.Background(), uint(1), req, domain.Principal{UserID: 2, Role: domain.RoleAdmin})
 
 	// Assert
 	assert.NoError(t, err)
@@ -359,7 +379,7 @@ func TestSpotService_Update_Forbidden(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	creator := domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -383,7 +403,7 @@ func TestSpotService_Update_Forbidden(t *testing.T) {
 		Return(spot, nil)
 
 	// Act - user 2 is NOT admin and NOT owner
-	result, err := svc.Update(context.Background(), uint(1), req, uint(2), false)
+	result, err := svc.Update(context.Background(), uint(1), req, domain.Principal{UserID: 2, Role: domain.RoleUser})
 
 	// Assert
 	assert.Error(t, err)
@@ -397,7 +417,7 @@ func TestSpotService_Update_NotFound(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	newName := "Name"
 	req := &requests.UpdateSpotRequest{
@@ -409,7 +429,7 @@ func TestSpotService_Update_NotFound(t *testing.T) {
 		Return(nil, nil)
 
 	// Act
-	result, err := svc.Update(context.Background(), uint(999), req, uint(1), false)
+	result, err := svc.Update(context.Background(), uint(999), req, domain.Principal{UserID: 1, Role: domain.RoleUser})
 
 	// Assert
 	assert.Error(t, err)
@@ -423,7 +443,7 @@ func TestSpotService_Delete_Success_AsOwner(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spot := &domain.Spot{
 		ID:        1,
@@ -444,7 +464,7 @@ func TestSpotService_Delete_Success_AsOwner(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.Delete(context.Background(), uint(1), uint(1), false)
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{UserID: 1, Role: domain.RoleUser})
 
 	// Assert
 	assert.NoError(t, err)
@@ -456,7 +476,7 @@ func TestSpotService_Delete_Success_AsAdmin(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spot := &domain.Spot{
 		ID:        1,
@@ -477,7 +497,41 @@ func TestSpotService_Delete_Success_AsAdmin(t *testing.T) {
 		Return(nil)
 
 	// Act - user 2 is admin
-	err := svc.Delete(context.Background(), uint(1), uint(2), true)
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{UserID: 2, Role: domain.RoleAdmin})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestSpotService_Delete_AsModerator_RecordsAuditEvent(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	audit := mocks.NewAuditService(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, &storage.MinioClient{}, nil, nil, audit, testDuplicateRadius)
+
+	spot := &domain.Spot{
+		ID:        1,
+		Name:      "Test Spot",
+		CreatedBy: 1,
+	}
+
+	spotRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(spot, nil)
+	photoRepo.EXPECT().FindBySpotIDUnscoped(mock.Anything, uint(1)).Return([]domain.Photo{}, nil)
+	spotRepo.EXPECT().Delete(mock.Anything, uint(1)).Return(nil)
+	audit.EXPECT().
+		Record(mock.Anything, mock.AnythingOfType("*domain.AuditEvent")).
+		Run(func(ctx context.Context, event *domain.AuditEvent) {
+			assert.Equal(t, domain.AuditActionSpotDeleted, event.Action)
+			assert.Equal(t, uint(2), *event.ActorID)
+			assert.Equal(t, uint(1), *event.TargetID)
+			assert.Equal(t, "Test Spot", event.Changes["name"].Before)
+			assert.Nil(t, event.Changes["name"].After)
+		}).
+		Once()
+
+	// Act - user 2 moderates the spot of user 1
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{UserID: 2, Role: domain.RoleModerator})
 
 	// Assert
 	assert.NoError(t, err)
@@ -489,7 +543,7 @@ func TestSpotService_Delete_WithPhotos_UsesHardDelete(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spot := &domain.Spot{
 		ID:        1,
@@ -536,7 +590,7 @@ func TestSpotService_Delete_WithPhotos_UsesHardDelete(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.Delete(context.Background(), uint(1), uint(1), false)
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{UserID: 1, Role: domain.RoleUser})
 
 	// Assert
 	assert.NoError(t, err)
@@ -548,7 +602,7 @@ func TestSpotService_Delete_WithSoftDeletedPhotos_IncludesAll(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spot := &domain.Spot{
 		ID:        1,
@@ -598,7 +652,7 @@ func TestSpotService_Delete_WithSoftDeletedPhotos_IncludesAll(t *testing.T) {
 		Return(nil)
 
 	// Act
-	err := svc.Delete(context.Background(), uint(1), uint(1), false)
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{UserID: 1, Role: domain.RoleUser})
 
 	// Assert
 	assert.NoError(t, err)
@@ -610,7 +664,7 @@ func TestSpotService_Delete_Forbidden(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spot := &domain.Spot{
 		ID:        1,
@@ -623,27 +677,66 @@ func TestSpotService_Delete_Forbidden(t *testing.T) {
 		Return(spot, nil)
 
 	// Act - user 2 is NOT admin and NOT owner
-	err := svc.Delete(context.Background(), uint(1), uint(2), false)
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{UserID: 2, Role: domain.RoleUser})
 
 	// Assert
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "forbidden")
 }
 
+func TestSpotService_Delete_ModeratorWithoutTwoFactor(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, &storage.MinioClient{}, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	spotRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.Spot{ID: 1, Name: "Test Spot", CreatedBy: 1}, nil)
+
+	// Act - REQUIRE_ADMIN_2FA applies to the ownership path as well
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{
+		UserID:           2,
+		Role:             domain.RoleModerator,
+		RequireTwoFactor: true,
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrForbidden)
+}
+
+func TestSpotService_Delete_OwnerWithoutTwoFactor(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, &storage.MinioClient{}, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	spotRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(&domain.Spot{ID: 1, Name: "Test Spot", CreatedBy: 2}, nil)
+	photoRepo.EXPECT().FindBySpotIDUnscoped(mock.Anything, uint(1)).Return([]domain.Photo{}, nil)
+	spotRepo.EXPECT().Delete(mock.Anything, uint(1)).Return(nil)
+
+	// Act - staff deleting an own spot uses no privileged permission
+	err := svc.Delete(context.Background(), uint(1), domain.Principal{
+		UserID:           2,
+		Role:             domain.RoleModerator,
+		RequireTwoFactor: true,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
 func TestSpotService_Delete_NotFound(t *testing.T) {
 	// Arrange
 	spotRepo := mocks.NewSpotRepository(t)
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
 	spotRepo.EXPECT().
 		FindByID(mock.Anything, uint(999)).
 		Return(nil, nil)
 
 	// Act
-	err := svc.Delete(context.Background(), uint(999), uint(1), false)
+	err := svc.Delete(context.Background(), uint(999), domain.Principal{UserID: 1, Role: domain.RoleUser})
 
 	// Assert
 	assert.Error(t, err)
@@ -656,21 +749,25 @@ func TestSpotService_List_SortByDistance(t *testing.T) {
 	photoRepo := mocks.NewPhotoRepository(t)
 	minioClient := &storage.MinioClient{}
 	notificationSvc := mocks.NewNotificationService(t)
-	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, minioClient, notificationSvc, nil)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
 
-	// Spots at different distances
+	// Spots already ordered by distance by the database
+	closeDistance := 10.0
+	farDistance := 650.0
 	spots := []domain.Spot{
-		{
-			ID:        1,
-			Name:      "Far Spot",
-			Latitude:  47.38,
-			Longitude: 8.55,
-		},
 		{
 			ID:        2,
 			Name:      "Close Spot",
 			Latitude:  47.377,
 			Longitude: 8.542,
+			Distance:  &closeDistance,
+		},
+		{
+			ID:        1,
+			Name:      "Far Spot",
+			Latitude:  47.38,
+			Longitude: 8.55,
+			Distance:  &farDistance,
 		},
 	}
 
@@ -688,7 +785,7 @@ func TestSpotService_List_SortByDistance(t *testing.T) {
 
 	spotRepo.EXPECT().
 		FindAll(mock.Anything, mock.MatchedBy(func(f repository.SpotFilter) bool {
-			return f.Lat != nil && f.Lon != nil
+			return f.Lat != nil && f.Lon != nil && f.SortBy == "distance" && f.SortOrder == "asc"
 		})).
 		Return(spots, int64(2), nil)
 
@@ -707,7 +804,743 @@ func TestSpotService_List_SortByDistance(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Len(t, result.Spots, 2)
-	// Should be sorted by distance ascending - Close Spot first
+	// Database order is kept - Close Spot first
 	assert.Equal(t, "Close Spot", result.Spots[0].Name)
 	assert.Equal(t, "Far Spot", result.Spots[1].Name)
+	assert.Equal(t, 10.0, *result.Spots[0].Distance)
+}
+
+func TestSpotService_ListNearest_Success(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	minioClient := &storage.MinioClient{}
+	notificationSvc := mocks.NewNotificationService(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	distance := 42.0
+	spots := []domain.Spot{
+		{
+			ID:        3,
+			Name:      "Nearest Spot",
+			Latitude:  47.377,
+			Longitude: 8.542,
+			Distance:  &distance,
+		},
+	}
+
+	lat := 47.3769
+	lon := 8.5417
+	req := &requests.NearestSpotsRequest{
+		Lat:   &lat,
+		Lon:   &lon,
+		Limit: 5,
+	}
+
+	spotRepo.EXPECT().
+		FindNearest(mock.Anything, lat, lon, 5).
+		Return(spots, nil)
+
+	photoRepo.EXPECT().
+		GetMainPhoto(mock.Anything, uint(3)).
+		Return(nil, nil).Maybe()
+
+	// Act
+	result, err := svc.ListNearest(context.Background(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "Nearest Spot", result[0].Name)
+	assert.Equal(t, 42.0, *result[0].Distance)
+}
+
+func TestSpotService_Create_WithRatingCreatesReview(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	minioClient := &storage.MinioClient{}
+	notificationSvc := mocks.NewNotificationService(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, reviewRepo, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	rating := 4
+	req := &requests.CreateSpotRequest{
+		Name:      "Rated Spot",
+		Latitude:  47.3769,
+		Longitude: 8.5417,
+		Rating:    &rating,
+	}
+
+	average := 4.0
+	creator := domain.User{
+		Model:       &gorm.Model{ID: 1},
+		DisplayName: "Creator",
+	}
+
+	spotRepo.EXPECT().
+		FindWithinRadius(mock.Anything, 47.3769, 8.5417, testDuplicateRadius, uint(0)).
+		Return(nil, nil)
+
+	spotRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.Spot")).
+		Run(func(ctx context.Context, s *domain.Spot) {
+			s.ID = 1
+		}).
+		Return(nil)
+
+	// Initial rating is stored as the creator's review
+	reviewRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.SpotReview")).
+		Run(func(ctx context.Context, review *domain.SpotReview) {
+			assert.Equal(t, uint(1), review.SpotID)
+			assert.Equal(t, uint(1), review.UserID)
+			assert.Equal(t, 4, review.Stars)
+		}).
+		Return(true, nil)
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.Spot{
+			ID:            1,
+			Name:          "Rated Spot",
+			CreatedBy:     1,
+			Creator:       creator,
+			AverageRating: &average,
+			ReviewCount:   1,
+		}, nil)
+
+	notificationSvc.EXPECT().
+		NotifyNewSpot(mock.Anything, mock.AnythingOfType("*domain.Spot"), uint(1)).
+		Return(nil).
+		Maybe() // async call
+
+	// Act
+	result, err := svc.Create(context.Background(), req, uint(1))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, int64(1), result.ReviewCount)
+	assert.Equal(t, 4.0, *result.AverageRating)
+	assert.Equal(t, 4, *result.Rating)
+}
+
+func TestSpotService_List_CursorRoundTrip(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	minioClient := &storage.MinioClient{}
+	notificationSvc := mocks.NewNotificationService(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	createdAt := time.Date(2025, 6, 1, 12, 30, 0, 123456000, time.UTC)
+	firstPage := []domain.Spot{
+		{ID: 9, Name: "Newest", CreatedAt: createdAt.Add(time.Hour)},
+		{ID: 7, Name: "Older", CreatedAt: createdAt},
+	}
+
+	photoRepo.EXPECT().
+		GetMainPhoto(mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
+
+	spotRepo.EXPECT().
+		FindAll(mock.Anything, mock.MatchedBy(func(f repository.SpotFilter) bool {
+			return f.Cursor == nil
+		})).
+		Return(firstPage, int64(3), nil).Once()
+
+	// Act - first page is full, so a cursor is returned
+	first, err := svc.List(context.Background(), &requests.ListSpotsRequest{Page: 1, Limit: 2, SortBy: "created_at", SortOrder: "desc"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), first.Pagination.Total)
+	assert.Equal(t, 2, first.Pagination.TotalPages)
+	assert.NotNil(t, first.NextCursor)
+
+	// Second page continues after the last spot of the first page
+	spotRepo.EXPECT().
+		FindAll(mock.Anything, mock.MatchedBy(func(f repository.SpotFilter) bool {
+			if f.Cursor == nil || f.Cursor.ID != 7 {
+				return false
+			}
+			value, ok := f.Cursor.Value.(time.Time)
+			return ok && value.Equal(createdAt)
+		})).
+		Return([]domain.Spot{{ID: 3, Name: "Oldest", CreatedAt: createdAt.Add(-time.Hour)}}, int64(3), nil).Once()
+
+	second, err := svc.List(context.Background(), &requests.ListSpotsRequest{Page: 1, Limit: 2, SortBy: "created_at", SortOrder: "desc", Cursor: *first.NextCursor})
+
+	assert.NoError(t, err)
+	assert.Len(t, second.Spots, 1)
+	assert.Nil(t, second.NextCursor) // last page
+}
+
+func TestSpotService_List_InvalidCursor(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	minioClient := &storage.MinioClient{}
+	notificationSvc := mocks.NewNotificationService(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, minioClient, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	req := &requests.ListSpotsRequest{
+		Page:   1,
+		Limit:  50,
+		SortBy: "created_at",
+		Cursor: "not-a-cursor",
+	}
+
+	// Act
+	result, err := svc.List(context.Background(), req)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidCursor)
+	assert.Nil(t, result)
+}
+
+func TestSpotService_GetMap_ClustersAtLowZoom(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	minioClient := &storage.MinioClient{}
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, minioClient, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	spotID := uint(4)
+	clusters := []repository.SpotCluster{
+		{Count: 12, Latitude: 47.37, Longitude: 8.54},
+		{Count: 1, Latitude: 46.95, Longitude: 7.44, SpotID: &spotID},
+	}
+
+	spotRepo.EXPECT().
+		FindClustersInBounds(mock.Anything, repository.BoundingBox{MinLon: 5.9, MinLat: 45.8, MaxLon: 10.5, MaxLat: 47.8}, mock.AnythingOfType("float64")).
+		Return(clusters, nil)
+
+	// Act
+	result, err := svc.GetMap(context.Background(), &requests.MapSpotsRequest{BBox: "5.9,45.8,10.5,47.8", Zoom: 8})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "clusters", result.Mode)
+	assert.Len(t, result.Clusters, 2)
+	assert.Equal(t, int64(12), result.Clusters[0].Count)
+	assert.Equal(t, uint(4), *result.Clusters[1].SpotID)
+	assert.Empty(t, result.Markers)
+}
+
+func TestSpotService_GetMap_MarkersAtHighZoom(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	minioClient := &storage.MinioClient{}
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, minioClient, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	thumbnail := "spots/1/thumb.jpg"
+	average := 4.5
+	markers := []repository.SpotMarker{
+		{ID: 1, Latitude: 47.377, Longitude: 8.542, AverageRating: &average, ThumbnailPath: &thumbnail},
+		{ID: 2, Latitude: 47.378, Longitude: 8.543},
+	}
+
+	spotRepo.EXPECT().
+		FindMarkersInBounds(mock.Anything, mock.AnythingOfType("repository.BoundingBox"), mapMaxMarkers).
+		Return(markers, nil)
+
+	// Act
+	result, err := svc.GetMap(context.Background(), &requests.MapSpotsRequest{BBox: "8.53,47.37,8.55,47.38", Zoom: 16})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "markers", result.Mode)
+	assert.Len(t, result.Markers, 2)
+	assert.Contains(t, *result.Markers[0].ThumbnailURL, thumbnail)
+	assert.Equal(t, 4.5, *result.Markers[0].AverageRating)
+	assert.Nil(t, result.Markers[1].ThumbnailURL)
+	assert.False(t, result.Truncated)
+}
+
+func TestSpotService_GetMap_InvalidBoundingBox(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	for _, bbox := range []string{"8.5,47.3,8.6", "a,b,c,d", "8.6,47.3,8.5,47.4", "8.5,-95,8.6,47.4"} {
+		// Act
+		result, err := svc.GetMap(context.Background(), &requests.MapSpotsRequest{BBox: bbox, Zoom: 15})
+
+		// Assert
+		assert.ErrorIs(t, err, apperror.ErrInvalidBoundingBox, bbox)
+		assert.Nil(t, result)
+	}
+}
+
+func TestSpotService_Export_GeoJSON(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	average := 3.6
+	spots := []domain.Spot{
+		{ID: 1, Name: "Seeblick", Description: "Am Ufer", Latitude: 47.37, Longitude: 8.54, AverageRating: &average, ReviewCount: 5,
+			Amenities: []domain.Amenity{{ID: 1, Key: domain.AmenityKeyToilet}, {ID: 3, Key: "shade"}}},
+		{ID: 2, Name: "Waldrand", Latitude: 47.40, Longitude: 8.60},
+	}
+
+	hasToilet := true
+	req := &requests.ExportSpotsRequest{
+		ListSpotsRequest: requests.ListSpotsRequest{SortBy: "created_at", HasToilet: &hasToilet},
+		Format:           "geojson",
+	}
+
+	spotRepo.EXPECT().
+		FindAll(mock.Anything, mock.MatchedBy(func(f repository.SpotFilter) bool {
+			return assert.ObjectsAreEqual([]string{domain.AmenityKeyToilet}, f.Amenities) && f.Limit == exportBatchSize
+		})).
+		Return(spots, int64(2), nil)
+
+	// Act
+	var buf bytes.Buffer
+	err := svc.Export(context.Background(), req, &buf)
+
+	// Assert
+	assert.NoError(t, err)
+
+	var doc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Coordinates []float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "FeatureCollection", doc.Type)
+	assert.Len(t, doc.Features, 2)
+	assert.Equal(t, []float64{8.54, 47.37}, doc.Features[0].Geometry.Coordinates)
+	assert.Equal(t, "Seeblick", doc.Features[0].Properties["name"])
+	assert.Equal(t, 3.6, doc.Features[0].Properties["average_rating"])
+	assert.Equal(t, true, doc.Features[0].Properties["has_toilet"])
+	assert.Equal(t, []interface{}{domain.AmenityKeyToilet, "shade"}, doc.Features[0].Properties["amenities"])
+	assert.NotContains(t, doc.Features[1].Properties, "average_rating")
+}
+
+func TestSpotService_Import_Report(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	reviewRepo := mocks.NewSpotReviewRepository(t)
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, reviewRepo, amenityRepo, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	data := []byte(`<?xml version="1.0"?>
+<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1" xmlns:hopspot="urn:hopspot:gpx:1">
+	<wpt lat="47.1" lon="8.1"><name>Neue Bank</name><extensions><hopspot:rating>4</hopspot:rating><hopspot:has_toilet>true</hopspot:has_toilet><hopspot:amenities>shade</hopspot:amenities></extensions></wpt>
+	<wpt lat="47.2" lon="8.2"><name>Seeblick</name></wpt>
+	<wpt lat="47.3" lon="8.3"><name>Gleicher Ort</name></wpt>
+	<wpt lat="47.4" lon="8.4"></wpt>
+</gpx>`)
+
+	// 1. New spot
+	spotRepo.EXPECT().FindByName(mock.Anything, "Neue Bank").Return(nil, nil)
+	spotRepo.EXPECT().FindNearest(mock.Anything, 47.1, 8.1, 1).Return(nil, nil)
+	amenityRepo.EXPECT().
+		FindByKeys(mock.Anything, []string{"shade", domain.AmenityKeyToilet}).
+		Return([]domain.Amenity{{ID: 3, Key: "shade"}, {ID: 1, Key: domain.AmenityKeyToilet}}, nil)
+	spotRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.Spot")).
+		Run(func(ctx context.Context, s *domain.Spot) {
+			assert.True(t, s.HasAmenity(domain.AmenityKeyToilet))
+			assert.True(t, s.HasAmenity("shade"))
+			s.ID = 10
+		}).
+		Return(nil)
+	reviewRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(r *domain.SpotReview) bool {
+			return r.SpotID == 10 && r.Stars == 4
+		})).
+		Return(true, nil)
+	spotRepo.EXPECT().FindByID(mock.Anything, uint(10)).Return(&domain.Spot{ID: 10, Name: "Neue Bank"}, nil)
+
+	// 2. Same name exists
+	spotRepo.EXPECT().FindByName(mock.Anything, "Seeblick").Return(&domain.Spot{ID: 1, Name: "Seeblick"}, nil)
+
+	// 3. Other spot 8 m away
+	nearDistance := 8.0
+	spotRepo.EXPECT().FindByName(mock.Anything, "Gleicher Ort").Return(nil, nil)
+	spotRepo.EXPECT().FindNearest(mock.Anything, 47.3, 8.3, 1).
+		Return([]domain.Spot{{ID: 2, Name: "Bank am Weg", Distance: &nearDistance}}, nil)
+
+	// Act
+	result, err := svc.Import(context.Background(), data, uint(1))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "gpx", result.Format)
+	assert.Equal(t, 4, result.Total)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 2, result.Duplicates)
+	assert.Equal(t, 1, result.Invalid)
+
+	assert.Equal(t, responses.ImportStatusCreated, result.Results[0].Status)
+	assert.Equal(t, uint(10), *result.Results[0].SpotID)
+	assert.Equal(t, uint(1), *result.Results[1].DuplicateOf)
+	assert.Equal(t, uint(2), *result.Results[2].DuplicateOf)
+	assert.Equal(t, responses.ImportStatusInvalid, result.Results[3].Status) // no name
+}
+
+func TestSpotService_Import_InvalidFeatures(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	// Rejected before any lookup, so the repository is never called
+	data := []byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[-33.87,151.21]},"properties":{"name":"Vertauscht"}},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[181.5,47.37]},"properties":{"name":"Datumsgrenze"}},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[8.54,95]},"properties":{"name":"Nordpol"}},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[8.54,47.37]},"properties":{"name":"  "}},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[8.54,47.37]},"properties":{}},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":["8.54","47.37"]},"properties":{"name":"Text"}},
+		{"type":"Feature","geometry":{"type":"LineString","coordinates":[[8.54,47.37],[8.55,47.38]]},"properties":{"name":"Weg"}},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[8.54,47.37]},"properties":{"name":"Zu gut","rating":7}}
+	]}`)
+
+	// Act
+	result, err := svc.Import(context.Background(), data, uint(1))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "geojson", result.Format)
+	assert.Equal(t, 8, result.Total)
+	assert.Equal(t, 8, result.Invalid)
+	assert.Equal(t, 0, result.Created)
+
+	messages := []string{
+		"latitude must be between -90 and 90", // [lat, lon] instead of [lon, lat]
+		"longitude must be between -180 and 180",
+		"latitude must be between -90 and 90",
+		"name is required and must be at most 255 characters",
+		"name is required and must be at most 255 characters",
+		"only point geometries are supported",
+		"only point geometries are supported",
+		"rating must be between 1 and 5",
+	}
+	for i, message := range messages {
+		assert.Equal(t, responses.ImportStatusInvalid, result.Results[i].Status)
+		assert.Equal(t, i, result.Results[i].Index)
+		assert.Equal(t, message, result.Results[i].Message)
+	}
+}
+
+func TestSpotService_Import_FileTooLarge(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	data := []byte(`{"type":"FeatureCollection","features":[]}` + strings.Repeat(" ", geoformat.MaxFileSize))
+
+	// Act
+	result, err := svc.Import(context.Background(), data, uint(1))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidImportFile)
+	assert.Nil(t, result)
+}
+
+func TestSpotService_Import_InvalidFile(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	// Act
+	result, err := svc.Import(context.Background(), []byte("name;lat;lon"), uint(1))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidImportFile)
+	assert.Nil(t, result)
+}
+
+func TestSpotService_Create_DuplicateNearby(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	req := &requests.CreateSpotRequest{
+		Name:      "Park Spot Again",
+		Latitude:  47.3769,
+		Longitude: 8.5417,
+	}
+
+	distance := 6.5
+	spotRepo.EXPECT().
+		FindWithinRadius(mock.Anything, 47.3769, 8.5417, testDuplicateRadius, uint(0)).
+		Return([]domain.Spot{
+			{ID: 7, Name: "Park Spot", Latitude: 47.37695, Longitude: 8.54175, Distance: &distance},
+		}, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), req, uint(1))
+
+	// Assert - nothing is created, the nearby spots are attached to the error
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperror.ErrSpotDuplicate)
+
+	var dataErr *apperror.DataError
+	assert.ErrorAs(t, err, &dataErr)
+	duplicates, ok := dataErr.Data.(responses.DuplicateSpotsResponse)
+	assert.True(t, ok)
+	assert.Equal(t, testDuplicateRadius, duplicates.Radius)
+	assert.Len(t, duplicates.Spots, 1)
+	assert.Equal(t, uint(7), duplicates.Spots[0].ID)
+	assert.Equal(t, "Park Spot", duplicates.Spots[0].Name)
+	assert.Equal(t, 6.5, duplicates.Spots[0].Distance)
+}
+
+func TestSpotService_Create_ForceSkipsDuplicateCheck(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	notificationSvc := mocks.NewNotificationService(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, notificationSvc, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	req := &requests.CreateSpotRequest{
+		Name:      "Park Spot Again",
+		Latitude:  47.3769,
+		Longitude: 8.5417,
+		Force:     true,
+	}
+
+	// No FindWithinRadius expected
+	spotRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*domain.Spot")).
+		Run(func(ctx context.Context, s *domain.Spot) {
+			s.ID = 8
+		}).
+		Return(nil)
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(8)).
+		Return(&domain.Spot{ID: 8, Name: "Park Spot Again", CreatedBy: 1, Creator: domain.User{Model: &gorm.Model{ID: 1}}}, nil)
+
+	notificationSvc.EXPECT().
+		NotifyNewSpot(mock.Anything, mock.AnythingOfType("*domain.Spot"), uint(1)).
+		Return(nil).
+		Maybe() // async call
+
+	// Act
+	result, err := svc.Create(context.Background(), req, uint(1))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(8), result.ID)
+}
+
+func TestSpotService_Update_MoveOntoDuplicate(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	spot := &domain.Spot{ID: 1, Name: "Spot", Latitude: 47.0, Longitude: 8.0, CreatedBy: 1}
+	newLat := 47.3769
+	req := &requests.UpdateSpotRequest{Latitude: &newLat}
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(spot, nil)
+
+	// The spot itself is excluded from the check
+	spotRepo.EXPECT().
+		FindWithinRadius(mock.Anything, 47.3769, 8.0, testDuplicateRadius, uint(1)).
+		Return([]domain.Spot{{ID: 2, Name: "Other Spot"}}, nil)
+
+	// Act
+	result, err := svc.Update(context.Background(), uint(1), req, domain.Principal{UserID: 1, Role: domain.RoleUser})
+
+	// Assert - Update is never called
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperror.ErrSpotDuplicate)
+}
+
+func TestSpotService_Merge_Success(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	audit := mocks.NewAuditService(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, audit, testDuplicateRadius)
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(2)).
+		Return(&domain.Spot{ID: 2, Name: "Duplicate"}, nil).
+		Once()
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.Spot{ID: 1, Name: "Original", Creator: domain.User{Model: &gorm.Model{ID: 1}}}, nil)
+
+	spotRepo.EXPECT().
+		Merge(mock.Anything, uint(2), uint(1)).
+		Return(nil)
+
+	photoRepo.EXPECT().
+		GetMainPhoto(mock.Anything, uint(1)).
+		Return(nil, nil)
+
+	audit.EXPECT().
+		Record(mock.Anything, mock.AnythingOfType("*domain.AuditEvent")).
+		Run(func(ctx context.Context, event *domain.AuditEvent) {
+			assert.Equal(t, domain.AuditActionSpotMerged, event.Action)
+			assert.Equal(t, uint(9), *event.ActorID)
+			assert.Equal(t, domain.AuditTargetSpot, event.TargetType)
+			assert.Equal(t, uint(2), *event.TargetID)
+			assert.Equal(t, uint(1), event.Changes["merged_into"].After)
+			assert.Equal(t, "Duplicate", event.Changes["name"].Before)
+		}).
+		Once()
+
+	// Act - admin 9 merges spot 2 into spot 1
+	result, err := svc.Merge(context.Background(), &requests.MergeSpotsRequest{SourceID: 2, TargetID: 1}, uint(9))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), result.ID)
+	assert.Equal(t, "Original", result.Name)
+}
+
+func TestSpotService_Merge_SameSpot(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	// Act
+	result, err := svc.Merge(context.Background(), &requests.MergeSpotsRequest{SourceID: 1, TargetID: 1}, uint(9))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperror.ErrSpotMergeSelf)
+}
+
+func TestSpotService_Merge_TargetNotFound(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(2)).
+		Return(&domain.Spot{ID: 2}, nil)
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(3)).
+		Return(nil, nil)
+
+	// Act
+	result, err := svc.Merge(context.Background(), &requests.MergeSpotsRequest{SourceID: 2, TargetID: 3}, uint(9))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperror.ErrSpotNotFound)
+}
+
+func TestSpotService_Create_UnknownAmenity(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, amenityRepo, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	req := &requests.CreateSpotRequest{
+		Name:      "Spot",
+		Latitude:  47.0,
+		Longitude: 8.0,
+		Amenities: []string{"shade", "jacuzzi"},
+	}
+
+	spotRepo.EXPECT().
+		FindWithinRadius(mock.Anything, 47.0, 8.0, testDuplicateRadius, uint(0)).
+		Return(nil, nil)
+	amenityRepo.EXPECT().
+		FindByKeys(mock.Anything, []string{"shade", "jacuzzi"}).
+		Return([]domain.Amenity{{ID: 3, Key: "shade"}}, nil)
+
+	// Act
+	result, err := svc.Create(context.Background(), req, uint(1))
+
+	// Assert - unknown keys are listed in the error data
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperror.ErrAmenityUnknown)
+
+	var dataErr *apperror.DataError
+	assert.ErrorAs(t, err, &dataErr)
+	assert.Equal(t, responses.UnknownAmenitiesResponse{Keys: []string{"jacuzzi"}}, dataErr.Data)
+}
+
+func TestSpotService_Update_LegacyFlagTogglesAmenity(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	photoRepo := mocks.NewPhotoRepository(t)
+	amenityRepo := mocks.NewAmenityRepository(t)
+	svc := NewSpotService(spotRepo, photoRepo, nil, nil, nil, nil, nil, amenityRepo, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	shade := domain.Amenity{ID: 3, Key: "shade"}
+	toilet := domain.Amenity{ID: 1, Key: domain.AmenityKeyToilet}
+	spot := &domain.Spot{
+		ID:        1,
+		CreatedBy: 1,
+		Creator:   domain.User{Model: &gorm.Model{ID: 1}},
+		Amenities: []domain.Amenity{shade, toilet},
+	}
+
+	hasToilet := false
+	req := &requests.UpdateSpotRequest{HasToilet: &hasToilet}
+
+	spotRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(spot, nil)
+
+	// Other amenities stay, only the toilet is removed
+	amenityRepo.EXPECT().
+		FindByKeys(mock.Anything, []string{"shade"}).
+		Return([]domain.Amenity{shade}, nil)
+
+	spotRepo.EXPECT().
+		Update(mock.Anything, spot).
+		Return(nil)
+	spotRepo.EXPECT().
+		ReplaceAmenities(mock.Anything, uint(1), []domain.Amenity{shade}).
+		Return(nil)
+
+	photoRepo.EXPECT().
+		GetMainPhoto(mock.Anything, uint(1)).
+		Return(nil, nil)
+
+	// Act
+	result, err := svc.Update(context.Background(), uint(1), req, domain.Principal{UserID: 1, Role: domain.RoleUser})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, result.HasToilet)
+	assert.Len(t, result.Amenities, 1)
+	assert.Equal(t, "shade", result.Amenities[0].Key)
+}
+
+func TestSpotService_List_AmenityFilter(t *testing.T) {
+	// Arrange
+	spotRepo := mocks.NewSpotRepository(t)
+	svc := NewSpotService(spotRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), testDuplicateRadius)
+
+	hasTrashBin := false
+	req := &requests.ListSpotsRequest{
+		Page:        1,
+		Limit:       50,
+		Amenities:   "Shade, view,,shade",
+		HasTrashBin: &hasTrashBin,
+	}
+
+	spotRepo.EXPECT().
+		FindAll(mock.Anything, mock.MatchedBy(func(f repository.SpotFilter) bool {
+			return assert.ObjectsAreEqual([]string{"shade", "view"}, f.Amenities) &&
+				assert.ObjectsAreEqual([]string{domain.AmenityKeyTrashBin}, f.WithoutAmenities)
+		})).
+		Return([]domain.Spot{}, int64(0), nil)
+
+	// Act
+	result, err := svc.List(context.Background(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, result.Spots)
 }
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/cache"
+	"hopSpotAPI/pkg/logger"
+)
+
+// TokenVersionService tracks the token version access tokens of a user must carry.
+// Raising the version revokes all access tokens issued before, refresh tokens stay valid.
+type TokenVersionService interface {
+	Current(ctx context.Context, userID uint) (uint, error)
+	Invalidate(ctx context.Context, userID uint) error
+}
+
+type tokenVersionService struct {
+	userRepo    repository.UserRepository
+	redisClient *cache.RedisClient
+	cacheTTL    time.Duration
+}
+
+func NewTokenVersionService(userRepo repository.UserRepository, redisClient *cache.RedisClient, cacheTTL time.Duration) TokenVersionService {
+	return &tokenVersionService{
+		userRepo:    userRepo,
+		redisClient: redisClient,
+		cacheTTL:    cacheTTL,
+	}
+}
+
+// Current implements TokenVersionService.
+// Checked on every authenticated request, so Redis is asked first and the database only on a miss.
+func (s *tokenVersionService) Current(ctx context.Context, userID uint) (uint, error) {
+	cacheKey := s.generateCacheKey(userID)
+
+	if s.redisClient != nil {
+		var version uint
+		found, err := s.redisClient.Get(ctx, cacheKey, &version)
+		if err != nil {
+			logger.Warn().Err(err).Str("key", cacheKey).Msg("Redis get error")
+		}
+		if found {
+			return version, nil
+		}
+	}
+
+	version, err := s.userRepo.FindTokenVersion(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if version == nil {
+		return 0, apperror.ErrUserNotFound
+	}
+
+	// SetNX, so a value read before an Invalidate can't overwrite the newer one
+	if s.redisClient != nil {
+		if _, err := s.redisClient.SetNX(ctx, cacheKey, *version, s.cacheTTL); err != nil {
+			logger.Warn().Err(err).Str("key", cacheKey).Msg("Redis set error")
+		}
+	}
+
+	return *version, nil
+}
+
+// Invalidate implements TokenVersionService.
+func (s *tokenVersionService) Invalidate(ctx context.Context, userID uint) error {
+	if err := s.userRepo.IncrementTokenVersion(ctx, userID); err != nil {
+		return err
+	}
+
+	if s.redisClient == nil {
+		return nil
+	}
+
+	version, err := s.userRepo.FindTokenVersion(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if version == nil {
+		// Deleted users have no valid tokens, a cached version must not outlive them
+		return s.redisClient.Delete(ctx, s.generateCacheKey(userID))
+	}
+
+	return s.redisClient.Set(ctx, s.generateCacheKey(userID), *version, s.cacheTTL)
+}
+
+func (s *tokenVersionService) generateCacheKey(userID uint) string {
+	return fmt.Sprintf("token_version:%d", userID)
+}
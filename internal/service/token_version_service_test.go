@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTokenVersionService_Current_FallsBackToDatabase(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewTokenVersionService(userRepo, nil, time.Minute)
+
+	version := uint(3)
+	userRepo.EXPECT().FindTokenVersion(mock.Anything, uint(1)).Return(&version, nil)
+
+	// Act
+	result, err := svc.Current(context.Background(), uint(1))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), result)
+}
+
+func TestTokenVersionService_Current_UserNotFound(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewTokenVersionService(userRepo, nil, time.Minute)
+
+	userRepo.EXPECT().FindTokenVersion(mock.Anything, uint(1)).Return(nil, nil)
+
+	// Act
+	_, err := svc.Current(context.Background(), uint(1))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrUserNotFound)
+}
+
+func TestTokenVersionService_Invalidate_IncrementsVersion(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewTokenVersionService(userRepo, nil, time.Minute)
+
+	userRepo.EXPECT().IncrementTokenVersion(mock.Anything, uint(1)).Return(nil)
+
+	// Act
+	err := svc.Invalidate(context.Background(), uint(1))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestTokenVersionService_Invalidate_RepositoryError(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewTokenVersionService(userRepo, nil, time.Minute)
+
+	dbErr := errors.New("db down")
+	userRepo.EXPECT().IncrementTokenVersion(mock.Anything, uint(1)).Return(dbErr)
+
+	// Act
+	err := svc.Invalidate(context.Background(), uint(1))
+
+	// Assert
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/dto/responses"
+	"hopSpotAPI/internal/repository"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/utils"
+)
+
+type TwoFactorService interface {
+	Setup(ctx context.Context, userID uint, req *requests.TwoFactorSetupRequest) (*responses.TwoFactorSetupResponse, error)
+	Enable(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error)
+	Disable(ctx context.Context, userID uint, req *requests.DisableTwoFactorRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error)
+	// CreateChallenge starts the code step of a login and returns the plain challenge token
+	CreateChallenge(ctx context.Context, userID uint) (string, error)
+	// VerifyChallenge redeems a challenge with a TOTP or recovery code and returns its user.
+	// On a wrong code or an expired challenge the user is returned with the error, so the failure counts against the account.
+	VerifyChallenge(ctx context.Context, challengeToken, code string) (*domain.User, error)
+}
+
+const (
+	// recoveryCodeCount is the number of recovery codes generated at once
+	recoveryCodeCount = 10
+	// maxTwoFactorAttempts is the number of wrong codes after which a challenge is discarded
+	maxTwoFactorAttempts = 5
+)
+
+type twoFactorService struct {
+	userRepo         repository.UserRepository
+	recoveryCodeRepo repository.RecoveryCodeRepository
+	challengeRepo    repository.TwoFactorChallengeRepository
+	config           config.Config
+}
+
+func NewTwoFactorService(
+	userRepo repository.UserRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	challengeRepo repository.TwoFactorChallengeRepository,
+	config config.Config,
+) TwoFactorService {
+	return &twoFactorService{
+		userRepo:         userRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		challengeRepo:    challengeRepo,
+		config:           config,
+	}
+}
+
+// Setup implements TwoFactorService.
+// Stores a new pending secret, 2FA is only active after Enable confirmed a code.
+// Accounts without password confirm with their email, like DeleteAccount.
+func (s *twoFactorService) Setup(ctx context.Context, userID uint, req *requests.TwoFactorSetupRequest) (*responses.TwoFactorSetupResponse, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !confirmsAccount(user, req.Password, req.Email) {
+		return nil, apperror.ErrInvalidCredentials
+	}
+	if user.IsTwoFactorEnabled() {
+		return nil, apperror.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPSecret = &secret
+	user.TOTPEnabledAt = nil
+	if err := s.userRepo.UpdateTwoFactor(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &responses.TwoFactorSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: utils.TOTPProvisioningURI(secret, s.config.TwoFactorIssuer, user.Email),
+	}, nil
+}
+
+// Enable implements TwoFactorService.
+func (s *twoFactorService) Enable(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsTwoFactorEnabled() {
+		return nil, apperror.ErrTwoFactorAlreadyEnabled
+	}
+	if user.TOTPSecret == nil {
+		return nil, apperror.ErrTwoFactorNotEnabled
+	}
+
+	// Only the authenticator app proves the setup worked
+	step, ok := utils.ValidateTOTP(*user.TOTPSecret, req.Code, time.Now())
+	if !ok {
+		return nil, apperror.ErrInvalidTwoFactorCode
+	}
+
+	codes, err := s.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user.TOTPEnabledAt = &now
+	user.TOTPLastStep = step
+	if err := s.userRepo.UpdateTwoFactor(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &responses.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// Disable implements TwoFactorService.
+func (s *twoFactorService) Disable(ctx context.Context, userID uint, req *requests.DisableTwoFactorRequest) error {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsTwoFactorEnabled() {
+		return apperror.ErrTwoFactorNotEnabled
+	}
+	if s.config.RequireAdminTwoFactor && user.Role.IsStaff() {
+		return apperror.ErrTwoFactorRequired
+	}
+	if !confirmsAccount(user, req.Password, req.Email) {
+		return apperror.ErrInvalidCredentials
+	}
+	if err := s.verifyCode(ctx, user, req.Code); err != nil {
+		return err
+	}
+
+	if err := s.recoveryCodeRepo.DeleteByUserID(ctx, user.ID); err != nil {
+		return err
+	}
+
+	user.TOTPSecret = nil
+	user.TOTPEnabledAt = nil
+	user.TOTPLastStep = 0
+	return s.userRepo.UpdateTwoFactor(ctx, user)
+}
+
+// RegenerateRecoveryCodes implements TwoFactorService.
+// All previous recovery codes stop working.
+func (s *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsTwoFactorEnabled() {
+		return nil, apperror.ErrTwoFactorNotEnabled
+	}
+	if err := s.verifyCode(ctx, user, req.Code); err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.UpdateTwoFactor(ctx, user); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// CreateChallenge implements TwoFactorService.
+func (s *twoFactorService) CreateChallenge(ctx context.Context, userID uint) (string, error) {
+	tokenString, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return "", err
+	}
+
+	challenge := &domain.TwoFactorChallenge{
+		UserID:    userID,
+		TokenHash: utils.HashToken(tokenString),
+		ExpiresAt: time.Now().Add(s.config.TwoFactorChallengeExpire),
+	}
+	if err := s.challengeRepo.Create(ctx, challenge); err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+// VerifyChallenge implements TwoFactorService.
+func (s *twoFactorService) VerifyChallenge(ctx context.Context, challengeToken, code string) (*domain.User, error) {
+	challenge, err := s.challengeRepo.FindByTokenHash(ctx, utils.HashToken(challengeToken))
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, apperror.ErrInvalidTwoFactorChallenge
+	}
+
+	user := &challenge.User
+	if !challenge.IsValid(maxTwoFactorAttempts) || !user.IsTwoFactorEnabled() {
+		return user, apperror.ErrInvalidTwoFactorChallenge
+	}
+
+	if err := s.verifyCode(ctx, user, code); err != nil {
+		if !errors.Is(err, apperror.ErrInvalidTwoFactorCode) {
+			return nil, err
+		}
+		if incErr := s.challengeRepo.IncrementAttempts(ctx, challenge.ID); incErr != nil {
+			return nil, incErr
+		}
+		return user, err
+	}
+
+	// Redeem, so the challenge is single-use even for parallel requests
+	redeemed, err := s.challengeRepo.MarkAsUsed(ctx, challenge.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !redeemed {
+		return nil, apperror.ErrInvalidTwoFactorChallenge
+	}
+
+	if err := s.userRepo.UpdateTwoFactor(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// verifyCode accepts a TOTP code or an unused recovery code.
+// An accepted TOTP step is stored on the user, callers persist it with their update.
+func (s *twoFactorService) verifyCode(ctx context.Context, user *domain.User, code string) error {
+	if step, ok := utils.ValidateTOTP(*user.TOTPSecret, code, time.Now()); ok {
+		// A code must not be accepted twice
+		if step <= user.TOTPLastStep {
+			return apperror.ErrInvalidTwoFactorCode
+		}
+		user.TOTPLastStep = step
+		return nil
+	}
+
+	recoveryCode, err := s.recoveryCodeRepo.FindUnusedByHash(ctx, user.ID, utils.HashToken(utils.NormalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if recoveryCode == nil {
+		return apperror.ErrInvalidTwoFactorCode
+	}
+
+	redeemed, err := s.recoveryCodeRepo.MarkAsUsed(ctx, recoveryCode.ID)
+	if err != nil {
+		return err
+	}
+	if !redeemed {
+		return apperror.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+// replaceRecoveryCodes stores new recovery codes and returns them in plain text
+func (s *twoFactorService) replaceRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+	plainCodes := make([]string, 0, recoveryCodeCount)
+	codes := make([]domain.RecoveryCode, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, err := utils.GenerateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		plainCodes = append(plainCodes, code)
+		codes = append(codes, domain.RecoveryCode{UserID: userID, CodeHash: utils.HashToken(code)})
+	}
+
+	if err := s.recoveryCodeRepo.ReplaceByUserID(ctx, userID, codes); err != nil {
+		return nil, err
+	}
+	return plainCodes, nil
+}
+
+func (s *twoFactorService) findUser(ctx context.Context, userID uint) (*domain.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, apperror.ErrUserNotFound
+	}
+	return user, nil
+}
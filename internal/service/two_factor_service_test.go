@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXP"
+
+func newTestTwoFactorUser(enabled bool) *domain.User {
+	hashedPassword, _ := utils.HashPassword("TestPass123!")
+	secret := testTOTPSecret
+	user := &domain.User{
+		Model:        &gorm.Model{ID: 1},
+		Email:        "test@example.com",
+		PasswordHash: hashedPassword,
+		Role:         domain.RoleUser,
+		IsActive:     true,
+		TOTPSecret:   &secret,
+	}
+	if enabled {
+		enabledAt := time.Now()
+		user.TOTPEnabledAt = &enabledAt
+	}
+	return user
+}
+
+func TestTwoFactorService_Setup_ReturnsProvisioningURI(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewTwoFactorService(userRepo, nil, nil, config.Config{TwoFactorIssuer: "HopSpot"})
+
+	user := newTestTwoFactorUser(false)
+	user.TOTPSecret = nil
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	userRepo.EXPECT().UpdateTwoFactor(mock.Anything, user).Return(nil)
+
+	// Act
+	result, err := svc.Setup(context.Background(), 1, &requests.TwoFactorSetupRequest{Password: "TestPass123!"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, result.ProvisioningURI, "otpauth://totp/HopSpot:test@example.com?")
+	assert.Contains(t, result.ProvisioningURI, "secret="+result.Secret)
+	assert.Equal(t, result.Secret, *user.TOTPSecret)
+	assert.False(t, user.IsTwoFactorEnabled()) // only after a code was confirmed
+}
+
+func TestTwoFactorService_Setup_WithoutPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     requests.TwoFactorSetupRequest
+		wantErr error
+	}{
+		{name: "current email", req: requests.TwoFactorSetupRequest{Email: "test@example.com"}},
+		{name: "other email", req: requests.TwoFactorSetupRequest{Email: "other@example.com"}, wantErr: apperror.ErrInvalidCredentials},
+		{name: "password only", req: requests.TwoFactorSetupRequest{Password: "TestPass123!"}, wantErr: apperror.ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			userRepo := mocks.NewUserRepository(t)
+			svc := NewTwoFactorService(userRepo, nil, nil, config.Config{TwoFactorIssuer: "HopSpot"})
+
+			// Registered with an external login
+			user := newTestTwoFactorUser(false)
+			user.PasswordHash = ""
+			user.TOTPSecret = nil
+
+			userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+			if tt.wantErr == nil {
+				userRepo.EXPECT().UpdateTwoFactor(mock.Anything, user).Return(nil)
+			}
+
+			// Act
+			result, err := svc.Setup(context.Background(), 1, &tt.req)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				assert.Nil(t, user.TOTPSecret)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, result.Secret, *user.TOTPSecret)
+			}
+		})
+	}
+}
+
+func TestTwoFactorService_Enable_ReturnsRecoveryCodes(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	recoveryCodeRepo := mocks.NewRecoveryCodeRepository(t)
+	svc := NewTwoFactorService(userRepo, recoveryCodeRepo, nil, config.Config{})
+
+	user := newTestTwoFactorUser(false)
+	code, _ := utils.TOTPCode(testTOTPSecret, time.Now())
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	recoveryCodeRepo.EXPECT().
+		ReplaceByUserID(mock.Anything, uint(1), mock.AnythingOfType("[]domain.RecoveryCode")).
+		Run(func(ctx context.Context, userID uint, codes []domain.RecoveryCode) {
+			assert.Len(t, codes, recoveryCodeCount)
+		}).
+		Return(nil)
+	userRepo.EXPECT().UpdateTwoFactor(mock.Anything, user).Return(nil)
+
+	// Act
+	result, err := svc.Enable(context.Background(), 1, &requests.TwoFactorCodeRequest{Code: code})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.RecoveryCodes, recoveryCodeCount)
+	assert.True(t, user.IsTwoFactorEnabled())
+}
+
+func TestTwoFactorService_Enable_InvalidCode(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewTwoFactorService(userRepo, nil, nil, config.Config{})
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(newTestTwoFactorUser(false), nil)
+
+	// Act
+	result, err := svc.Enable(context.Background(), 1, &requests.TwoFactorCodeRequest{Code: "abcdef"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidTwoFactorCode)
+	assert.Nil(t, result)
+}
+
+func TestTwoFactorService_Disable_MandatoryForAdmins(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewTwoFactorService(userRepo, nil, nil, config.Config{RequireAdminTwoFactor: true})
+
+	user := newTestTwoFactorUser(true)
+	user.Role = domain.RoleAdmin
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+
+	// Act
+	err := svc.Disable(context.Background(), 1, &requests.DisableTwoFactorRequest{Password: "TestPass123!", Code: "123456"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrTwoFactorRequired)
+}
+
+func TestTwoFactorService_Disable_WithoutPassword(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	recoveryCodeRepo := mocks.NewRecoveryCodeRepository(t)
+	svc := NewTwoFactorService(userRepo, recoveryCodeRepo, nil, config.Config{})
+
+	user := newTestTwoFactorUser(true)
+	user.PasswordHash = ""
+	code, _ := utils.TOTPCode(testTOTPSecret, time.Now())
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	recoveryCodeRepo.EXPECT().DeleteByUserID(mock.Anything, uint(1)).Return(nil)
+	userRepo.EXPECT().UpdateTwoFactor(mock.Anything, user).Return(nil)
+
+	// Act
+	err := svc.Disable(context.Background(), 1, &requests.DisableTwoFactorRequest{Email: "test@example.com", Code: code})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, user.IsTwoFactorEnabled())
+}
+
+func TestTwoFactorService_VerifyChallenge_TOTPCode(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	challengeRepo := mocks.NewTwoFactorChallengeRepository(t)
+	svc := NewTwoFactorService(userRepo, nil, challengeRepo, config.Config{})
+
+	challenge := &domain.TwoFactorChallenge{
+		ID:        7,
+		UserID:    1,
+		ExpiresAt: time.Now().Add(time.Minute),
+		User:      *newTestTwoFactorUser(true),
+	}
+	code, _ := utils.TOTPCode(testTOTPSecret, time.Now())
+
+	challengeRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("challenge-token")).Return(challenge, nil)
+	challengeRepo.EXPECT().MarkAsUsed(mock.Anything, uint(7)).Return(true, nil)
+	userRepo.EXPECT().
+		UpdateTwoFactor(mock.Anything, mock.AnythingOfType("*domain.User")).
+		Run(func(ctx context.Context, user *domain.User) {
+			// The accepted step is stored, so the code can't be used again
+			assert.NotZero(t, user.TOTPLastStep)
+		}).
+		Return(nil)
+
+	// Act
+	user, err := svc.VerifyChallenge(context.Background(), "challenge-token", code)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), user.ID)
+}
+
+func TestTwoFactorService_VerifyChallenge_ReusedCodeRejected(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	recoveryCodeRepo := mocks.NewRecoveryCodeRepository(t)
+	challengeRepo := mocks.NewTwoFactorChallengeRepository(t)
+	svc := NewTwoFactorService(userRepo, recoveryCodeRepo, challengeRepo, config.Config{})
+
+	now := time.Now()
+	user := newTestTwoFactorUser(true)
+	user.TOTPLastStep = now.Unix() / 30
+	challenge := &domain.TwoFactorChallenge{ID: 7, UserID: 1, ExpiresAt: now.Add(time.Minute), User: *user}
+	code, _ := utils.TOTPCode(testTOTPSecret, now)
+
+	challengeRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("challenge-token")).Return(challenge, nil)
+	challengeRepo.EXPECT().IncrementAttempts(mock.Anything, uint(7)).Return(nil)
+
+	// Act
+	result, err := svc.VerifyChallenge(context.Background(), "challenge-token", code)
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidTwoFactorCode)
+	assert.Equal(t, uint(1), result.ID) // counted against the account
+}
+
+func TestTwoFactorService_VerifyChallenge_RecoveryCode(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	recoveryCodeRepo := mocks.NewRecoveryCodeRepository(t)
+	challengeRepo := mocks.NewTwoFactorChallengeRepository(t)
+	svc := NewTwoFactorService(userRepo, recoveryCodeRepo, challengeRepo, config.Config{})
+
+	challenge := &domain.TwoFactorChallenge{
+		ID:        7,
+		UserID:    1,
+		ExpiresAt: time.Now().Add(time.Minute),
+		User:      *newTestTwoFactorUser(true),
+	}
+
+	challengeRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("challenge-token")).Return(challenge, nil)
+	recoveryCodeRepo.EXPECT().
+		FindUnusedByHash(mock.Anything, uint(1), utils.HashToken("k3m9x-p2q7w")).
+		Return(&domain.RecoveryCode{ID: 3, UserID: 1}, nil)
+	recoveryCodeRepo.EXPECT().MarkAsUsed(mock.Anything, uint(3)).Return(true, nil)
+	challengeRepo.EXPECT().MarkAsUsed(mock.Anything, uint(7)).Return(true, nil)
+	userRepo.EXPECT().UpdateTwoFactor(mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
+
+	// Act
+	user, err := svc.VerifyChallenge(context.Background(), "challenge-token", " K3M9X-P2Q7W ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, user)
+}
+
+func TestTwoFactorService_VerifyChallenge_TooManyAttempts(t *testing.T) {
+	// Arrange
+	challengeRepo := mocks.NewTwoFactorChallengeRepository(t)
+	svc := NewTwoFactorService(nil, nil, challengeRepo, config.Config{})
+
+	challenge := &domain.TwoFactorChallenge{
+		ID:        7,
+		UserID:    1,
+		ExpiresAt: time.Now().Add(time.Minute),
+		Attempts:  maxTwoFactorAttempts,
+		User:      *newTestTwoFactorUser(true),
+	}
+
+	challengeRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken("challenge-token")).Return(challenge, nil)
+
+	// Act
+	user, err := svc.VerifyChallenge(context.Background(), "challenge-token", "123456")
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidTwoFactorChallenge)
+	assert.Equal(t, uint(1), user.ID)
+}
@@ -1,30 +1,74 @@
 package service
 
 import (
+	"archive/zip"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
 	"hopSpotAPI/internal/dto/responses"
 	"hopSpotAPI/internal/mapper"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/pkg/apperror"
+	"hopSpotAPI/pkg/logger"
+	"hopSpotAPI/pkg/storage"
 	"hopSpotAPI/pkg/utils"
 )
 
 type UserService interface {
 	GetProfile(ctx context.Context, userID uint) (*responses.UserResponse, error)
+	GetPublicProfile(ctx context.Context, userID uint) (*responses.PublicProfileResponse, error)
 	UpdateProfile(ctx context.Context, userID uint, req *requests.UpdateProfileRequest) (*responses.UserResponse, error)
-	ChangePassword(ctx context.Context, userID uint, req *requests.ChangePasswordRequest) error
+	// ChangePassword sets a new password and logs out every session except the current one
+	ChangePassword(ctx context.Context, userID, currentSessionID uint, req *requests.ChangePasswordRequest) error
+
+	// ExportData collects everything stored about the user
+	ExportData(ctx context.Context, userID uint) (*responses.AccountExportResponse, error)
+	// WriteExportArchive writes an export as ZIP with data.json and the original images of the photos
+	WriteExportArchive(ctx context.Context, export *responses.AccountExportResponse, w io.Writer) error
+	// DeleteAccount purges the personal data of the user after confirmation and revokes all tokens
+	DeleteAccount(ctx context.Context, userID uint, req *requests.DeleteAccountRequest) error
 }
 
+// recentActivitiesLimit is the number of activities shown on a public profile
+const recentActivitiesLimit = 10
+
+// accountDeletionReassign is the spot policy handing spots of deleted accounts to a configured user,
+// see config.AccountDeletionSpotPolicy
+const accountDeletionReassign = "reassign"
+
 type userService struct {
-	userRepo repository.UserRepository
-	config   config.Config
+	userRepo         repository.UserRepository
+	spotRepo         repository.SpotRepository
+	visitRepo        repository.VisitRepository
+	favoriteRepo     repository.FavoriteRepository
+	activityRepo     repository.ActivityRepository
+	accountRepo      repository.AccountRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	minioClient      *storage.MinioClient
+	tokenVersions    TokenVersionService
+	config           config.Config
 }
 
-func NewUserService(userRepo repository.UserRepository, cfg config.Config) UserService {
-	return &userService{userRepo: userRepo, config: cfg}
+func NewUserService(userRepo repository.UserRepository, spotRepo repository.SpotRepository, visitRepo repository.VisitRepository, favoriteRepo repository.FavoriteRepository, activityRepo repository.ActivityRepository, accountRepo repository.AccountRepository, refreshTokenRepo repository.RefreshTokenRepository, minioClient *storage.MinioClient, tokenVersions TokenVersionService, cfg config.Config) UserService {
+	return &userService{
+		userRepo:         userRepo,
+		spotRepo:         spotRepo,
+		visitRepo:        visitRepo,
+		favoriteRepo:     favoriteRepo,
+		activityRepo:     activityRepo,
+		accountRepo:      accountRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		minioClient:      minioClient,
+		tokenVersions:    tokenVersions,
+		config:           cfg,
+	}
 }
 
 func (u *userService) GetProfile(ctx context.Context, userID uint) (*responses.UserResponse, error) {
@@ -42,6 +86,44 @@ func (u *userService) GetProfile(ctx context.Context, userID uint) (*responses.U
 	return &response, nil
 }
 
+// GetPublicProfile implements UserService.
+// Deactivated users are hidden like unknown ones.
+func (u *userService) GetPublicProfile(ctx context.Context, userID uint) (*responses.PublicProfileResponse, error) {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.IsActive {
+		return nil, apperror.ErrUserNotFound
+	}
+
+	var stats responses.UserStatsResponse
+	if stats.SpotsCreated, err = u.spotRepo.CountByCreator(ctx, userID); err != nil {
+		return nil, err
+	}
+	if stats.VisitsLogged, err = u.visitRepo.CountByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	if stats.SpotsVisited, err = u.visitRepo.CountDistinctSpotsByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	if stats.Favorites, err = u.favoriteRepo.CountByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	activities, _, err := u.activityRepo.FindAll(ctx, repository.ActivityFilter{
+		Page:   1,
+		Limit:  recentActivitiesLimit,
+		UserID: &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := mapper.UserToPublicProfileResponse(user, stats, activities)
+	return &response, nil
+}
+
 // UpdateProfile implements UserService.
 func (u *userService) UpdateProfile(ctx context.Context, userID uint, req *requests.UpdateProfileRequest) (*responses.UserResponse, error) {
 	// Find the user by ID
@@ -68,7 +150,7 @@ func (u *userService) UpdateProfile(ctx context.Context, userID uint, req *reque
 	return &response, nil
 }
 
-func (u *userService) ChangePassword(ctx context.Context, userID uint, req *requests.ChangePasswordRequest) error {
+func (u *userService) ChangePassword(ctx context.Context, userID, currentSessionID uint, req *requests.ChangePasswordRequest) error {
 	// Find the user by ID
 	user, err := u.userRepo.FindByID(ctx, userID)
 	if err != nil {
@@ -91,5 +173,135 @@ func (u *userService) ChangePassword(ctx context.Context, userID uint, req *requ
 
 	// Update the user's password
 	user.PasswordHash = newHashedPassword
-	return u.userRepo.Update(ctx, user)
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Sessions started with the old password are logged out, the current device stays logged in
+	if err := u.refreshTokenRepo.RevokeOthers(ctx, user.ID, currentSessionID); err != nil {
+		return err
+	}
+
+	// Access tokens issued with the old password stop working
+	return u.tokenVersions.Invalidate(ctx, user.ID)
+}
+
+// ExportData implements UserService.
+func (u *userService) ExportData(ctx context.Context, userID uint) (*responses.AccountExportResponse, error) {
+	data, err := u.accountRepo.FindData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, apperror.ErrUserNotFound
+	}
+
+	export := mapper.AccountDataToExportResponse(data)
+	export.ExportedAt = time.Now()
+	return &export, nil
+}
+
+// WriteExportArchive implements UserService.
+// Photos that can't be read from storage are left out, the archive is already being sent.
+func (u *userService) WriteExportArchive(ctx context.Context, export *responses.AccountExportResponse, w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	dataFile, err := archive.Create("data.json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(dataFile)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(export); err != nil {
+		return err
+	}
+
+	for _, photo := range export.Photos {
+		if err := u.addPhotoToArchive(ctx, archive, photo.ID, photo.URLOriginal); err != nil {
+			logger.Warn().Err(err).Uint("photoID", photo.ID).Msg("Photo left out of data export")
+		}
+	}
+
+	return archive.Close()
+}
+
+func (u *userService) addPhotoToArchive(ctx context.Context, archive *zip.Writer, photoID uint, objectName string) error {
+	reader, err := u.minioClient.Download(ctx, objectName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	photoFile, err := archive.Create(fmt.Sprintf("photos/%d.jpg", photoID))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(photoFile, reader)
+	return err
+}
+
+// DeleteAccount implements UserService.
+// Accounts without password confirm with their email, they have nothing else to enter.
+func (u *userService) DeleteAccount(ctx context.Context, userID uint, req *requests.DeleteAccountRequest) error {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return apperror.ErrUserNotFound
+	}
+
+	if !confirmsAccount(user, req.Password, req.Email) {
+		return apperror.ErrInvalidCredentials
+	}
+
+	spotOwnerID, err := u.spotOwnerFor(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Revoke first, a cached token version must not outlive the user
+	if err := u.tokenVersions.Invalidate(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := u.accountRepo.Delete(ctx, userID, spotOwnerID); err != nil {
+		return err
+	}
+
+	logger.Info().Uint("userID", userID).Bool("spotsReassigned", spotOwnerID != nil).Msg("Account deleted by user")
+	return nil
+}
+
+// confirmsAccount checks the confirmation of a sensitive account change: the password,
+// or the current email for accounts without password (external login only)
+func confirmsAccount(user *domain.User, password, email string) bool {
+	if user.HasPassword() {
+		return utils.CheckPasswordHash(password, user.PasswordHash)
+	}
+	return email != "" && strings.EqualFold(email, user.Email)
+}
+
+// spotOwnerFor returns who gets the spots of a deleted account, nil to anonymize them.
+// A missing owner falls back to anonymizing, the deletion must not fail on configuration.
+func (u *userService) spotOwnerFor(ctx context.Context, userID uint) (*uint, error) {
+	if u.config.AccountDeletionSpotPolicy != accountDeletionReassign {
+		return nil, nil
+	}
+
+	ownerID := u.config.AccountDeletionSpotOwnerID
+	if ownerID == userID {
+		return nil, nil
+	}
+
+	owner, err := u.userRepo.FindByID(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if owner == nil {
+		logger.Warn().Uint("ownerID", ownerID).Msg("Spot owner for deleted accounts not found, anonymizing instead")
+		return nil, nil
+	}
+
+	return &ownerID, nil
 }
@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"hopSpotAPI/internal/config"
 	"hopSpotAPI/internal/domain"
 	"hopSpotAPI/internal/dto/requests"
+	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
 	"hopSpotAPI/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -19,7 +22,7 @@ func TestUserService_GetProfile_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
 
 	user := &domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -48,7 +51,7 @@ func TestUserService_GetProfile_UserNotFound(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
 
 	userRepo.EXPECT().
 		FindByID(mock.Anything, uint(999)).
@@ -67,7 +70,7 @@ func TestUserService_UpdateProfile_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
 
 	user := &domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -106,7 +109,7 @@ func TestUserService_UpdateProfile_UserNotFound(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
 
 	newName := "New Name"
 	req := &requests.UpdateProfileRequest{
@@ -130,7 +133,7 @@ func TestUserService_UpdateProfile_NoChanges(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
 
 	user := &domain.User{
 		Model:       &gorm.Model{ID: 1},
@@ -163,8 +166,10 @@ func TestUserService_UpdateProfile_NoChanges(t *testing.T) {
 func TestUserService_ChangePassword_Success(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, refreshTokenRepo, nil, tokenVersions, cfg)
 
 	oldPassword := "OldPassword123!"
 	hashedOldPassword, _ := utils.HashPassword(oldPassword)
@@ -197,18 +202,84 @@ func TestUserService_ChangePassword_Success(t *testing.T) {
 		}).
 		Return(nil)
 
+	refreshTokenRepo.EXPECT().RevokeOthers(mock.Anything, uint(1), uint(5)).Return(nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+
 	// Act
-	err := svc.ChangePassword(context.Background(), uint(1), req)
+	err := svc.ChangePassword(context.Background(), uint(1), uint(5), req)
 
 	// Assert
 	assert.NoError(t, err)
 }
 
+func TestUserService_ChangePassword_OtherSessionsRejectedOnRefresh(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, refreshTokenRepo, nil, tokenVersions, config.Config{})
+
+	cfg := config.Config{
+		JWTSecret:          "test-secret-min-32-characters-long",
+		JWTExpire:          time.Hour,
+		RefreshTokenExpire: 24 * time.Hour,
+	}
+	authSvc := NewAuthService(userRepo, nil, refreshTokenRepo, nil, nil, nil, nil, nil, nil, newNoopAuditService(t), utils.NewHMACJWTKeys(cfg.JWTSecret), cfg)
+
+	hashedOldPassword, _ := utils.HashPassword("OldPassword123!")
+	user := &domain.User{
+		Model:        &gorm.Model{ID: 1},
+		Email:        "test@example.com",
+		PasswordHash: hashedOldPassword,
+		IsActive:     true,
+	}
+
+	sessions := map[string]*domain.RefreshToken{
+		"current-token": {Model: &gorm.Model{ID: 5, CreatedAt: time.Now()}, UserID: 1, ExpiresAt: time.Now().Add(time.Hour), User: *user},
+		"other-token":   {Model: &gorm.Model{ID: 6, CreatedAt: time.Now()}, UserID: 1, ExpiresAt: time.Now().Add(time.Hour), User: *user},
+	}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(1)).Return(user, nil)
+	userRepo.EXPECT().Update(mock.Anything, user).Return(nil)
+	refreshTokenRepo.EXPECT().
+		RevokeOthers(mock.Anything, uint(1), uint(5)).
+		Run(func(ctx context.Context, userID, keepID uint) {
+			for _, session := range sessions {
+				if session.ID != keepID {
+					session.IsRevoked = true
+				}
+			}
+		}).
+		Return(nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+
+	for token, session := range sessions {
+		refreshTokenRepo.EXPECT().FindByTokenHash(mock.Anything, utils.HashToken(token)).Return(session, nil)
+	}
+	refreshTokenRepo.EXPECT().Rotate(mock.Anything, sessions["current-token"], utils.HashToken("current-token")).Return(nil)
+
+	// Act
+	err := svc.ChangePassword(context.Background(), uint(1), uint(5), &requests.ChangePasswordRequest{
+		OldPassword: "OldPassword123!",
+		NewPassword: "NewPassword456!",
+	})
+	assert.NoError(t, err)
+
+	otherResult, otherErr := authSvc.Refresh(context.Background(), &requests.RefreshTokenRequest{RefreshToken: "other-token"})
+	currentResult, currentErr := authSvc.Refresh(context.Background(), &requests.RefreshTokenRequest{RefreshToken: "current-token"})
+
+	// Assert
+	assert.ErrorIs(t, otherErr, apperror.ErrInvalidRefreshToken)
+	assert.Nil(t, otherResult)
+	assert.NoError(t, currentErr)
+	assert.NotNil(t, currentResult)
+}
+
 func TestUserService_ChangePassword_UserNotFound(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
 
 	req := &requests.ChangePasswordRequest{
 		OldPassword: "OldPassword123!",
@@ -220,7 +291,7 @@ func TestUserService_ChangePassword_UserNotFound(t *testing.T) {
 		Return(nil, nil) // User not found
 
 	// Act
-	err := svc.ChangePassword(context.Background(), uint(999), req)
+	err := svc.ChangePassword(context.Background(), uint(999), uint(5), req)
 
 	// Assert
 	assert.Error(t, err)
@@ -231,7 +302,7 @@ func TestUserService_ChangePassword_WrongOldPassword(t *testing.T) {
 	// Arrange
 	userRepo := mocks.NewUserRepository(t)
 	cfg := config.Config{}
-	svc := NewUserService(userRepo, cfg)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
 
 	hashedPassword, _ := utils.HashPassword("CorrectPassword123!")
 
@@ -254,9 +325,191 @@ func TestUserService_ChangePassword_WrongOldPassword(t *testing.T) {
 		Return(user, nil)
 
 	// Act
-	err := svc.ChangePassword(context.Background(), uint(1), req)
+	err := svc.ChangePassword(context.Background(), uint(1), uint(5), req)
 
 	// Assert
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid credentials")
 }
+
+func TestUserService_GetPublicProfile_Success(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	spotRepo := mocks.NewSpotRepository(t)
+	visitRepo := mocks.NewVisitRepository(t)
+	favoriteRepo := mocks.NewFavoriteRepository(t)
+	activityRepo := mocks.NewActivityRepository(t)
+	svc := NewUserService(userRepo, spotRepo, visitRepo, favoriteRepo, activityRepo, nil, nil, nil, nil, config.Config{})
+
+	user := &domain.User{
+		Model:       &gorm.Model{ID: 2},
+		Email:       "secret@example.com",
+		DisplayName: "Wanderer",
+		Role:        domain.RoleAdmin,
+		IsActive:    true,
+	}
+	spotID := uint(9)
+	activities := []domain.Activity{
+		{ID: 1, UserID: 2, ActionType: domain.ActionVisitAdded, SpotID: &spotID, User: *user, Spot: &domain.Spot{ID: 9, Name: "Bank am See"}},
+	}
+
+	userRepo.EXPECT().FindByID(mock.Anything, uint(2)).Return(user, nil)
+	spotRepo.EXPECT().CountByCreator(mock.Anything, uint(2)).Return(int64(3), nil)
+	visitRepo.EXPECT().CountByUserID(mock.Anything, uint(2)).Return(int64(12), nil)
+	visitRepo.EXPECT().CountDistinctSpotsByUserID(mock.Anything, uint(2)).Return(int64(5), nil)
+	favoriteRepo.EXPECT().CountByUserID(mock.Anything, uint(2)).Return(int64(4), nil)
+	activityRepo.EXPECT().
+		FindAll(mock.Anything, mock.MatchedBy(func(f repository.ActivityFilter) bool {
+			return f.UserID != nil && *f.UserID == 2 && f.Limit == recentActivitiesLimit
+		})).
+		Return(activities, int64(1), nil)
+
+	// Act
+	result, err := svc.GetPublicProfile(context.Background(), uint(2))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Wanderer", result.DisplayName)
+	assert.Equal(t, int64(3), result.Stats.SpotsCreated)
+	assert.Equal(t, int64(12), result.Stats.VisitsLogged)
+	assert.Equal(t, int64(5), result.Stats.SpotsVisited)
+	assert.Equal(t, int64(4), result.Stats.Favorites)
+	assert.Len(t, result.RecentActivities, 1)
+	assert.Equal(t, "Bank am See", result.RecentActivities[0].Spot.Name)
+}
+
+func TestUserService_GetPublicProfile_Deactivated(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, config.Config{})
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(2)).
+		Return(&domain.User{Model: &gorm.Model{ID: 2}, IsActive: false}, nil)
+
+	// Act
+	result, err := svc.GetPublicProfile(context.Background(), uint(2))
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrUserNotFound)
+	assert.Nil(t, result)
+}
+
+func TestUserService_DeleteAccount_WrongPassword(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	accountRepo := mocks.NewAccountRepository(t)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, accountRepo, nil, nil, nil, config.Config{})
+
+	hashedPassword, _ := utils.HashPassword("Password123!")
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.User{Model: &gorm.Model{ID: 1}, PasswordHash: hashedPassword}, nil)
+
+	// Act
+	err := svc.DeleteAccount(context.Background(), 1, &requests.DeleteAccountRequest{Password: "wrong"})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrInvalidCredentials)
+	accountRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_DeleteAccount_Anonymize(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	accountRepo := mocks.NewAccountRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, accountRepo, nil, nil, tokenVersions, config.Config{
+		AccountDeletionSpotPolicy: "anonymize",
+	})
+
+	hashedPassword, _ := utils.HashPassword("Password123!")
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.User{Model: &gorm.Model{ID: 1}, PasswordHash: hashedPassword}, nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+	accountRepo.EXPECT().Delete(mock.Anything, uint(1), (*uint)(nil)).Return(nil)
+
+	// Act
+	err := svc.DeleteAccount(context.Background(), 1, &requests.DeleteAccountRequest{Password: "Password123!"})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestUserService_DeleteAccount_ReassignSpots(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	accountRepo := mocks.NewAccountRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, accountRepo, nil, nil, tokenVersions, config.Config{
+		AccountDeletionSpotPolicy:  "reassign",
+		AccountDeletionSpotOwnerID: 9,
+	})
+
+	hashedPassword, _ := utils.HashPassword("Password123!")
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.User{Model: &gorm.Model{ID: 1}, PasswordHash: hashedPassword}, nil)
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(9)).
+		Return(&domain.User{Model: &gorm.Model{ID: 9}}, nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+	accountRepo.EXPECT().
+		Delete(mock.Anything, uint(1), mock.AnythingOfType("*uint")).
+		Run(func(ctx context.Context, userID uint, spotOwnerID *uint) {
+			assert.Equal(t, uint(9), *spotOwnerID)
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.DeleteAccount(context.Background(), 1, &requests.DeleteAccountRequest{Password: "Password123!"})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestUserService_DeleteAccount_WithoutPassword(t *testing.T) {
+	// Arrange
+	userRepo := mocks.NewUserRepository(t)
+	accountRepo := mocks.NewAccountRepository(t)
+	tokenVersions := mocks.NewTokenVersionService(t)
+	svc := NewUserService(userRepo, nil, nil, nil, nil, accountRepo, nil, nil, tokenVersions, config.Config{})
+
+	userRepo.EXPECT().
+		FindByID(mock.Anything, uint(1)).
+		Return(&domain.User{Model: &gorm.Model{ID: 1}, Email: "user@example.com"}, nil)
+	tokenVersions.EXPECT().Invalidate(mock.Anything, uint(1)).Return(nil)
+	accountRepo.EXPECT().Delete(mock.Anything, uint(1), (*uint)(nil)).Return(nil)
+
+	// Act - accounts of an external login confirm with their email
+	err := svc.DeleteAccount(context.Background(), 1, &requests.DeleteAccountRequest{Email: "User@Example.com"})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestUserService_ExportData(t *testing.T) {
+	// Arrange
+	accountRepo := mocks.NewAccountRepository(t)
+	svc := NewUserService(nil, nil, nil, nil, nil, accountRepo, nil, nil, nil, config.Config{})
+
+	accountRepo.EXPECT().FindData(mock.Anything, uint(1)).Return(&domain.AccountData{
+		User:      domain.User{Model: &gorm.Model{ID: 1}, Email: "user@example.com"},
+		Favorites: []domain.Favorite{{ID: 4, UserID: 1, SpotID: 7}},
+		InvitationCodes: []domain.InvitationCode{
+			{Model: &gorm.Model{ID: 5}, Code: "INVITE", MaxUses: 3, UseCount: 1},
+		},
+	}, nil)
+
+	// Act
+	export, err := svc.ExportData(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", export.Profile.Email)
+	assert.Equal(t, uint(7), export.Favorites[0].SpotID)
+	assert.Equal(t, 1, export.InvitationCodes[0].UseCount)
+	assert.Empty(t, export.Visits)
+	assert.False(t, export.ExportedAt.IsZero())
+}
@@ -17,7 +17,7 @@ type VisitService interface {
 	Create(ctx context.Context, req *requests.CreateVisitRequest, userID uint) (*responses.VisitResponse, error)
 	List(ctx context.Context, req *requests.ListVisitsRequest, userID uint) (*responses.PaginatedVisitsResponse, error)
 	GetCountBySpotID(ctx context.Context, spotID uint) (int64, error)
-	Delete(ctx context.Context, visitID uint, userID uint) error
+	Delete(ctx context.Context, visitID uint, principal domain.Principal) error
 }
 
 type visitService struct {
@@ -124,15 +124,15 @@ func (v *visitService) getMainPhotoURL(ctx context.Context, spotID uint) *string
 	return &url
 }
 
-// Delete deletes a visit if it belongs to the user
-func (v *visitService) Delete(ctx context.Context, visitID uint, userID uint) error {
+// Delete deletes a visit if it belongs to the user or the role may delete visits of others
+func (v *visitService) Delete(ctx context.Context, visitID uint, principal domain.Principal) error {
 	// Check if visit exists and belongs to the user
 	visit, err := v.visitRepo.FindByID(ctx, visitID)
 	if err != nil {
 		return err
 	}
 
-	if visit.UserID != userID {
+	if !principal.CanActOn(visit.UserID, domain.PermissionVisitDeleteAny) {
 		return apperror.ErrForbidden
 	}
 
@@ -9,6 +9,7 @@ import (
 	"hopSpotAPI/internal/dto/requests"
 	"hopSpotAPI/internal/repository"
 	"hopSpotAPI/mocks"
+	"hopSpotAPI/pkg/apperror"
 	"hopSpotAPI/pkg/storage"
 
 	"github.com/stretchr/testify/assert"
@@ -22,7 +23,10 @@ func newTestVisitService(t *testing.T, visitRepo *mocks.VisitRepository) VisitSe
 	photoRepo := mocks.NewPhotoRepository(t)
 	// Return nil for all GetMainPhoto calls - no photos in tests
 	photoRepo.EXPECT().GetMainPhoto(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
-	return NewVisitService(visitRepo, photoRepo, (*storage.MinioClient)(nil), nil)
+	// Create runs the activity in a goroutine that may finish after the test, so it is optional
+	activityService := mocks.NewActivityService(t)
+	activityService.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return NewVisitService(visitRepo, photoRepo, (*storage.MinioClient)(nil), activityService)
 }
 
 func TestVisitService_Create_Success(t *testing.T) {
@@ -304,3 +308,36 @@ func TestVisitService_GetCountBySpotID_Zero(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), count)
 }
+
+func TestVisitService_Delete_VisitOfOtherUser(t *testing.T) {
+	// Arrange
+	visitRepo := mocks.NewVisitRepository(t)
+	svc := newTestVisitService(t, visitRepo)
+
+	visitRepo.EXPECT().
+		FindByID(mock.Anything, uint(4)).
+		Return(&domain.Visit{Model: &gorm.Model{ID: 4}, UserID: 2}, nil)
+
+	// Act - moderators don't have visit.delete.any
+	err := svc.Delete(context.Background(), uint(4), domain.Principal{UserID: 3, Role: domain.RoleModerator})
+
+	// Assert
+	assert.ErrorIs(t, err, apperror.ErrForbidden)
+}
+
+func TestVisitService_Delete_AsAdmin(t *testing.T) {
+	// Arrange
+	visitRepo := mocks.NewVisitRepository(t)
+	svc := newTestVisitService(t, visitRepo)
+
+	visitRepo.EXPECT().
+		FindByID(mock.Anything, uint(4)).
+		Return(&domain.Visit{Model: &gorm.Model{ID: 4}, UserID: 2}, nil)
+	visitRepo.EXPECT().Delete(mock.Anything, uint(4)).Return(nil)
+
+	// Act
+	err := svc.Delete(context.Background(), uint(4), domain.Principal{UserID: 1, Role: domain.RoleAdmin})
+
+	// Assert
+	assert.NoError(t, err)
+}
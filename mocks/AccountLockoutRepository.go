@@ -0,0 +1,264 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AccountLockoutRepository is an autogenerated mock type for the AccountLockoutRepository type
+type AccountLockoutRepository struct {
+	mock.Mock
+}
+
+type AccountLockoutRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountLockoutRepository) EXPECT() *AccountLockoutRepository_Expecter {
+	return &AccountLockoutRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, userID
+func (_m *AccountLockoutRepository) Delete(ctx context.Context, userID uint) (bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountLockoutRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AccountLockoutRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *AccountLockoutRepository_Expecter) Delete(ctx interface{}, userID interface{}) *AccountLockoutRepository_Delete_Call {
+	return &AccountLockoutRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID)}
+}
+
+func (_c *AccountLockoutRepository_Delete_Call) Run(run func(ctx context.Context, userID uint)) *AccountLockoutRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutRepository_Delete_Call) Return(_a0 bool, _a1 error) *AccountLockoutRepository_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountLockoutRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *AccountLockoutRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID
+func (_m *AccountLockoutRepository) FindByUserID(ctx context.Context, userID uint) (*domain.AccountLockout, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 *domain.AccountLockout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.AccountLockout, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.AccountLockout); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AccountLockout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountLockoutRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type AccountLockoutRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *AccountLockoutRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *AccountLockoutRepository_FindByUserID_Call {
+	return &AccountLockoutRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *AccountLockoutRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID uint)) *AccountLockoutRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutRepository_FindByUserID_Call) Return(_a0 *domain.AccountLockout, _a1 error) *AccountLockoutRepository_FindByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountLockoutRepository_FindByUserID_Call) RunAndReturn(run func(context.Context, uint) (*domain.AccountLockout, error)) *AccountLockoutRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function with given fields: ctx, userID, until
+func (_m *AccountLockoutRepository) Lock(ctx context.Context, userID uint, until time.Time) error {
+	ret := _m.Called(ctx, userID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) error); ok {
+		r0 = rf(ctx, userID, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountLockoutRepository_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type AccountLockoutRepository_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - until time.Time
+func (_e *AccountLockoutRepository_Expecter) Lock(ctx interface{}, userID interface{}, until interface{}) *AccountLockoutRepository_Lock_Call {
+	return &AccountLockoutRepository_Lock_Call{Call: _e.mock.On("Lock", ctx, userID, until)}
+}
+
+func (_c *AccountLockoutRepository_Lock_Call) Run(run func(ctx context.Context, userID uint, until time.Time)) *AccountLockoutRepository_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutRepository_Lock_Call) Return(_a0 error) *AccountLockoutRepository_Lock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountLockoutRepository_Lock_Call) RunAndReturn(run func(context.Context, uint, time.Time) error) *AccountLockoutRepository_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function with given fields: ctx, userID, resetBefore
+func (_m *AccountLockoutRepository) RecordFailure(ctx context.Context, userID uint, resetBefore time.Time) (*domain.AccountLockout, error) {
+	ret := _m.Called(ctx, userID, resetBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 *domain.AccountLockout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) (*domain.AccountLockout, error)); ok {
+		return rf(ctx, userID, resetBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) *domain.AccountLockout); ok {
+		r0 = rf(ctx, userID, resetBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AccountLockout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, time.Time) error); ok {
+		r1 = rf(ctx, userID, resetBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountLockoutRepository_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type AccountLockoutRepository_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - resetBefore time.Time
+func (_e *AccountLockoutRepository_Expecter) RecordFailure(ctx interface{}, userID interface{}, resetBefore interface{}) *AccountLockoutRepository_RecordFailure_Call {
+	return &AccountLockoutRepository_RecordFailure_Call{Call: _e.mock.On("RecordFailure", ctx, userID, resetBefore)}
+}
+
+func (_c *AccountLockoutRepository_RecordFailure_Call) Run(run func(ctx context.Context, userID uint, resetBefore time.Time)) *AccountLockoutRepository_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutRepository_RecordFailure_Call) Return(_a0 *domain.AccountLockout, _a1 error) *AccountLockoutRepository_RecordFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountLockoutRepository_RecordFailure_Call) RunAndReturn(run func(context.Context, uint, time.Time) (*domain.AccountLockout, error)) *AccountLockoutRepository_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccountLockoutRepository creates a new instance of AccountLockoutRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountLockoutRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountLockoutRepository {
+	mock := &AccountLockoutRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
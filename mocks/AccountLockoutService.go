@@ -0,0 +1,228 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+)
+
+// AccountLockoutService is an autogenerated mock type for the AccountLockoutService type
+type AccountLockoutService struct {
+	mock.Mock
+}
+
+type AccountLockoutService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountLockoutService) EXPECT() *AccountLockoutService_Expecter {
+	return &AccountLockoutService_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx, userID
+func (_m *AccountLockoutService) Check(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountLockoutService_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type AccountLockoutService_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *AccountLockoutService_Expecter) Check(ctx interface{}, userID interface{}) *AccountLockoutService_Check_Call {
+	return &AccountLockoutService_Check_Call{Call: _e.mock.On("Check", ctx, userID)}
+}
+
+func (_c *AccountLockoutService_Check_Call) Run(run func(ctx context.Context, userID uint)) *AccountLockoutService_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutService_Check_Call) Return(_a0 error) *AccountLockoutService_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountLockoutService_Check_Call) RunAndReturn(run func(context.Context, uint) error) *AccountLockoutService_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function with given fields: ctx, userID, client
+func (_m *AccountLockoutService) RecordFailure(ctx context.Context, userID uint, client requests.ClientInfo) error {
+	ret := _m.Called(ctx, userID, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, requests.ClientInfo) error); ok {
+		r0 = rf(ctx, userID, client)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountLockoutService_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type AccountLockoutService_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - client requests.ClientInfo
+func (_e *AccountLockoutService_Expecter) RecordFailure(ctx interface{}, userID interface{}, client interface{}) *AccountLockoutService_RecordFailure_Call {
+	return &AccountLockoutService_RecordFailure_Call{Call: _e.mock.On("RecordFailure", ctx, userID, client)}
+}
+
+func (_c *AccountLockoutService_RecordFailure_Call) Run(run func(ctx context.Context, userID uint, client requests.ClientInfo)) *AccountLockoutService_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(requests.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutService_RecordFailure_Call) Return(_a0 error) *AccountLockoutService_RecordFailure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountLockoutService_RecordFailure_Call) RunAndReturn(run func(context.Context, uint, requests.ClientInfo) error) *AccountLockoutService_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: ctx, userID
+func (_m *AccountLockoutService) Reset(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountLockoutService_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type AccountLockoutService_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *AccountLockoutService_Expecter) Reset(ctx interface{}, userID interface{}) *AccountLockoutService_Reset_Call {
+	return &AccountLockoutService_Reset_Call{Call: _e.mock.On("Reset", ctx, userID)}
+}
+
+func (_c *AccountLockoutService_Reset_Call) Run(run func(ctx context.Context, userID uint)) *AccountLockoutService_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutService_Reset_Call) Return(_a0 error) *AccountLockoutService_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountLockoutService_Reset_Call) RunAndReturn(run func(context.Context, uint) error) *AccountLockoutService_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlock provides a mock function with given fields: ctx, userID, adminID
+func (_m *AccountLockoutService) Unlock(ctx context.Context, userID uint, adminID uint) error {
+	ret := _m.Called(ctx, userID, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountLockoutService_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type AccountLockoutService_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - adminID uint
+func (_e *AccountLockoutService_Expecter) Unlock(ctx interface{}, userID interface{}, adminID interface{}) *AccountLockoutService_Unlock_Call {
+	return &AccountLockoutService_Unlock_Call{Call: _e.mock.On("Unlock", ctx, userID, adminID)}
+}
+
+func (_c *AccountLockoutService_Unlock_Call) Run(run func(ctx context.Context, userID uint, adminID uint)) *AccountLockoutService_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *AccountLockoutService_Unlock_Call) Return(_a0 error) *AccountLockoutService_Unlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountLockoutService_Unlock_Call) RunAndReturn(run func(context.Context, uint, uint) error) *AccountLockoutService_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccountLockoutService creates a new instance of AccountLockoutService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountLockoutService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountLockoutService {
+	mock := &AccountLockoutService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,145 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AccountRepository is an autogenerated mock type for the AccountRepository type
+type AccountRepository struct {
+	mock.Mock
+}
+
+type AccountRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccountRepository) EXPECT() *AccountRepository_Expecter {
+	return &AccountRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, userID, spotOwnerID
+func (_m *AccountRepository) Delete(ctx context.Context, userID uint, spotOwnerID *uint) error {
+	ret := _m.Called(ctx, userID, spotOwnerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *uint) error); ok {
+		r0 = rf(ctx, userID, spotOwnerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AccountRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - spotOwnerID *uint
+func (_e *AccountRepository_Expecter) Delete(ctx interface{}, userID interface{}, spotOwnerID interface{}) *AccountRepository_Delete_Call {
+	return &AccountRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, spotOwnerID)}
+}
+
+func (_c *AccountRepository_Delete_Call) Run(run func(ctx context.Context, userID uint, spotOwnerID *uint)) *AccountRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*uint))
+	})
+	return _c
+}
+
+func (_c *AccountRepository_Delete_Call) Return(_a0 error) *AccountRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountRepository_Delete_Call) RunAndReturn(run func(context.Context, uint, *uint) error) *AccountRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindData provides a mock function with given fields: ctx, userID
+func (_m *AccountRepository) FindData(ctx context.Context, userID uint) (*domain.AccountData, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindData")
+	}
+
+	var r0 *domain.AccountData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.AccountData, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.AccountData); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AccountData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountRepository_FindData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindData'
+type AccountRepository_FindData_Call struct {
+	*mock.Call
+}
+
+// FindData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *AccountRepository_Expecter) FindData(ctx interface{}, userID interface{}) *AccountRepository_FindData_Call {
+	return &AccountRepository_FindData_Call{Call: _e.mock.On("FindData", ctx, userID)}
+}
+
+func (_c *AccountRepository_FindData_Call) Run(run func(ctx context.Context, userID uint)) *AccountRepository_FindData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AccountRepository_FindData_Call) Return(_a0 *domain.AccountData, _a1 error) *AccountRepository_FindData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountRepository_FindData_Call) RunAndReturn(run func(context.Context, uint) (*domain.AccountData, error)) *AccountRepository_FindData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccountRepository creates a new instance of AccountRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccountRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccountRepository {
+	mock := &AccountRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
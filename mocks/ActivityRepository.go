@@ -0,0 +1,200 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "hopSpotAPI/internal/repository"
+)
+
+// ActivityRepository is an autogenerated mock type for the ActivityRepository type
+type ActivityRepository struct {
+	mock.Mock
+}
+
+type ActivityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ActivityRepository) EXPECT() *ActivityRepository_Expecter {
+	return &ActivityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, activity
+func (_m *ActivityRepository) Create(ctx context.Context, activity *domain.Activity) error {
+	ret := _m.Called(ctx, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Activity) error); ok {
+		r0 = rf(ctx, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ActivityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ActivityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - activity *domain.Activity
+func (_e *ActivityRepository_Expecter) Create(ctx interface{}, activity interface{}) *ActivityRepository_Create_Call {
+	return &ActivityRepository_Create_Call{Call: _e.mock.On("Create", ctx, activity)}
+}
+
+func (_c *ActivityRepository_Create_Call) Run(run func(ctx context.Context, activity *domain.Activity)) *ActivityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Activity))
+	})
+	return _c
+}
+
+func (_c *ActivityRepository_Create_Call) Return(_a0 error) *ActivityRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ActivityRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Activity) error) *ActivityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBySpotID provides a mock function with given fields: ctx, spotID
+func (_m *ActivityRepository) DeleteBySpotID(ctx context.Context, spotID uint) error {
+	ret := _m.Called(ctx, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySpotID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, spotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ActivityRepository_DeleteBySpotID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBySpotID'
+type ActivityRepository_DeleteBySpotID_Call struct {
+	*mock.Call
+}
+
+// DeleteBySpotID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+func (_e *ActivityRepository_Expecter) DeleteBySpotID(ctx interface{}, spotID interface{}) *ActivityRepository_DeleteBySpotID_Call {
+	return &ActivityRepository_DeleteBySpotID_Call{Call: _e.mock.On("DeleteBySpotID", ctx, spotID)}
+}
+
+func (_c *ActivityRepository_DeleteBySpotID_Call) Run(run func(ctx context.Context, spotID uint)) *ActivityRepository_DeleteBySpotID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ActivityRepository_DeleteBySpotID_Call) Return(_a0 error) *ActivityRepository_DeleteBySpotID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ActivityRepository_DeleteBySpotID_Call) RunAndReturn(run func(context.Context, uint) error) *ActivityRepository_DeleteBySpotID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: ctx, filter
+func (_m *ActivityRepository) FindAll(ctx context.Context, filter repository.ActivityFilter) ([]domain.Activity, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.Activity
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ActivityFilter) ([]domain.Activity, int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ActivityFilter) []domain.Activity); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ActivityFilter) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.ActivityFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ActivityRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type ActivityRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter repository.ActivityFilter
+func (_e *ActivityRepository_Expecter) FindAll(ctx interface{}, filter interface{}) *ActivityRepository_FindAll_Call {
+	return &ActivityRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, filter)}
+}
+
+func (_c *ActivityRepository_FindAll_Call) Run(run func(ctx context.Context, filter repository.ActivityFilter)) *ActivityRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ActivityFilter))
+	})
+	return _c
+}
+
+func (_c *ActivityRepository_FindAll_Call) Return(_a0 []domain.Activity, _a1 int64, _a2 error) *ActivityRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ActivityRepository_FindAll_Call) RunAndReturn(run func(context.Context, repository.ActivityFilter) ([]domain.Activity, int64, error)) *ActivityRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewActivityRepository creates a new instance of ActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityRepository {
+	mock := &ActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
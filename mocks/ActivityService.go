@@ -0,0 +1,149 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// ActivityService is an autogenerated mock type for the ActivityService type
+type ActivityService struct {
+	mock.Mock
+}
+
+type ActivityService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ActivityService) EXPECT() *ActivityService_Expecter {
+	return &ActivityService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, userID, actionType, spotID
+func (_m *ActivityService) Create(ctx context.Context, userID uint, actionType string, spotID *uint) error {
+	ret := _m.Called(ctx, userID, actionType, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, *uint) error); ok {
+		r0 = rf(ctx, userID, actionType, spotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ActivityService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ActivityService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - actionType string
+//   - spotID *uint
+func (_e *ActivityService_Expecter) Create(ctx interface{}, userID interface{}, actionType interface{}, spotID interface{}) *ActivityService_Create_Call {
+	return &ActivityService_Create_Call{Call: _e.mock.On("Create", ctx, userID, actionType, spotID)}
+}
+
+func (_c *ActivityService_Create_Call) Run(run func(ctx context.Context, userID uint, actionType string, spotID *uint)) *ActivityService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string), args[3].(*uint))
+	})
+	return _c
+}
+
+func (_c *ActivityService_Create_Call) Return(_a0 error) *ActivityService_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ActivityService_Create_Call) RunAndReturn(run func(context.Context, uint, string, *uint) error) *ActivityService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID, req
+func (_m *ActivityService) List(ctx context.Context, userID uint, req *requests.ListActivitiesRequest) (*responses.PaginatedActivitiesResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *responses.PaginatedActivitiesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListActivitiesRequest) (*responses.PaginatedActivitiesResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListActivitiesRequest) *responses.PaginatedActivitiesResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.PaginatedActivitiesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.ListActivitiesRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivityService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ActivityService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.ListActivitiesRequest
+func (_e *ActivityService_Expecter) List(ctx interface{}, userID interface{}, req interface{}) *ActivityService_List_Call {
+	return &ActivityService_List_Call{Call: _e.mock.On("List", ctx, userID, req)}
+}
+
+func (_c *ActivityService_List_Call) Run(run func(ctx context.Context, userID uint, req *requests.ListActivitiesRequest)) *ActivityService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.ListActivitiesRequest))
+	})
+	return _c
+}
+
+func (_c *ActivityService_List_Call) Return(_a0 *responses.PaginatedActivitiesResponse, _a1 error) *ActivityService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ActivityService_List_Call) RunAndReturn(run func(context.Context, uint, *requests.ListActivitiesRequest) (*responses.PaginatedActivitiesResponse, error)) *ActivityService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewActivityService creates a new instance of ActivityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityService {
+	mock := &ActivityService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,10 +5,10 @@ package mocks
 import (
 	context "context"
 
-	requests "hopSpotAPI/internal/dto/requests"
-
 	mock "github.com/stretchr/testify/mock"
 
+	requests "hopSpotAPI/internal/dto/requests"
+
 	responses "hopSpotAPI/internal/dto/responses"
 )
 
@@ -85,6 +85,115 @@ func (_c *AdminService_CreateInvitationCode_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DeactivateUser provides a mock function with given fields: ctx, id, adminID, req
+func (_m *AdminService) DeactivateUser(ctx context.Context, id uint, adminID uint, req *requests.DeactivateUserRequest) (*responses.DeactivateUsersResponse, error) {
+	ret := _m.Called(ctx, id, adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 *responses.DeactivateUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *requests.DeactivateUserRequest) (*responses.DeactivateUsersResponse, error)); ok {
+		return rf(ctx, id, adminID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *requests.DeactivateUserRequest) *responses.DeactivateUsersResponse); ok {
+		r0 = rf(ctx, id, adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.DeactivateUsersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *requests.DeactivateUserRequest) error); ok {
+		r1 = rf(ctx, id, adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminService_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type AdminService_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - adminID uint
+//   - req *requests.DeactivateUserRequest
+func (_e *AdminService_Expecter) DeactivateUser(ctx interface{}, id interface{}, adminID interface{}, req interface{}) *AdminService_DeactivateUser_Call {
+	return &AdminService_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", ctx, id, adminID, req)}
+}
+
+func (_c *AdminService_DeactivateUser_Call) Run(run func(ctx context.Context, id uint, adminID uint, req *requests.DeactivateUserRequest)) *AdminService_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*requests.DeactivateUserRequest))
+	})
+	return _c
+}
+
+func (_c *AdminService_DeactivateUser_Call) Return(_a0 *responses.DeactivateUsersResponse, _a1 error) *AdminService_DeactivateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminService_DeactivateUser_Call) RunAndReturn(run func(context.Context, uint, uint, *requests.DeactivateUserRequest) (*responses.DeactivateUsersResponse, error)) *AdminService_DeactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInvitationCode provides a mock function with given fields: ctx, id, adminID
+func (_m *AdminService) DeleteInvitationCode(ctx context.Context, id uint, adminID uint) error {
+	ret := _m.Called(ctx, id, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInvitationCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, id, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdminService_DeleteInvitationCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInvitationCode'
+type AdminService_DeleteInvitationCode_Call struct {
+	*mock.Call
+}
+
+// DeleteInvitationCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - adminID uint
+func (_e *AdminService_Expecter) DeleteInvitationCode(ctx interface{}, id interface{}, adminID interface{}) *AdminService_DeleteInvitationCode_Call {
+	return &AdminService_DeleteInvitationCode_Call{Call: _e.mock.On("DeleteInvitationCode", ctx, id, adminID)}
+}
+
+func (_c *AdminService_DeleteInvitationCode_Call) Run(run func(ctx context.Context, id uint, adminID uint)) *AdminService_DeleteInvitationCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *AdminService_DeleteInvitationCode_Call) Return(_a0 error) *AdminService_DeleteInvitationCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AdminService_DeleteInvitationCode_Call) RunAndReturn(run func(context.Context, uint, uint) error) *AdminService_DeleteInvitationCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUser provides a mock function with given fields: ctx, id, adminID
 func (_m *AdminService) DeleteUser(ctx context.Context, id uint, adminID uint) error {
 	ret := _m.Called(ctx, id, adminID)
@@ -133,6 +242,125 @@ func (_c *AdminService_DeleteUser_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// GetInviteTree provides a mock function with given fields: ctx, req
+func (_m *AdminService) GetInviteTree(ctx context.Context, req *requests.InviteTreeRequest) (*responses.InviteTreeResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInviteTree")
+	}
+
+	var r0 *responses.InviteTreeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.InviteTreeRequest) (*responses.InviteTreeResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.InviteTreeRequest) *responses.InviteTreeResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.InviteTreeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.InviteTreeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminService_GetInviteTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInviteTree'
+type AdminService_GetInviteTree_Call struct {
+	*mock.Call
+}
+
+// GetInviteTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.InviteTreeRequest
+func (_e *AdminService_Expecter) GetInviteTree(ctx interface{}, req interface{}) *AdminService_GetInviteTree_Call {
+	return &AdminService_GetInviteTree_Call{Call: _e.mock.On("GetInviteTree", ctx, req)}
+}
+
+func (_c *AdminService_GetInviteTree_Call) Run(run func(ctx context.Context, req *requests.InviteTreeRequest)) *AdminService_GetInviteTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.InviteTreeRequest))
+	})
+	return _c
+}
+
+func (_c *AdminService_GetInviteTree_Call) Return(_a0 *responses.InviteTreeResponse, _a1 error) *AdminService_GetInviteTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminService_GetInviteTree_Call) RunAndReturn(run func(context.Context, *requests.InviteTreeRequest) (*responses.InviteTreeResponse, error)) *AdminService_GetInviteTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserInviteTree provides a mock function with given fields: ctx, id, req
+func (_m *AdminService) GetUserInviteTree(ctx context.Context, id uint, req *requests.InviteTreeRequest) (*responses.InviteTreeNodeResponse, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserInviteTree")
+	}
+
+	var r0 *responses.InviteTreeNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.InviteTreeRequest) (*responses.InviteTreeNodeResponse, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.InviteTreeRequest) *responses.InviteTreeNodeResponse); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.InviteTreeNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.InviteTreeRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminService_GetUserInviteTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInviteTree'
+type AdminService_GetUserInviteTree_Call struct {
+	*mock.Call
+}
+
+// GetUserInviteTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - req *requests.InviteTreeRequest
+func (_e *AdminService_Expecter) GetUserInviteTree(ctx interface{}, id interface{}, req interface{}) *AdminService_GetUserInviteTree_Call {
+	return &AdminService_GetUserInviteTree_Call{Call: _e.mock.On("GetUserInviteTree", ctx, id, req)}
+}
+
+func (_c *AdminService_GetUserInviteTree_Call) Run(run func(ctx context.Context, id uint, req *requests.InviteTreeRequest)) *AdminService_GetUserInviteTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.InviteTreeRequest))
+	})
+	return _c
+}
+
+func (_c *AdminService_GetUserInviteTree_Call) Return(_a0 *responses.InviteTreeNodeResponse, _a1 error) *AdminService_GetUserInviteTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminService_GetUserInviteTree_Call) RunAndReturn(run func(context.Context, uint, *requests.InviteTreeRequest) (*responses.InviteTreeNodeResponse, error)) *AdminService_GetUserInviteTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListInvitationCodes provides a mock function with given fields: ctx, req
 func (_m *AdminService) ListInvitationCodes(ctx context.Context, req *requests.ListInvitationCodesRequest) (*responses.PaginatedInvitationCodesResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -251,9 +479,57 @@ func (_c *AdminService_ListUsers_Call) RunAndReturn(run func(context.Context, *r
 	return _c
 }
 
-// UpdateUser provides a mock function with given fields: ctx, id, req
-func (_m *AdminService) UpdateUser(ctx context.Context, id uint, req *requests.AdminUpdateUserRequest) (*responses.UserResponse, error) {
-	ret := _m.Called(ctx, id, req)
+// UnlockUser provides a mock function with given fields: ctx, id, adminID
+func (_m *AdminService) UnlockUser(ctx context.Context, id uint, adminID uint) error {
+	ret := _m.Called(ctx, id, adminID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, id, adminID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdminService_UnlockUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockUser'
+type AdminService_UnlockUser_Call struct {
+	*mock.Call
+}
+
+// UnlockUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - adminID uint
+func (_e *AdminService_Expecter) UnlockUser(ctx interface{}, id interface{}, adminID interface{}) *AdminService_UnlockUser_Call {
+	return &AdminService_UnlockUser_Call{Call: _e.mock.On("UnlockUser", ctx, id, adminID)}
+}
+
+func (_c *AdminService_UnlockUser_Call) Run(run func(ctx context.Context, id uint, adminID uint)) *AdminService_UnlockUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *AdminService_UnlockUser_Call) Return(_a0 error) *AdminService_UnlockUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AdminService_UnlockUser_Call) RunAndReturn(run func(context.Context, uint, uint) error) *AdminService_UnlockUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, id, adminID, req
+func (_m *AdminService) UpdateUser(ctx context.Context, id uint, adminID uint, req *requests.AdminUpdateUserRequest) (*responses.UserResponse, error) {
+	ret := _m.Called(ctx, id, adminID, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateUser")
@@ -261,19 +537,19 @@ func (_m *AdminService) UpdateUser(ctx context.Context, id uint, req *requests.A
 
 	var r0 *responses.UserResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.AdminUpdateUserRequest) (*responses.UserResponse, error)); ok {
-		return rf(ctx, id, req)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *requests.AdminUpdateUserRequest) (*responses.UserResponse, error)); ok {
+		return rf(ctx, id, adminID, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.AdminUpdateUserRequest) *responses.UserResponse); ok {
-		r0 = rf(ctx, id, req)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *requests.AdminUpdateUserRequest) *responses.UserResponse); ok {
+		r0 = rf(ctx, id, adminID, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*responses.UserResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.AdminUpdateUserRequest) error); ok {
-		r1 = rf(ctx, id, req)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *requests.AdminUpdateUserRequest) error); ok {
+		r1 = rf(ctx, id, adminID, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -289,14 +565,15 @@ type AdminService_UpdateUser_Call struct {
 // UpdateUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uint
+//   - adminID uint
 //   - req *requests.AdminUpdateUserRequest
-func (_e *AdminService_Expecter) UpdateUser(ctx interface{}, id interface{}, req interface{}) *AdminService_UpdateUser_Call {
-	return &AdminService_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, req)}
+func (_e *AdminService_Expecter) UpdateUser(ctx interface{}, id interface{}, adminID interface{}, req interface{}) *AdminService_UpdateUser_Call {
+	return &AdminService_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, adminID, req)}
 }
 
-func (_c *AdminService_UpdateUser_Call) Run(run func(ctx context.Context, id uint, req *requests.AdminUpdateUserRequest)) *AdminService_UpdateUser_Call {
+func (_c *AdminService_UpdateUser_Call) Run(run func(ctx context.Context, id uint, adminID uint, req *requests.AdminUpdateUserRequest)) *AdminService_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.AdminUpdateUserRequest))
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*requests.AdminUpdateUserRequest))
 	})
 	return _c
 }
@@ -306,7 +583,7 @@ func (_c *AdminService_UpdateUser_Call) Return(_a0 *responses.UserResponse, _a1
 	return _c
 }
 
-func (_c *AdminService_UpdateUser_Call) RunAndReturn(run func(context.Context, uint, *requests.AdminUpdateUserRequest) (*responses.UserResponse, error)) *AdminService_UpdateUser_Call {
+func (_c *AdminService_UpdateUser_Call) RunAndReturn(run func(context.Context, uint, uint, *requests.AdminUpdateUserRequest) (*responses.UserResponse, error)) *AdminService_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
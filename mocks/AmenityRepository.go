@@ -0,0 +1,414 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AmenityRepository is an autogenerated mock type for the AmenityRepository type
+type AmenityRepository struct {
+	mock.Mock
+}
+
+type AmenityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AmenityRepository) EXPECT() *AmenityRepository_Expecter {
+	return &AmenityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, amenity
+func (_m *AmenityRepository) Create(ctx context.Context, amenity *domain.Amenity) error {
+	ret := _m.Called(ctx, amenity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Amenity) error); ok {
+		r0 = rf(ctx, amenity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AmenityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AmenityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - amenity *domain.Amenity
+func (_e *AmenityRepository_Expecter) Create(ctx interface{}, amenity interface{}) *AmenityRepository_Create_Call {
+	return &AmenityRepository_Create_Call{Call: _e.mock.On("Create", ctx, amenity)}
+}
+
+func (_c *AmenityRepository_Create_Call) Run(run func(ctx context.Context, amenity *domain.Amenity)) *AmenityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Amenity))
+	})
+	return _c
+}
+
+func (_c *AmenityRepository_Create_Call) Return(_a0 error) *AmenityRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AmenityRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Amenity) error) *AmenityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *AmenityRepository) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AmenityRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AmenityRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *AmenityRepository_Expecter) Delete(ctx interface{}, id interface{}) *AmenityRepository_Delete_Call {
+	return &AmenityRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *AmenityRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *AmenityRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AmenityRepository_Delete_Call) Return(_a0 error) *AmenityRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AmenityRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *AmenityRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: ctx
+func (_m *AmenityRepository) FindAll(ctx context.Context) ([]domain.Amenity, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.Amenity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Amenity, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Amenity); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Amenity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmenityRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type AmenityRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AmenityRepository_Expecter) FindAll(ctx interface{}) *AmenityRepository_FindAll_Call {
+	return &AmenityRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *AmenityRepository_FindAll_Call) Run(run func(ctx context.Context)) *AmenityRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AmenityRepository_FindAll_Call) Return(_a0 []domain.Amenity, _a1 error) *AmenityRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmenityRepository_FindAll_Call) RunAndReturn(run func(context.Context) ([]domain.Amenity, error)) *AmenityRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *AmenityRepository) FindByID(ctx context.Context, id uint) (*domain.Amenity, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.Amenity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Amenity, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Amenity); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Amenity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmenityRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type AmenityRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *AmenityRepository_Expecter) FindByID(ctx interface{}, id interface{}) *AmenityRepository_FindByID_Call {
+	return &AmenityRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *AmenityRepository_FindByID_Call) Run(run func(ctx context.Context, id uint)) *AmenityRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AmenityRepository_FindByID_Call) Return(_a0 *domain.Amenity, _a1 error) *AmenityRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmenityRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Amenity, error)) *AmenityRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKey provides a mock function with given fields: ctx, key
+func (_m *AmenityRepository) FindByKey(ctx context.Context, key string) (*domain.Amenity, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKey")
+	}
+
+	var r0 *domain.Amenity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Amenity, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Amenity); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Amenity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmenityRepository_FindByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKey'
+type AmenityRepository_FindByKey_Call struct {
+	*mock.Call
+}
+
+// FindByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *AmenityRepository_Expecter) FindByKey(ctx interface{}, key interface{}) *AmenityRepository_FindByKey_Call {
+	return &AmenityRepository_FindByKey_Call{Call: _e.mock.On("FindByKey", ctx, key)}
+}
+
+func (_c *AmenityRepository_FindByKey_Call) Run(run func(ctx context.Context, key string)) *AmenityRepository_FindByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AmenityRepository_FindByKey_Call) Return(_a0 *domain.Amenity, _a1 error) *AmenityRepository_FindByKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmenityRepository_FindByKey_Call) RunAndReturn(run func(context.Context, string) (*domain.Amenity, error)) *AmenityRepository_FindByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKeys provides a mock function with given fields: ctx, keys
+func (_m *AmenityRepository) FindByKeys(ctx context.Context, keys []string) ([]domain.Amenity, error) {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKeys")
+	}
+
+	var r0 []domain.Amenity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]domain.Amenity, error)); ok {
+		return rf(ctx, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []domain.Amenity); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Amenity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmenityRepository_FindByKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKeys'
+type AmenityRepository_FindByKeys_Call struct {
+	*mock.Call
+}
+
+// FindByKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []string
+func (_e *AmenityRepository_Expecter) FindByKeys(ctx interface{}, keys interface{}) *AmenityRepository_FindByKeys_Call {
+	return &AmenityRepository_FindByKeys_Call{Call: _e.mock.On("FindByKeys", ctx, keys)}
+}
+
+func (_c *AmenityRepository_FindByKeys_Call) Run(run func(ctx context.Context, keys []string)) *AmenityRepository_FindByKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *AmenityRepository_FindByKeys_Call) Return(_a0 []domain.Amenity, _a1 error) *AmenityRepository_FindByKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmenityRepository_FindByKeys_Call) RunAndReturn(run func(context.Context, []string) ([]domain.Amenity, error)) *AmenityRepository_FindByKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, amenity
+func (_m *AmenityRepository) Update(ctx context.Context, amenity *domain.Amenity) error {
+	ret := _m.Called(ctx, amenity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Amenity) error); ok {
+		r0 = rf(ctx, amenity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AmenityRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type AmenityRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - amenity *domain.Amenity
+func (_e *AmenityRepository_Expecter) Update(ctx interface{}, amenity interface{}) *AmenityRepository_Update_Call {
+	return &AmenityRepository_Update_Call{Call: _e.mock.On("Update", ctx, amenity)}
+}
+
+func (_c *AmenityRepository_Update_Call) Run(run func(ctx context.Context, amenity *domain.Amenity)) *AmenityRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Amenity))
+	})
+	return _c
+}
+
+func (_c *AmenityRepository_Update_Call) Return(_a0 error) *AmenityRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AmenityRepository_Update_Call) RunAndReturn(run func(context.Context, *domain.Amenity) error) *AmenityRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAmenityRepository creates a new instance of AmenityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAmenityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AmenityRepository {
+	mock := &AmenityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
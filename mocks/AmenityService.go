@@ -0,0 +1,264 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// AmenityService is an autogenerated mock type for the AmenityService type
+type AmenityService struct {
+	mock.Mock
+}
+
+type AmenityService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AmenityService) EXPECT() *AmenityService_Expecter {
+	return &AmenityService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *AmenityService) Create(ctx context.Context, req *requests.CreateAmenityRequest) (*responses.AmenityResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *responses.AmenityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.CreateAmenityRequest) (*responses.AmenityResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.CreateAmenityRequest) *responses.AmenityResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.AmenityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.CreateAmenityRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmenityService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AmenityService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.CreateAmenityRequest
+func (_e *AmenityService_Expecter) Create(ctx interface{}, req interface{}) *AmenityService_Create_Call {
+	return &AmenityService_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *AmenityService_Create_Call) Run(run func(ctx context.Context, req *requests.CreateAmenityRequest)) *AmenityService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.CreateAmenityRequest))
+	})
+	return _c
+}
+
+func (_c *AmenityService_Create_Call) Return(_a0 *responses.AmenityResponse, _a1 error) *AmenityService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmenityService_Create_Call) RunAndReturn(run func(context.Context, *requests.CreateAmenityRequest) (*responses.AmenityResponse, error)) *AmenityService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *AmenityService) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AmenityService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AmenityService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *AmenityService_Expecter) Delete(ctx interface{}, id interface{}) *AmenityService_Delete_Call {
+	return &AmenityService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *AmenityService_Delete_Call) Run(run func(ctx context.Context, id uint)) *AmenityService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *AmenityService_Delete_Call) Return(_a0 error) *AmenityService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AmenityService_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *AmenityService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *AmenityService) List(ctx context.Context) ([]responses.AmenityResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []responses.AmenityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]responses.AmenityResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []responses.AmenityResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]responses.AmenityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmenityService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type AmenityService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AmenityService_Expecter) List(ctx interface{}) *AmenityService_List_Call {
+	return &AmenityService_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *AmenityService_List_Call) Run(run func(ctx context.Context)) *AmenityService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AmenityService_List_Call) Return(_a0 []responses.AmenityResponse, _a1 error) *AmenityService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmenityService_List_Call) RunAndReturn(run func(context.Context) ([]responses.AmenityResponse, error)) *AmenityService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *AmenityService) Update(ctx context.Context, id uint, req *requests.UpdateAmenityRequest) (*responses.AmenityResponse, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *responses.AmenityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.UpdateAmenityRequest) (*responses.AmenityResponse, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.UpdateAmenityRequest) *responses.AmenityResponse); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.AmenityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.UpdateAmenityRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmenityService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type AmenityService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - req *requests.UpdateAmenityRequest
+func (_e *AmenityService_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *AmenityService_Update_Call {
+	return &AmenityService_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *AmenityService_Update_Call) Run(run func(ctx context.Context, id uint, req *requests.UpdateAmenityRequest)) *AmenityService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.UpdateAmenityRequest))
+	})
+	return _c
+}
+
+func (_c *AmenityService_Update_Call) Return(_a0 *responses.AmenityResponse, _a1 error) *AmenityService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmenityService_Update_Call) RunAndReturn(run func(context.Context, uint, *requests.UpdateAmenityRequest) (*responses.AmenityResponse, error)) *AmenityService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAmenityService creates a new instance of AmenityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAmenityService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AmenityService {
+	mock := &AmenityService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
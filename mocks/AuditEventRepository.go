@@ -0,0 +1,212 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "hopSpotAPI/internal/repository"
+
+	time "time"
+)
+
+// AuditEventRepository is an autogenerated mock type for the AuditEventRepository type
+type AuditEventRepository struct {
+	mock.Mock
+}
+
+type AuditEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditEventRepository) EXPECT() *AuditEventRepository_Expecter {
+	return &AuditEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, event
+func (_m *AuditEventRepository) Create(ctx context.Context, event *domain.AuditEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AuditEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditEventRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AuditEventRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.AuditEvent
+func (_e *AuditEventRepository_Expecter) Create(ctx interface{}, event interface{}) *AuditEventRepository_Create_Call {
+	return &AuditEventRepository_Create_Call{Call: _e.mock.On("Create", ctx, event)}
+}
+
+func (_c *AuditEventRepository_Create_Call) Run(run func(ctx context.Context, event *domain.AuditEvent)) *AuditEventRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *AuditEventRepository_Create_Call) Return(_a0 error) *AuditEventRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditEventRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.AuditEvent) error) *AuditEventRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBefore provides a mock function with given fields: ctx, before
+func (_m *AuditEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditEventRepository_DeleteBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBefore'
+type AuditEventRepository_DeleteBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *AuditEventRepository_Expecter) DeleteBefore(ctx interface{}, before interface{}) *AuditEventRepository_DeleteBefore_Call {
+	return &AuditEventRepository_DeleteBefore_Call{Call: _e.mock.On("DeleteBefore", ctx, before)}
+}
+
+func (_c *AuditEventRepository_DeleteBefore_Call) Run(run func(ctx context.Context, before time.Time)) *AuditEventRepository_DeleteBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AuditEventRepository_DeleteBefore_Call) Return(_a0 int64, _a1 error) *AuditEventRepository_DeleteBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditEventRepository_DeleteBefore_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *AuditEventRepository_DeleteBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: ctx, filter
+func (_m *AuditEventRepository) FindAll(ctx context.Context, filter repository.AuditEventFilter) ([]domain.AuditEvent, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.AuditEvent
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.AuditEventFilter) ([]domain.AuditEvent, int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.AuditEventFilter) []domain.AuditEvent); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.AuditEventFilter) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.AuditEventFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AuditEventRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type AuditEventRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter repository.AuditEventFilter
+func (_e *AuditEventRepository_Expecter) FindAll(ctx interface{}, filter interface{}) *AuditEventRepository_FindAll_Call {
+	return &AuditEventRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, filter)}
+}
+
+func (_c *AuditEventRepository_FindAll_Call) Run(run func(ctx context.Context, filter repository.AuditEventFilter)) *AuditEventRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.AuditEventFilter))
+	})
+	return _c
+}
+
+func (_c *AuditEventRepository_FindAll_Call) Return(_a0 []domain.AuditEvent, _a1 int64, _a2 error) *AuditEventRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *AuditEventRepository_FindAll_Call) RunAndReturn(run func(context.Context, repository.AuditEventFilter) ([]domain.AuditEvent, int64, error)) *AuditEventRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditEventRepository creates a new instance of AuditEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditEventRepository {
+	mock := &AuditEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
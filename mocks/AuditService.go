@@ -0,0 +1,191 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// AuditService is an autogenerated mock type for the AuditService type
+type AuditService struct {
+	mock.Mock
+}
+
+type AuditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditService) EXPECT() *AuditService_Expecter {
+	return &AuditService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, req
+func (_m *AuditService) List(ctx context.Context, req *requests.ListAuditEventsRequest) (*responses.PaginatedAuditEventsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *responses.PaginatedAuditEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.ListAuditEventsRequest) (*responses.PaginatedAuditEventsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.ListAuditEventsRequest) *responses.PaginatedAuditEventsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.PaginatedAuditEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.ListAuditEventsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type AuditService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.ListAuditEventsRequest
+func (_e *AuditService_Expecter) List(ctx interface{}, req interface{}) *AuditService_List_Call {
+	return &AuditService_List_Call{Call: _e.mock.On("List", ctx, req)}
+}
+
+func (_c *AuditService_List_Call) Run(run func(ctx context.Context, req *requests.ListAuditEventsRequest)) *AuditService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.ListAuditEventsRequest))
+	})
+	return _c
+}
+
+func (_c *AuditService_List_Call) Return(_a0 *responses.PaginatedAuditEventsResponse, _a1 error) *AuditService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditService_List_Call) RunAndReturn(run func(context.Context, *requests.ListAuditEventsRequest) (*responses.PaginatedAuditEventsResponse, error)) *AuditService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpired provides a mock function with given fields: ctx
+func (_m *AuditService) PurgeExpired(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditService_PurgeExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpired'
+type AuditService_PurgeExpired_Call struct {
+	*mock.Call
+}
+
+// PurgeExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuditService_Expecter) PurgeExpired(ctx interface{}) *AuditService_PurgeExpired_Call {
+	return &AuditService_PurgeExpired_Call{Call: _e.mock.On("PurgeExpired", ctx)}
+}
+
+func (_c *AuditService_PurgeExpired_Call) Run(run func(ctx context.Context)) *AuditService_PurgeExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuditService_PurgeExpired_Call) Return(_a0 int64, _a1 error) *AuditService_PurgeExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditService_PurgeExpired_Call) RunAndReturn(run func(context.Context) (int64, error)) *AuditService_PurgeExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, event
+func (_m *AuditService) Record(ctx context.Context, event *domain.AuditEvent) {
+	_m.Called(ctx, event)
+}
+
+// AuditService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type AuditService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.AuditEvent
+func (_e *AuditService_Expecter) Record(ctx interface{}, event interface{}) *AuditService_Record_Call {
+	return &AuditService_Record_Call{Call: _e.mock.On("Record", ctx, event)}
+}
+
+func (_c *AuditService_Record_Call) Run(run func(ctx context.Context, event *domain.AuditEvent)) *AuditService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *AuditService_Record_Call) Return() *AuditService_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuditService_Record_Call) RunAndReturn(run func(context.Context, *domain.AuditEvent)) *AuditService_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewAuditService creates a new instance of AuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditService {
+	mock := &AuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,10 +5,10 @@ package mocks
 import (
 	context "context"
 
-	requests "hopSpotAPI/internal/dto/requests"
-
 	mock "github.com/stretchr/testify/mock"
 
+	requests "hopSpotAPI/internal/dto/requests"
+
 	responses "hopSpotAPI/internal/dto/responses"
 )
 
@@ -25,6 +25,66 @@ func (_m *AuthService) EXPECT() *AuthService_Expecter {
 	return &AuthService_Expecter{mock: &_m.Mock}
 }
 
+// ListSessions provides a mock function with given fields: ctx, userID, currentSessionID
+func (_m *AuthService) ListSessions(ctx context.Context, userID uint, currentSessionID uint) ([]responses.SessionResponse, error) {
+	ret := _m.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []responses.SessionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) ([]responses.SessionResponse, error)); ok {
+		return rf(ctx, userID, currentSessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) []responses.SessionResponse); ok {
+		r0 = rf(ctx, userID, currentSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]responses.SessionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AuthService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - currentSessionID uint
+func (_e *AuthService_Expecter) ListSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *AuthService_ListSessions_Call {
+	return &AuthService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *AuthService_ListSessions_Call) Run(run func(ctx context.Context, userID uint, currentSessionID uint)) *AuthService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *AuthService_ListSessions_Call) Return(_a0 []responses.SessionResponse, _a1 error) *AuthService_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_ListSessions_Call) RunAndReturn(run func(context.Context, uint, uint) ([]responses.SessionResponse, error)) *AuthService_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function with given fields: ctx, req
 func (_m *AuthService) Login(ctx context.Context, req *requests.LoginRequest) (*responses.LoginResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -84,6 +144,125 @@ func (_c *AuthService_Login_Call) RunAndReturn(run func(context.Context, *reques
 	return _c
 }
 
+// LoginOIDC provides a mock function with given fields: ctx, provider, req
+func (_m *AuthService) LoginOIDC(ctx context.Context, provider string, req *requests.OIDCLoginRequest) (*responses.LoginResponse, error) {
+	ret := _m.Called(ctx, provider, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginOIDC")
+	}
+
+	var r0 *responses.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *requests.OIDCLoginRequest) (*responses.LoginResponse, error)); ok {
+		return rf(ctx, provider, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *requests.OIDCLoginRequest) *responses.LoginResponse); ok {
+		r0 = rf(ctx, provider, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *requests.OIDCLoginRequest) error); ok {
+		r1 = rf(ctx, provider, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_LoginOIDC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginOIDC'
+type AuthService_LoginOIDC_Call struct {
+	*mock.Call
+}
+
+// LoginOIDC is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - req *requests.OIDCLoginRequest
+func (_e *AuthService_Expecter) LoginOIDC(ctx interface{}, provider interface{}, req interface{}) *AuthService_LoginOIDC_Call {
+	return &AuthService_LoginOIDC_Call{Call: _e.mock.On("LoginOIDC", ctx, provider, req)}
+}
+
+func (_c *AuthService_LoginOIDC_Call) Run(run func(ctx context.Context, provider string, req *requests.OIDCLoginRequest)) *AuthService_LoginOIDC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*requests.OIDCLoginRequest))
+	})
+	return _c
+}
+
+func (_c *AuthService_LoginOIDC_Call) Return(_a0 *responses.LoginResponse, _a1 error) *AuthService_LoginOIDC_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_LoginOIDC_Call) RunAndReturn(run func(context.Context, string, *requests.OIDCLoginRequest) (*responses.LoginResponse, error)) *AuthService_LoginOIDC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginTwoFactor provides a mock function with given fields: ctx, req
+func (_m *AuthService) LoginTwoFactor(ctx context.Context, req *requests.LoginTwoFactorRequest) (*responses.LoginResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginTwoFactor")
+	}
+
+	var r0 *responses.LoginResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.LoginTwoFactorRequest) (*responses.LoginResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.LoginTwoFactorRequest) *responses.LoginResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.LoginResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.LoginTwoFactorRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_LoginTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginTwoFactor'
+type AuthService_LoginTwoFactor_Call struct {
+	*mock.Call
+}
+
+// LoginTwoFactor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.LoginTwoFactorRequest
+func (_e *AuthService_Expecter) LoginTwoFactor(ctx interface{}, req interface{}) *AuthService_LoginTwoFactor_Call {
+	return &AuthService_LoginTwoFactor_Call{Call: _e.mock.On("LoginTwoFactor", ctx, req)}
+}
+
+func (_c *AuthService_LoginTwoFactor_Call) Run(run func(ctx context.Context, req *requests.LoginTwoFactorRequest)) *AuthService_LoginTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.LoginTwoFactorRequest))
+	})
+	return _c
+}
+
+func (_c *AuthService_LoginTwoFactor_Call) Return(_a0 *responses.LoginResponse, _a1 error) *AuthService_LoginTwoFactor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_LoginTwoFactor_Call) RunAndReturn(run func(context.Context, *requests.LoginTwoFactorRequest) (*responses.LoginResponse, error)) *AuthService_LoginTwoFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Logout provides a mock function with given fields: ctx, req
 func (_m *AuthService) Logout(ctx context.Context, req *requests.LogoutRequest) error {
 	ret := _m.Called(ctx, req)
@@ -297,6 +476,102 @@ func (_c *AuthService_Register_Call) RunAndReturn(run func(context.Context, *req
 	return _c
 }
 
+// RevokeOtherSessions provides a mock function with given fields: ctx, userID, currentSessionID
+func (_m *AuthService) RevokeOtherSessions(ctx context.Context, userID uint, currentSessionID uint) error {
+	ret := _m.Called(ctx, userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, currentSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type AuthService_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - currentSessionID uint
+func (_e *AuthService_Expecter) RevokeOtherSessions(ctx interface{}, userID interface{}, currentSessionID interface{}) *AuthService_RevokeOtherSessions_Call {
+	return &AuthService_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx, userID, currentSessionID)}
+}
+
+func (_c *AuthService_RevokeOtherSessions_Call) Run(run func(ctx context.Context, userID uint, currentSessionID uint)) *AuthService_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeOtherSessions_Call) Return(_a0 error) *AuthService_RevokeOtherSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthService_RevokeOtherSessions_Call) RunAndReturn(run func(context.Context, uint, uint) error) *AuthService_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *AuthService) RevokeSession(ctx context.Context, userID uint, sessionID uint) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AuthService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - sessionID uint
+func (_e *AuthService_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *AuthService_RevokeSession_Call {
+	return &AuthService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
+}
+
+func (_c *AuthService_RevokeSession_Call) Run(run func(ctx context.Context, userID uint, sessionID uint)) *AuthService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeSession_Call) Return(_a0 error) *AuthService_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthService_RevokeSession_Call) RunAndReturn(run func(context.Context, uint, uint) error) *AuthService_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuthService creates a new instance of AuthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthService(t interface {
@@ -0,0 +1,249 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EmailTokenRepository is an autogenerated mock type for the EmailTokenRepository type
+type EmailTokenRepository struct {
+	mock.Mock
+}
+
+type EmailTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmailTokenRepository) EXPECT() *EmailTokenRepository_Expecter {
+	return &EmailTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, token
+func (_m *EmailTokenRepository) Create(ctx context.Context, token *domain.EmailToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EmailToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type EmailTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *domain.EmailToken
+func (_e *EmailTokenRepository_Expecter) Create(ctx interface{}, token interface{}) *EmailTokenRepository_Create_Call {
+	return &EmailTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *EmailTokenRepository_Create_Call) Run(run func(ctx context.Context, token *domain.EmailToken)) *EmailTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.EmailToken))
+	})
+	return _c
+}
+
+func (_c *EmailTokenRepository_Create_Call) Return(_a0 error) *EmailTokenRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailTokenRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.EmailToken) error) *EmailTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *EmailTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 *domain.EmailToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.EmailToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.EmailToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.EmailToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmailTokenRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type EmailTokenRepository_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *EmailTokenRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *EmailTokenRepository_FindByTokenHash_Call {
+	return &EmailTokenRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *EmailTokenRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *EmailTokenRepository_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *EmailTokenRepository_FindByTokenHash_Call) Return(_a0 *domain.EmailToken, _a1 error) *EmailTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmailTokenRepository_FindByTokenHash_Call) RunAndReturn(run func(context.Context, string) (*domain.EmailToken, error)) *EmailTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateByUserID provides a mock function with given fields: ctx, userID, purpose
+func (_m *EmailTokenRepository) InvalidateByUserID(ctx context.Context, userID uint, purpose string) error {
+	ret := _m.Called(ctx, userID, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) error); ok {
+		r0 = rf(ctx, userID, purpose)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailTokenRepository_InvalidateByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateByUserID'
+type EmailTokenRepository_InvalidateByUserID_Call struct {
+	*mock.Call
+}
+
+// InvalidateByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - purpose string
+func (_e *EmailTokenRepository_Expecter) InvalidateByUserID(ctx interface{}, userID interface{}, purpose interface{}) *EmailTokenRepository_InvalidateByUserID_Call {
+	return &EmailTokenRepository_InvalidateByUserID_Call{Call: _e.mock.On("InvalidateByUserID", ctx, userID, purpose)}
+}
+
+func (_c *EmailTokenRepository_InvalidateByUserID_Call) Run(run func(ctx context.Context, userID uint, purpose string)) *EmailTokenRepository_InvalidateByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *EmailTokenRepository_InvalidateByUserID_Call) Return(_a0 error) *EmailTokenRepository_InvalidateByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailTokenRepository_InvalidateByUserID_Call) RunAndReturn(run func(context.Context, uint, string) error) *EmailTokenRepository_InvalidateByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsUsed provides a mock function with given fields: ctx, id
+func (_m *EmailTokenRepository) MarkAsUsed(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsUsed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmailTokenRepository_MarkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUsed'
+type EmailTokenRepository_MarkAsUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAsUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *EmailTokenRepository_Expecter) MarkAsUsed(ctx interface{}, id interface{}) *EmailTokenRepository_MarkAsUsed_Call {
+	return &EmailTokenRepository_MarkAsUsed_Call{Call: _e.mock.On("MarkAsUsed", ctx, id)}
+}
+
+func (_c *EmailTokenRepository_MarkAsUsed_Call) Run(run func(ctx context.Context, id uint)) *EmailTokenRepository_MarkAsUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *EmailTokenRepository_MarkAsUsed_Call) Return(_a0 bool, _a1 error) *EmailTokenRepository_MarkAsUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmailTokenRepository_MarkAsUsed_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *EmailTokenRepository_MarkAsUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEmailTokenRepository creates a new instance of EmailTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailTokenRepository {
+	mock := &EmailTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,194 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// EmailVerificationService is an autogenerated mock type for the EmailVerificationService type
+type EmailVerificationService struct {
+	mock.Mock
+}
+
+type EmailVerificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EmailVerificationService) EXPECT() *EmailVerificationService_Expecter {
+	return &EmailVerificationService_Expecter{mock: &_m.Mock}
+}
+
+// ConfirmEmail provides a mock function with given fields: ctx, req
+func (_m *EmailVerificationService) ConfirmEmail(ctx context.Context, req *requests.ConfirmEmailRequest) (*responses.UserResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmEmail")
+	}
+
+	var r0 *responses.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.ConfirmEmailRequest) (*responses.UserResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.ConfirmEmailRequest) *responses.UserResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.ConfirmEmailRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EmailVerificationService_ConfirmEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmail'
+type EmailVerificationService_ConfirmEmail_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.ConfirmEmailRequest
+func (_e *EmailVerificationService_Expecter) ConfirmEmail(ctx interface{}, req interface{}) *EmailVerificationService_ConfirmEmail_Call {
+	return &EmailVerificationService_ConfirmEmail_Call{Call: _e.mock.On("ConfirmEmail", ctx, req)}
+}
+
+func (_c *EmailVerificationService_ConfirmEmail_Call) Run(run func(ctx context.Context, req *requests.ConfirmEmailRequest)) *EmailVerificationService_ConfirmEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.ConfirmEmailRequest))
+	})
+	return _c
+}
+
+func (_c *EmailVerificationService_ConfirmEmail_Call) Return(_a0 *responses.UserResponse, _a1 error) *EmailVerificationService_ConfirmEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EmailVerificationService_ConfirmEmail_Call) RunAndReturn(run func(context.Context, *requests.ConfirmEmailRequest) (*responses.UserResponse, error)) *EmailVerificationService_ConfirmEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestEmailChange provides a mock function with given fields: ctx, userID, req
+func (_m *EmailVerificationService) RequestEmailChange(ctx context.Context, userID uint, req *requests.ChangeEmailRequest) error {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestEmailChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ChangeEmailRequest) error); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailVerificationService_RequestEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestEmailChange'
+type EmailVerificationService_RequestEmailChange_Call struct {
+	*mock.Call
+}
+
+// RequestEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.ChangeEmailRequest
+func (_e *EmailVerificationService_Expecter) RequestEmailChange(ctx interface{}, userID interface{}, req interface{}) *EmailVerificationService_RequestEmailChange_Call {
+	return &EmailVerificationService_RequestEmailChange_Call{Call: _e.mock.On("RequestEmailChange", ctx, userID, req)}
+}
+
+func (_c *EmailVerificationService_RequestEmailChange_Call) Run(run func(ctx context.Context, userID uint, req *requests.ChangeEmailRequest)) *EmailVerificationService_RequestEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.ChangeEmailRequest))
+	})
+	return _c
+}
+
+func (_c *EmailVerificationService_RequestEmailChange_Call) Return(_a0 error) *EmailVerificationService_RequestEmailChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailVerificationService_RequestEmailChange_Call) RunAndReturn(run func(context.Context, uint, *requests.ChangeEmailRequest) error) *EmailVerificationService_RequestEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendVerification provides a mock function with given fields: ctx, userID
+func (_m *EmailVerificationService) SendVerification(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendVerification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EmailVerificationService_SendVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendVerification'
+type EmailVerificationService_SendVerification_Call struct {
+	*mock.Call
+}
+
+// SendVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *EmailVerificationService_Expecter) SendVerification(ctx interface{}, userID interface{}) *EmailVerificationService_SendVerification_Call {
+	return &EmailVerificationService_SendVerification_Call{Call: _e.mock.On("SendVerification", ctx, userID)}
+}
+
+func (_c *EmailVerificationService_SendVerification_Call) Run(run func(ctx context.Context, userID uint)) *EmailVerificationService_SendVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *EmailVerificationService_SendVerification_Call) Return(_a0 error) *EmailVerificationService_SendVerification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EmailVerificationService_SendVerification_Call) RunAndReturn(run func(context.Context, uint) error) *EmailVerificationService_SendVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEmailVerificationService creates a new instance of EmailVerificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailVerificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailVerificationService {
+	mock := &EmailVerificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
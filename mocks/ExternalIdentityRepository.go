@@ -0,0 +1,298 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ExternalIdentityRepository is an autogenerated mock type for the ExternalIdentityRepository type
+type ExternalIdentityRepository struct {
+	mock.Mock
+}
+
+type ExternalIdentityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExternalIdentityRepository) EXPECT() *ExternalIdentityRepository_Expecter {
+	return &ExternalIdentityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, identity
+func (_m *ExternalIdentityRepository) Create(ctx context.Context, identity *domain.ExternalIdentity) error {
+	ret := _m.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ExternalIdentity) error); ok {
+		r0 = rf(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExternalIdentityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ExternalIdentityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *domain.ExternalIdentity
+func (_e *ExternalIdentityRepository_Expecter) Create(ctx interface{}, identity interface{}) *ExternalIdentityRepository_Create_Call {
+	return &ExternalIdentityRepository_Create_Call{Call: _e.mock.On("Create", ctx, identity)}
+}
+
+func (_c *ExternalIdentityRepository_Create_Call) Run(run func(ctx context.Context, identity *domain.ExternalIdentity)) *ExternalIdentityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ExternalIdentity))
+	})
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_Create_Call) Return(_a0 error) *ExternalIdentityRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.ExternalIdentity) error) *ExternalIdentityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *ExternalIdentityRepository) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExternalIdentityRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ExternalIdentityRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *ExternalIdentityRepository_Expecter) Delete(ctx interface{}, id interface{}) *ExternalIdentityRepository_Delete_Call {
+	return &ExternalIdentityRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *ExternalIdentityRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *ExternalIdentityRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_Delete_Call) Return(_a0 error) *ExternalIdentityRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *ExternalIdentityRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByProviderSubject provides a mock function with given fields: ctx, provider, subject
+func (_m *ExternalIdentityRepository) FindByProviderSubject(ctx context.Context, provider string, subject string) (*domain.ExternalIdentity, error) {
+	ret := _m.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProviderSubject")
+	}
+
+	var r0 *domain.ExternalIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.ExternalIdentity, error)); ok {
+		return rf(ctx, provider, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.ExternalIdentity); ok {
+		r0 = rf(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExternalIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExternalIdentityRepository_FindByProviderSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProviderSubject'
+type ExternalIdentityRepository_FindByProviderSubject_Call struct {
+	*mock.Call
+}
+
+// FindByProviderSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *ExternalIdentityRepository_Expecter) FindByProviderSubject(ctx interface{}, provider interface{}, subject interface{}) *ExternalIdentityRepository_FindByProviderSubject_Call {
+	return &ExternalIdentityRepository_FindByProviderSubject_Call{Call: _e.mock.On("FindByProviderSubject", ctx, provider, subject)}
+}
+
+func (_c *ExternalIdentityRepository_FindByProviderSubject_Call) Run(run func(ctx context.Context, provider string, subject string)) *ExternalIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_FindByProviderSubject_Call) Return(_a0 *domain.ExternalIdentity, _a1 error) *ExternalIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_FindByProviderSubject_Call) RunAndReturn(run func(context.Context, string, string) (*domain.ExternalIdentity, error)) *ExternalIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID
+func (_m *ExternalIdentityRepository) FindByUserID(ctx context.Context, userID uint) ([]domain.ExternalIdentity, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []domain.ExternalIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.ExternalIdentity, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.ExternalIdentity); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ExternalIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExternalIdentityRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type ExternalIdentityRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *ExternalIdentityRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *ExternalIdentityRepository_FindByUserID_Call {
+	return &ExternalIdentityRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *ExternalIdentityRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID uint)) *ExternalIdentityRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_FindByUserID_Call) Return(_a0 []domain.ExternalIdentity, _a1 error) *ExternalIdentityRepository_FindByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_FindByUserID_Call) RunAndReturn(run func(context.Context, uint) ([]domain.ExternalIdentity, error)) *ExternalIdentityRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastLogin provides a mock function with given fields: ctx, id
+func (_m *ExternalIdentityRepository) UpdateLastLogin(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastLogin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExternalIdentityRepository_UpdateLastLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastLogin'
+type ExternalIdentityRepository_UpdateLastLogin_Call struct {
+	*mock.Call
+}
+
+// UpdateLastLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *ExternalIdentityRepository_Expecter) UpdateLastLogin(ctx interface{}, id interface{}) *ExternalIdentityRepository_UpdateLastLogin_Call {
+	return &ExternalIdentityRepository_UpdateLastLogin_Call{Call: _e.mock.On("UpdateLastLogin", ctx, id)}
+}
+
+func (_c *ExternalIdentityRepository_UpdateLastLogin_Call) Run(run func(ctx context.Context, id uint)) *ExternalIdentityRepository_UpdateLastLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_UpdateLastLogin_Call) Return(_a0 error) *ExternalIdentityRepository_UpdateLastLogin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExternalIdentityRepository_UpdateLastLogin_Call) RunAndReturn(run func(context.Context, uint) error) *ExternalIdentityRepository_UpdateLastLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExternalIdentityRepository creates a new instance of ExternalIdentityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExternalIdentityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExternalIdentityRepository {
+	mock := &ExternalIdentityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,423 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "hopSpotAPI/internal/repository"
+)
+
+// FavoriteRepository is an autogenerated mock type for the FavoriteRepository type
+type FavoriteRepository struct {
+	mock.Mock
+}
+
+type FavoriteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FavoriteRepository) EXPECT() *FavoriteRepository_Expecter {
+	return &FavoriteRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByUserID provides a mock function with given fields: ctx, userID
+func (_m *FavoriteRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FavoriteRepository_CountByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUserID'
+type FavoriteRepository_CountByUserID_Call struct {
+	*mock.Call
+}
+
+// CountByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *FavoriteRepository_Expecter) CountByUserID(ctx interface{}, userID interface{}) *FavoriteRepository_CountByUserID_Call {
+	return &FavoriteRepository_CountByUserID_Call{Call: _e.mock.On("CountByUserID", ctx, userID)}
+}
+
+func (_c *FavoriteRepository_CountByUserID_Call) Run(run func(ctx context.Context, userID uint)) *FavoriteRepository_CountByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *FavoriteRepository_CountByUserID_Call) Return(_a0 int64, _a1 error) *FavoriteRepository_CountByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FavoriteRepository_CountByUserID_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *FavoriteRepository_CountByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, favorite
+func (_m *FavoriteRepository) Create(ctx context.Context, favorite *domain.Favorite) error {
+	ret := _m.Called(ctx, favorite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Favorite) error); ok {
+		r0 = rf(ctx, favorite)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FavoriteRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type FavoriteRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - favorite *domain.Favorite
+func (_e *FavoriteRepository_Expecter) Create(ctx interface{}, favorite interface{}) *FavoriteRepository_Create_Call {
+	return &FavoriteRepository_Create_Call{Call: _e.mock.On("Create", ctx, favorite)}
+}
+
+func (_c *FavoriteRepository_Create_Call) Run(run func(ctx context.Context, favorite *domain.Favorite)) *FavoriteRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Favorite))
+	})
+	return _c
+}
+
+func (_c *FavoriteRepository_Create_Call) Return(_a0 error) *FavoriteRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FavoriteRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Favorite) error) *FavoriteRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, spotID
+func (_m *FavoriteRepository) Delete(ctx context.Context, userID uint, spotID uint) error {
+	ret := _m.Called(ctx, userID, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, spotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FavoriteRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type FavoriteRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - spotID uint
+func (_e *FavoriteRepository_Expecter) Delete(ctx interface{}, userID interface{}, spotID interface{}) *FavoriteRepository_Delete_Call {
+	return &FavoriteRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, spotID)}
+}
+
+func (_c *FavoriteRepository_Delete_Call) Run(run func(ctx context.Context, userID uint, spotID uint)) *FavoriteRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FavoriteRepository_Delete_Call) Return(_a0 error) *FavoriteRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FavoriteRepository_Delete_Call) RunAndReturn(run func(context.Context, uint, uint) error) *FavoriteRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBySpotID provides a mock function with given fields: ctx, spotID
+func (_m *FavoriteRepository) DeleteBySpotID(ctx context.Context, spotID uint) error {
+	ret := _m.Called(ctx, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySpotID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, spotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FavoriteRepository_DeleteBySpotID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBySpotID'
+type FavoriteRepository_DeleteBySpotID_Call struct {
+	*mock.Call
+}
+
+// DeleteBySpotID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+func (_e *FavoriteRepository_Expecter) DeleteBySpotID(ctx interface{}, spotID interface{}) *FavoriteRepository_DeleteBySpotID_Call {
+	return &FavoriteRepository_DeleteBySpotID_Call{Call: _e.mock.On("DeleteBySpotID", ctx, spotID)}
+}
+
+func (_c *FavoriteRepository_DeleteBySpotID_Call) Run(run func(ctx context.Context, spotID uint)) *FavoriteRepository_DeleteBySpotID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *FavoriteRepository_DeleteBySpotID_Call) Return(_a0 error) *FavoriteRepository_DeleteBySpotID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FavoriteRepository_DeleteBySpotID_Call) RunAndReturn(run func(context.Context, uint) error) *FavoriteRepository_DeleteBySpotID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function with given fields: ctx, userID, spotID
+func (_m *FavoriteRepository) Exists(ctx context.Context, userID uint, spotID uint) (bool, error) {
+	ret := _m.Called(ctx, userID, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (bool, error)); ok {
+		return rf(ctx, userID, spotID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) bool); ok {
+		r0 = rf(ctx, userID, spotID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, userID, spotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FavoriteRepository_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type FavoriteRepository_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - spotID uint
+func (_e *FavoriteRepository_Expecter) Exists(ctx interface{}, userID interface{}, spotID interface{}) *FavoriteRepository_Exists_Call {
+	return &FavoriteRepository_Exists_Call{Call: _e.mock.On("Exists", ctx, userID, spotID)}
+}
+
+func (_c *FavoriteRepository_Exists_Call) Run(run func(ctx context.Context, userID uint, spotID uint)) *FavoriteRepository_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FavoriteRepository_Exists_Call) Return(_a0 bool, _a1 error) *FavoriteRepository_Exists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FavoriteRepository_Exists_Call) RunAndReturn(run func(context.Context, uint, uint) (bool, error)) *FavoriteRepository_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID, filter
+func (_m *FavoriteRepository) FindByUserID(ctx context.Context, userID uint, filter repository.FavoriteFilter) ([]domain.Favorite, int64, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []domain.Favorite
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.FavoriteFilter) ([]domain.Favorite, int64, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.FavoriteFilter) []domain.Favorite); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, repository.FavoriteFilter) int64); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint, repository.FavoriteFilter) error); ok {
+		r2 = rf(ctx, userID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FavoriteRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type FavoriteRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - filter repository.FavoriteFilter
+func (_e *FavoriteRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}, filter interface{}) *FavoriteRepository_FindByUserID_Call {
+	return &FavoriteRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID, filter)}
+}
+
+func (_c *FavoriteRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID uint, filter repository.FavoriteFilter)) *FavoriteRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(repository.FavoriteFilter))
+	})
+	return _c
+}
+
+func (_c *FavoriteRepository_FindByUserID_Call) Return(_a0 []domain.Favorite, _a1 int64, _a2 error) *FavoriteRepository_FindByUserID_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FavoriteRepository_FindByUserID_Call) RunAndReturn(run func(context.Context, uint, repository.FavoriteFilter) ([]domain.Favorite, int64, error)) *FavoriteRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpotIDsByUserID provides a mock function with given fields: ctx, userID
+func (_m *FavoriteRepository) GetSpotIDsByUserID(ctx context.Context, userID uint) ([]uint, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpotIDsByUserID")
+	}
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]uint, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []uint); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FavoriteRepository_GetSpotIDsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpotIDsByUserID'
+type FavoriteRepository_GetSpotIDsByUserID_Call struct {
+	*mock.Call
+}
+
+// GetSpotIDsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *FavoriteRepository_Expecter) GetSpotIDsByUserID(ctx interface{}, userID interface{}) *FavoriteRepository_GetSpotIDsByUserID_Call {
+	return &FavoriteRepository_GetSpotIDsByUserID_Call{Call: _e.mock.On("GetSpotIDsByUserID", ctx, userID)}
+}
+
+func (_c *FavoriteRepository_GetSpotIDsByUserID_Call) Run(run func(ctx context.Context, userID uint)) *FavoriteRepository_GetSpotIDsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *FavoriteRepository_GetSpotIDsByUserID_Call) Return(_a0 []uint, _a1 error) *FavoriteRepository_GetSpotIDsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FavoriteRepository_GetSpotIDsByUserID_Call) RunAndReturn(run func(context.Context, uint) ([]uint, error)) *FavoriteRepository_GetSpotIDsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFavoriteRepository creates a new instance of FavoriteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFavoriteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FavoriteRepository {
+	mock := &FavoriteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,530 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "hopSpotAPI/internal/repository"
+)
+
+// FollowRepository is an autogenerated mock type for the FollowRepository type
+type FollowRepository struct {
+	mock.Mock
+}
+
+type FollowRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FollowRepository) EXPECT() *FollowRepository_Expecter {
+	return &FollowRepository_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: ctx, follow
+func (_m *FollowRepository) Accept(ctx context.Context, follow *domain.Follow) error {
+	ret := _m.Called(ctx, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Follow) error); ok {
+		r0 = rf(ctx, follow)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowRepository_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type FollowRepository_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follow *domain.Follow
+func (_e *FollowRepository_Expecter) Accept(ctx interface{}, follow interface{}) *FollowRepository_Accept_Call {
+	return &FollowRepository_Accept_Call{Call: _e.mock.On("Accept", ctx, follow)}
+}
+
+func (_c *FollowRepository_Accept_Call) Run(run func(ctx context.Context, follow *domain.Follow)) *FollowRepository_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Follow))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_Accept_Call) Return(_a0 error) *FollowRepository_Accept_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowRepository_Accept_Call) RunAndReturn(run func(context.Context, *domain.Follow) error) *FollowRepository_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Block provides a mock function with given fields: ctx, blockerID, blockedID
+func (_m *FollowRepository) Block(ctx context.Context, blockerID uint, blockedID uint) error {
+	ret := _m.Called(ctx, blockerID, blockedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, blockerID, blockedID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowRepository_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type FollowRepository_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockerID uint
+//   - blockedID uint
+func (_e *FollowRepository_Expecter) Block(ctx interface{}, blockerID interface{}, blockedID interface{}) *FollowRepository_Block_Call {
+	return &FollowRepository_Block_Call{Call: _e.mock.On("Block", ctx, blockerID, blockedID)}
+}
+
+func (_c *FollowRepository_Block_Call) Run(run func(ctx context.Context, blockerID uint, blockedID uint)) *FollowRepository_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_Block_Call) Return(_a0 error) *FollowRepository_Block_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowRepository_Block_Call) RunAndReturn(run func(context.Context, uint, uint) error) *FollowRepository_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, follow
+func (_m *FollowRepository) Create(ctx context.Context, follow *domain.Follow) error {
+	ret := _m.Called(ctx, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Follow) error); ok {
+		r0 = rf(ctx, follow)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type FollowRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follow *domain.Follow
+func (_e *FollowRepository_Expecter) Create(ctx interface{}, follow interface{}) *FollowRepository_Create_Call {
+	return &FollowRepository_Create_Call{Call: _e.mock.On("Create", ctx, follow)}
+}
+
+func (_c *FollowRepository_Create_Call) Run(run func(ctx context.Context, follow *domain.Follow)) *FollowRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Follow))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_Create_Call) Return(_a0 error) *FollowRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Follow) error) *FollowRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *FollowRepository) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type FollowRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *FollowRepository_Expecter) Delete(ctx interface{}, id interface{}) *FollowRepository_Delete_Call {
+	return &FollowRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *FollowRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *FollowRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_Delete_Call) Return(_a0 error) *FollowRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *FollowRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBetween provides a mock function with given fields: ctx, userID, otherUserID
+func (_m *FollowRepository) DeleteBetween(ctx context.Context, userID uint, otherUserID uint) error {
+	ret := _m.Called(ctx, userID, otherUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBetween")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, otherUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowRepository_DeleteBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBetween'
+type FollowRepository_DeleteBetween_Call struct {
+	*mock.Call
+}
+
+// DeleteBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - otherUserID uint
+func (_e *FollowRepository_Expecter) DeleteBetween(ctx interface{}, userID interface{}, otherUserID interface{}) *FollowRepository_DeleteBetween_Call {
+	return &FollowRepository_DeleteBetween_Call{Call: _e.mock.On("DeleteBetween", ctx, userID, otherUserID)}
+}
+
+func (_c *FollowRepository_DeleteBetween_Call) Run(run func(ctx context.Context, userID uint, otherUserID uint)) *FollowRepository_DeleteBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_DeleteBetween_Call) Return(_a0 error) *FollowRepository_DeleteBetween_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowRepository_DeleteBetween_Call) RunAndReturn(run func(context.Context, uint, uint) error) *FollowRepository_DeleteBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, followerID, followeeID
+func (_m *FollowRepository) Find(ctx context.Context, followerID uint, followeeID uint) (*domain.Follow, error) {
+	ret := _m.Called(ctx, followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *domain.Follow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (*domain.Follow, error)); ok {
+		return rf(ctx, followerID, followeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) *domain.Follow); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Follow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, followerID, followeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type FollowRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uint
+//   - followeeID uint
+func (_e *FollowRepository_Expecter) Find(ctx interface{}, followerID interface{}, followeeID interface{}) *FollowRepository_Find_Call {
+	return &FollowRepository_Find_Call{Call: _e.mock.On("Find", ctx, followerID, followeeID)}
+}
+
+func (_c *FollowRepository_Find_Call) Run(run func(ctx context.Context, followerID uint, followeeID uint)) *FollowRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_Find_Call) Return(_a0 *domain.Follow, _a1 error) *FollowRepository_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_Find_Call) RunAndReturn(run func(context.Context, uint, uint) (*domain.Follow, error)) *FollowRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByFollowee provides a mock function with given fields: ctx, followeeID, filter
+func (_m *FollowRepository) FindByFollowee(ctx context.Context, followeeID uint, filter repository.FollowFilter) ([]domain.Follow, int64, error) {
+	ret := _m.Called(ctx, followeeID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByFollowee")
+	}
+
+	var r0 []domain.Follow
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.FollowFilter) ([]domain.Follow, int64, error)); ok {
+		return rf(ctx, followeeID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.FollowFilter) []domain.Follow); ok {
+		r0 = rf(ctx, followeeID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Follow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, repository.FollowFilter) int64); ok {
+		r1 = rf(ctx, followeeID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint, repository.FollowFilter) error); ok {
+		r2 = rf(ctx, followeeID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowRepository_FindByFollowee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByFollowee'
+type FollowRepository_FindByFollowee_Call struct {
+	*mock.Call
+}
+
+// FindByFollowee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID uint
+//   - filter repository.FollowFilter
+func (_e *FollowRepository_Expecter) FindByFollowee(ctx interface{}, followeeID interface{}, filter interface{}) *FollowRepository_FindByFollowee_Call {
+	return &FollowRepository_FindByFollowee_Call{Call: _e.mock.On("FindByFollowee", ctx, followeeID, filter)}
+}
+
+func (_c *FollowRepository_FindByFollowee_Call) Run(run func(ctx context.Context, followeeID uint, filter repository.FollowFilter)) *FollowRepository_FindByFollowee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(repository.FollowFilter))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_FindByFollowee_Call) Return(_a0 []domain.Follow, _a1 int64, _a2 error) *FollowRepository_FindByFollowee_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_FindByFollowee_Call) RunAndReturn(run func(context.Context, uint, repository.FollowFilter) ([]domain.Follow, int64, error)) *FollowRepository_FindByFollowee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByFollower provides a mock function with given fields: ctx, followerID, filter
+func (_m *FollowRepository) FindByFollower(ctx context.Context, followerID uint, filter repository.FollowFilter) ([]domain.Follow, int64, error) {
+	ret := _m.Called(ctx, followerID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByFollower")
+	}
+
+	var r0 []domain.Follow
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.FollowFilter) ([]domain.Follow, int64, error)); ok {
+		return rf(ctx, followerID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.FollowFilter) []domain.Follow); ok {
+		r0 = rf(ctx, followerID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Follow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, repository.FollowFilter) int64); ok {
+		r1 = rf(ctx, followerID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint, repository.FollowFilter) error); ok {
+		r2 = rf(ctx, followerID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowRepository_FindByFollower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByFollower'
+type FollowRepository_FindByFollower_Call struct {
+	*mock.Call
+}
+
+// FindByFollower is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uint
+//   - filter repository.FollowFilter
+func (_e *FollowRepository_Expecter) FindByFollower(ctx interface{}, followerID interface{}, filter interface{}) *FollowRepository_FindByFollower_Call {
+	return &FollowRepository_FindByFollower_Call{Call: _e.mock.On("FindByFollower", ctx, followerID, filter)}
+}
+
+func (_c *FollowRepository_FindByFollower_Call) Run(run func(ctx context.Context, followerID uint, filter repository.FollowFilter)) *FollowRepository_FindByFollower_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(repository.FollowFilter))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_FindByFollower_Call) Return(_a0 []domain.Follow, _a1 int64, _a2 error) *FollowRepository_FindByFollower_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_FindByFollower_Call) RunAndReturn(run func(context.Context, uint, repository.FollowFilter) ([]domain.Follow, int64, error)) *FollowRepository_FindByFollower_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *FollowRepository) FindByID(ctx context.Context, id uint) (*domain.Follow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.Follow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Follow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Follow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Follow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type FollowRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *FollowRepository_Expecter) FindByID(ctx interface{}, id interface{}) *FollowRepository_FindByID_Call {
+	return &FollowRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *FollowRepository_FindByID_Call) Run(run func(ctx context.Context, id uint)) *FollowRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_FindByID_Call) Return(_a0 *domain.Follow, _a1 error) *FollowRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Follow, error)) *FollowRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFollowRepository creates a new instance of FollowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFollowRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FollowRepository {
+	mock := &FollowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,472 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// FollowService is an autogenerated mock type for the FollowService type
+type FollowService struct {
+	mock.Mock
+}
+
+type FollowService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FollowService) EXPECT() *FollowService_Expecter {
+	return &FollowService_Expecter{mock: &_m.Mock}
+}
+
+// AcceptRequest provides a mock function with given fields: ctx, userID, requestID
+func (_m *FollowService) AcceptRequest(ctx context.Context, userID uint, requestID uint) (*responses.FollowResponse, error) {
+	ret := _m.Called(ctx, userID, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptRequest")
+	}
+
+	var r0 *responses.FollowResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (*responses.FollowResponse, error)); ok {
+		return rf(ctx, userID, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) *responses.FollowResponse); ok {
+		r0 = rf(ctx, userID, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.FollowResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, userID, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_AcceptRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptRequest'
+type FollowService_AcceptRequest_Call struct {
+	*mock.Call
+}
+
+// AcceptRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - requestID uint
+func (_e *FollowService_Expecter) AcceptRequest(ctx interface{}, userID interface{}, requestID interface{}) *FollowService_AcceptRequest_Call {
+	return &FollowService_AcceptRequest_Call{Call: _e.mock.On("AcceptRequest", ctx, userID, requestID)}
+}
+
+func (_c *FollowService_AcceptRequest_Call) Run(run func(ctx context.Context, userID uint, requestID uint)) *FollowService_AcceptRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowService_AcceptRequest_Call) Return(_a0 *responses.FollowResponse, _a1 error) *FollowService_AcceptRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_AcceptRequest_Call) RunAndReturn(run func(context.Context, uint, uint) (*responses.FollowResponse, error)) *FollowService_AcceptRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Block provides a mock function with given fields: ctx, userID, targetID
+func (_m *FollowService) Block(ctx context.Context, userID uint, targetID uint) error {
+	ret := _m.Called(ctx, userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type FollowService_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - targetID uint
+func (_e *FollowService_Expecter) Block(ctx interface{}, userID interface{}, targetID interface{}) *FollowService_Block_Call {
+	return &FollowService_Block_Call{Call: _e.mock.On("Block", ctx, userID, targetID)}
+}
+
+func (_c *FollowService_Block_Call) Run(run func(ctx context.Context, userID uint, targetID uint)) *FollowService_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowService_Block_Call) Return(_a0 error) *FollowService_Block_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_Block_Call) RunAndReturn(run func(context.Context, uint, uint) error) *FollowService_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeclineRequest provides a mock function with given fields: ctx, userID, requestID
+func (_m *FollowService) DeclineRequest(ctx context.Context, userID uint, requestID uint) error {
+	ret := _m.Called(ctx, userID, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeclineRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_DeclineRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeclineRequest'
+type FollowService_DeclineRequest_Call struct {
+	*mock.Call
+}
+
+// DeclineRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - requestID uint
+func (_e *FollowService_Expecter) DeclineRequest(ctx interface{}, userID interface{}, requestID interface{}) *FollowService_DeclineRequest_Call {
+	return &FollowService_DeclineRequest_Call{Call: _e.mock.On("DeclineRequest", ctx, userID, requestID)}
+}
+
+func (_c *FollowService_DeclineRequest_Call) Run(run func(ctx context.Context, userID uint, requestID uint)) *FollowService_DeclineRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowService_DeclineRequest_Call) Return(_a0 error) *FollowService_DeclineRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_DeclineRequest_Call) RunAndReturn(run func(context.Context, uint, uint) error) *FollowService_DeclineRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function with given fields: ctx, userID, targetID
+func (_m *FollowService) Follow(ctx context.Context, userID uint, targetID uint) (*responses.FollowResponse, error) {
+	ret := _m.Called(ctx, userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 *responses.FollowResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (*responses.FollowResponse, error)); ok {
+		return rf(ctx, userID, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) *responses.FollowResponse); ok {
+		r0 = rf(ctx, userID, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.FollowResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, userID, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type FollowService_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - targetID uint
+func (_e *FollowService_Expecter) Follow(ctx interface{}, userID interface{}, targetID interface{}) *FollowService_Follow_Call {
+	return &FollowService_Follow_Call{Call: _e.mock.On("Follow", ctx, userID, targetID)}
+}
+
+func (_c *FollowService_Follow_Call) Run(run func(ctx context.Context, userID uint, targetID uint)) *FollowService_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowService_Follow_Call) Return(_a0 *responses.FollowResponse, _a1 error) *FollowService_Follow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_Follow_Call) RunAndReturn(run func(context.Context, uint, uint) (*responses.FollowResponse, error)) *FollowService_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFriends provides a mock function with given fields: ctx, userID, req
+func (_m *FollowService) ListFriends(ctx context.Context, userID uint, req *requests.ListFriendsRequest) (*responses.PaginatedFriendsResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFriends")
+	}
+
+	var r0 *responses.PaginatedFriendsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListFriendsRequest) (*responses.PaginatedFriendsResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListFriendsRequest) *responses.PaginatedFriendsResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.PaginatedFriendsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.ListFriendsRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_ListFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFriends'
+type FollowService_ListFriends_Call struct {
+	*mock.Call
+}
+
+// ListFriends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.ListFriendsRequest
+func (_e *FollowService_Expecter) ListFriends(ctx interface{}, userID interface{}, req interface{}) *FollowService_ListFriends_Call {
+	return &FollowService_ListFriends_Call{Call: _e.mock.On("ListFriends", ctx, userID, req)}
+}
+
+func (_c *FollowService_ListFriends_Call) Run(run func(ctx context.Context, userID uint, req *requests.ListFriendsRequest)) *FollowService_ListFriends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.ListFriendsRequest))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListFriends_Call) Return(_a0 *responses.PaginatedFriendsResponse, _a1 error) *FollowService_ListFriends_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_ListFriends_Call) RunAndReturn(run func(context.Context, uint, *requests.ListFriendsRequest) (*responses.PaginatedFriendsResponse, error)) *FollowService_ListFriends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRequests provides a mock function with given fields: ctx, userID, req
+func (_m *FollowService) ListRequests(ctx context.Context, userID uint, req *requests.ListFriendsRequest) (*responses.PaginatedFollowRequestsResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRequests")
+	}
+
+	var r0 *responses.PaginatedFollowRequestsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListFriendsRequest) (*responses.PaginatedFollowRequestsResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListFriendsRequest) *responses.PaginatedFollowRequestsResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.PaginatedFollowRequestsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.ListFriendsRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_ListRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRequests'
+type FollowService_ListRequests_Call struct {
+	*mock.Call
+}
+
+// ListRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.ListFriendsRequest
+func (_e *FollowService_Expecter) ListRequests(ctx interface{}, userID interface{}, req interface{}) *FollowService_ListRequests_Call {
+	return &FollowService_ListRequests_Call{Call: _e.mock.On("ListRequests", ctx, userID, req)}
+}
+
+func (_c *FollowService_ListRequests_Call) Run(run func(ctx context.Context, userID uint, req *requests.ListFriendsRequest)) *FollowService_ListRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.ListFriendsRequest))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListRequests_Call) Return(_a0 *responses.PaginatedFollowRequestsResponse, _a1 error) *FollowService_ListRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_ListRequests_Call) RunAndReturn(run func(context.Context, uint, *requests.ListFriendsRequest) (*responses.PaginatedFollowRequestsResponse, error)) *FollowService_ListRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function with given fields: ctx, userID, targetID
+func (_m *FollowService) Unblock(ctx context.Context, userID uint, targetID uint) error {
+	ret := _m.Called(ctx, userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type FollowService_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - targetID uint
+func (_e *FollowService_Expecter) Unblock(ctx interface{}, userID interface{}, targetID interface{}) *FollowService_Unblock_Call {
+	return &FollowService_Unblock_Call{Call: _e.mock.On("Unblock", ctx, userID, targetID)}
+}
+
+func (_c *FollowService_Unblock_Call) Run(run func(ctx context.Context, userID uint, targetID uint)) *FollowService_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowService_Unblock_Call) Return(_a0 error) *FollowService_Unblock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_Unblock_Call) RunAndReturn(run func(context.Context, uint, uint) error) *FollowService_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function with given fields: ctx, userID, targetID
+func (_m *FollowService) Unfollow(ctx context.Context, userID uint, targetID uint) error {
+	ret := _m.Called(ctx, userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type FollowService_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - targetID uint
+func (_e *FollowService_Expecter) Unfollow(ctx interface{}, userID interface{}, targetID interface{}) *FollowService_Unfollow_Call {
+	return &FollowService_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, userID, targetID)}
+}
+
+func (_c *FollowService_Unfollow_Call) Run(run func(ctx context.Context, userID uint, targetID uint)) *FollowService_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *FollowService_Unfollow_Call) Return(_a0 error) *FollowService_Unfollow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_Unfollow_Call) RunAndReturn(run func(context.Context, uint, uint) error) *FollowService_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFollowService creates a new instance of FollowService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFollowService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FollowService {
+	mock := &FollowService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
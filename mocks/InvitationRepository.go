@@ -25,6 +25,63 @@ func (_m *InvitationRepository) EXPECT() *InvitationRepository_Expecter {
 	return &InvitationRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountQuotaUsed provides a mock function with given fields: ctx, userID
+func (_m *InvitationRepository) CountQuotaUsed(ctx context.Context, userID uint) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountQuotaUsed")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_CountQuotaUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountQuotaUsed'
+type InvitationRepository_CountQuotaUsed_Call struct {
+	*mock.Call
+}
+
+// CountQuotaUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *InvitationRepository_Expecter) CountQuotaUsed(ctx interface{}, userID interface{}) *InvitationRepository_CountQuotaUsed_Call {
+	return &InvitationRepository_CountQuotaUsed_Call{Call: _e.mock.On("CountQuotaUsed", ctx, userID)}
+}
+
+func (_c *InvitationRepository_CountQuotaUsed_Call) Run(run func(ctx context.Context, userID uint)) *InvitationRepository_CountQuotaUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_CountQuotaUsed_Call) Return(_a0 int64, _a1 error) *InvitationRepository_CountQuotaUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationRepository_CountQuotaUsed_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *InvitationRepository_CountQuotaUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, code
 func (_m *InvitationRepository) Create(ctx context.Context, code *domain.InvitationCode) error {
 	ret := _m.Called(ctx, code)
@@ -72,6 +129,64 @@ func (_c *InvitationRepository_Create_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// CreateWithinQuota provides a mock function with given fields: ctx, code, quota
+func (_m *InvitationRepository) CreateWithinQuota(ctx context.Context, code *domain.InvitationCode, quota int) (bool, error) {
+	ret := _m.Called(ctx, code, quota)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithinQuota")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.InvitationCode, int) (bool, error)); ok {
+		return rf(ctx, code, quota)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.InvitationCode, int) bool); ok {
+		r0 = rf(ctx, code, quota)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.InvitationCode, int) error); ok {
+		r1 = rf(ctx, code, quota)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_CreateWithinQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithinQuota'
+type InvitationRepository_CreateWithinQuota_Call struct {
+	*mock.Call
+}
+
+// CreateWithinQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code *domain.InvitationCode
+//   - quota int
+func (_e *InvitationRepository_Expecter) CreateWithinQuota(ctx interface{}, code interface{}, quota interface{}) *InvitationRepository_CreateWithinQuota_Call {
+	return &InvitationRepository_CreateWithinQuota_Call{Call: _e.mock.On("CreateWithinQuota", ctx, code, quota)}
+}
+
+func (_c *InvitationRepository_CreateWithinQuota_Call) Run(run func(ctx context.Context, code *domain.InvitationCode, quota int)) *InvitationRepository_CreateWithinQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.InvitationCode), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_CreateWithinQuota_Call) Return(_a0 bool, _a1 error) *InvitationRepository_CreateWithinQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationRepository_CreateWithinQuota_Call) RunAndReturn(run func(context.Context, *domain.InvitationCode, int) (bool, error)) *InvitationRepository_CreateWithinQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *InvitationRepository) Delete(ctx context.Context, id uint) error {
 	ret := _m.Called(ctx, id)
@@ -303,50 +418,121 @@ func (_c *InvitationRepository_FindByID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// MarkAsRedeemed provides a mock function with given fields: ctx, codeID, userID
-func (_m *InvitationRepository) MarkAsRedeemed(ctx context.Context, codeID uint, userID uint) error {
-	ret := _m.Called(ctx, codeID, userID)
+// FindInviteTree provides a mock function with given fields: ctx, rootID, maxDepth
+func (_m *InvitationRepository) FindInviteTree(ctx context.Context, rootID *uint, maxDepth int) ([]repository.InviteTreeNode, error) {
+	ret := _m.Called(ctx, rootID, maxDepth)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkAsRedeemed")
+		panic("no return value specified for FindInviteTree")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
-		r0 = rf(ctx, codeID, userID)
+	var r0 []repository.InviteTreeNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uint, int) ([]repository.InviteTreeNode, error)); ok {
+		return rf(ctx, rootID, maxDepth)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uint, int) []repository.InviteTreeNode); ok {
+		r0 = rf(ctx, rootID, maxDepth)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.InviteTreeNode)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *uint, int) error); ok {
+		r1 = rf(ctx, rootID, maxDepth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_FindInviteTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindInviteTree'
+type InvitationRepository_FindInviteTree_Call struct {
+	*mock.Call
+}
+
+// FindInviteTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rootID *uint
+//   - maxDepth int
+func (_e *InvitationRepository_Expecter) FindInviteTree(ctx interface{}, rootID interface{}, maxDepth interface{}) *InvitationRepository_FindInviteTree_Call {
+	return &InvitationRepository_FindInviteTree_Call{Call: _e.mock.On("FindInviteTree", ctx, rootID, maxDepth)}
+}
+
+func (_c *InvitationRepository_FindInviteTree_Call) Run(run func(ctx context.Context, rootID *uint, maxDepth int)) *InvitationRepository_FindInviteTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uint), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *InvitationRepository_FindInviteTree_Call) Return(_a0 []repository.InviteTreeNode, _a1 error) *InvitationRepository_FindInviteTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
 }
 
-// InvitationRepository_MarkAsRedeemed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsRedeemed'
-type InvitationRepository_MarkAsRedeemed_Call struct {
+func (_c *InvitationRepository_FindInviteTree_Call) RunAndReturn(run func(context.Context, *uint, int) ([]repository.InviteTreeNode, error)) *InvitationRepository_FindInviteTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeem provides a mock function with given fields: ctx, codeID, user, identity
+func (_m *InvitationRepository) Redeem(ctx context.Context, codeID uint, user *domain.User, identity *domain.ExternalIdentity) (bool, error) {
+	ret := _m.Called(ctx, codeID, user, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeem")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.User, *domain.ExternalIdentity) (bool, error)); ok {
+		return rf(ctx, codeID, user, identity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *domain.User, *domain.ExternalIdentity) bool); ok {
+		r0 = rf(ctx, codeID, user, identity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *domain.User, *domain.ExternalIdentity) error); ok {
+		r1 = rf(ctx, codeID, user, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepository_Redeem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeem'
+type InvitationRepository_Redeem_Call struct {
 	*mock.Call
 }
 
-// MarkAsRedeemed is a helper method to define mock.On call
+// Redeem is a helper method to define mock.On call
 //   - ctx context.Context
 //   - codeID uint
-//   - userID uint
-func (_e *InvitationRepository_Expecter) MarkAsRedeemed(ctx interface{}, codeID interface{}, userID interface{}) *InvitationRepository_MarkAsRedeemed_Call {
-	return &InvitationRepository_MarkAsRedeemed_Call{Call: _e.mock.On("MarkAsRedeemed", ctx, codeID, userID)}
+//   - user *domain.User
+//   - identity *domain.ExternalIdentity
+func (_e *InvitationRepository_Expecter) Redeem(ctx interface{}, codeID interface{}, user interface{}, identity interface{}) *InvitationRepository_Redeem_Call {
+	return &InvitationRepository_Redeem_Call{Call: _e.mock.On("Redeem", ctx, codeID, user, identity)}
 }
 
-func (_c *InvitationRepository_MarkAsRedeemed_Call) Run(run func(ctx context.Context, codeID uint, userID uint)) *InvitationRepository_MarkAsRedeemed_Call {
+func (_c *InvitationRepository_Redeem_Call) Run(run func(ctx context.Context, codeID uint, user *domain.User, identity *domain.ExternalIdentity)) *InvitationRepository_Redeem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+		run(args[0].(context.Context), args[1].(uint), args[2].(*domain.User), args[3].(*domain.ExternalIdentity))
 	})
 	return _c
 }
 
-func (_c *InvitationRepository_MarkAsRedeemed_Call) Return(_a0 error) *InvitationRepository_MarkAsRedeemed_Call {
-	_c.Call.Return(_a0)
+func (_c *InvitationRepository_Redeem_Call) Return(_a0 bool, _a1 error) *InvitationRepository_Redeem_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InvitationRepository_MarkAsRedeemed_Call) RunAndReturn(run func(context.Context, uint, uint) error) *InvitationRepository_MarkAsRedeemed_Call {
+func (_c *InvitationRepository_Redeem_Call) RunAndReturn(run func(context.Context, uint, *domain.User, *domain.ExternalIdentity) (bool, error)) *InvitationRepository_Redeem_Call {
 	_c.Call.Return(run)
 	return _c
 }
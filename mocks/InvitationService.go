@@ -0,0 +1,207 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// InvitationService is an autogenerated mock type for the InvitationService type
+type InvitationService struct {
+	mock.Mock
+}
+
+type InvitationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvitationService) EXPECT() *InvitationService_Expecter {
+	return &InvitationService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, userID, req
+func (_m *InvitationService) Create(ctx context.Context, userID uint, req *requests.CreateInvitationRequest) (*responses.InvitationResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *responses.InvitationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.CreateInvitationRequest) (*responses.InvitationResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.CreateInvitationRequest) *responses.InvitationResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.InvitationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.CreateInvitationRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type InvitationService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.CreateInvitationRequest
+func (_e *InvitationService_Expecter) Create(ctx interface{}, userID interface{}, req interface{}) *InvitationService_Create_Call {
+	return &InvitationService_Create_Call{Call: _e.mock.On("Create", ctx, userID, req)}
+}
+
+func (_c *InvitationService_Create_Call) Run(run func(ctx context.Context, userID uint, req *requests.CreateInvitationRequest)) *InvitationService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.CreateInvitationRequest))
+	})
+	return _c
+}
+
+func (_c *InvitationService_Create_Call) Return(_a0 *responses.InvitationResponse, _a1 error) *InvitationService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_Create_Call) RunAndReturn(run func(context.Context, uint, *requests.CreateInvitationRequest) (*responses.InvitationResponse, error)) *InvitationService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID
+func (_m *InvitationService) List(ctx context.Context, userID uint) (*responses.UserInvitationsResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *responses.UserInvitationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*responses.UserInvitationsResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *responses.UserInvitationsResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.UserInvitationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type InvitationService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *InvitationService_Expecter) List(ctx interface{}, userID interface{}) *InvitationService_List_Call {
+	return &InvitationService_List_Call{Call: _e.mock.On("List", ctx, userID)}
+}
+
+func (_c *InvitationService_List_Call) Run(run func(ctx context.Context, userID uint)) *InvitationService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *InvitationService_List_Call) Return(_a0 *responses.UserInvitationsResponse, _a1 error) *InvitationService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_List_Call) RunAndReturn(run func(context.Context, uint) (*responses.UserInvitationsResponse, error)) *InvitationService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, userID, id
+func (_m *InvitationService) Revoke(ctx context.Context, userID uint, id uint) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationService_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type InvitationService_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - id uint
+func (_e *InvitationService_Expecter) Revoke(ctx interface{}, userID interface{}, id interface{}) *InvitationService_Revoke_Call {
+	return &InvitationService_Revoke_Call{Call: _e.mock.On("Revoke", ctx, userID, id)}
+}
+
+func (_c *InvitationService_Revoke_Call) Run(run func(ctx context.Context, userID uint, id uint)) *InvitationService_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *InvitationService_Revoke_Call) Return(_a0 error) *InvitationService_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationService_Revoke_Call) RunAndReturn(run func(context.Context, uint, uint) error) *InvitationService_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvitationService creates a new instance of InvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvitationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvitationService {
+	mock := &InvitationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,564 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "hopSpotAPI/internal/repository"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+type NotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationRepository) EXPECT() *NotificationRepository_Expecter {
+	return &NotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUnread provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type NotificationRepository_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *NotificationRepository_Expecter) CountUnread(ctx interface{}, userID interface{}) *NotificationRepository_CountUnread_Call {
+	return &NotificationRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", ctx, userID)}
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Run(run func(ctx context.Context, userID uint)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) Return(_a0 int64, _a1 error) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnread_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *NotificationRepository_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, notification
+func (_m *NotificationRepository) Create(ctx context.Context, notification *domain.Notification) error {
+	ret := _m.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Notification) error); ok {
+		r0 = rf(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type NotificationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification *domain.Notification
+func (_e *NotificationRepository_Expecter) Create(ctx interface{}, notification interface{}) *NotificationRepository_Create_Call {
+	return &NotificationRepository_Create_Call{Call: _e.mock.On("Create", ctx, notification)}
+}
+
+func (_c *NotificationRepository_Create_Call) Run(run func(ctx context.Context, notification *domain.Notification)) *NotificationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_Create_Call) Return(_a0 error) *NotificationRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Notification) error) *NotificationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function with given fields: ctx, notifications
+func (_m *NotificationRepository) CreateBatch(ctx context.Context, notifications []domain.Notification) error {
+	ret := _m.Called(ctx, notifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Notification) error); ok {
+		r0 = rf(ctx, notifications)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type NotificationRepository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notifications []domain.Notification
+func (_e *NotificationRepository_Expecter) CreateBatch(ctx interface{}, notifications interface{}) *NotificationRepository_CreateBatch_Call {
+	return &NotificationRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, notifications)}
+}
+
+func (_c *NotificationRepository_CreateBatch_Call) Run(run func(ctx context.Context, notifications []domain.Notification)) *NotificationRepository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.Notification))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CreateBatch_Call) Return(_a0 error) *NotificationRepository_CreateBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_CreateBatch_Call) RunAndReturn(run func(context.Context, []domain.Notification) error) *NotificationRepository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NotificationRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *NotificationRepository_Expecter) Delete(ctx interface{}, id interface{}) *NotificationRepository_Delete_Call {
+	return &NotificationRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *NotificationRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *NotificationRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_Delete_Call) Return(_a0 error) *NotificationRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *NotificationRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) FindByID(ctx context.Context, id uint) (*domain.Notification, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Notification, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Notification); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type NotificationRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *NotificationRepository_Expecter) FindByID(ctx interface{}, id interface{}) *NotificationRepository_FindByID_Call {
+	return &NotificationRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *NotificationRepository_FindByID_Call) Run(run func(ctx context.Context, id uint)) *NotificationRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_FindByID_Call) Return(_a0 *domain.Notification, _a1 error) *NotificationRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Notification, error)) *NotificationRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByRelatedSpotIDUnscoped provides a mock function with given fields: ctx, relatedSpotID
+func (_m *NotificationRepository) FindByRelatedSpotIDUnscoped(ctx context.Context, relatedSpotID uint) ([]domain.Notification, error) {
+	ret := _m.Called(ctx, relatedSpotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByRelatedSpotIDUnscoped")
+	}
+
+	var r0 []domain.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.Notification, error)); ok {
+		return rf(ctx, relatedSpotID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.Notification); ok {
+		r0 = rf(ctx, relatedSpotID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, relatedSpotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationRepository_FindByRelatedSpotIDUnscoped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRelatedSpotIDUnscoped'
+type NotificationRepository_FindByRelatedSpotIDUnscoped_Call struct {
+	*mock.Call
+}
+
+// FindByRelatedSpotIDUnscoped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - relatedSpotID uint
+func (_e *NotificationRepository_Expecter) FindByRelatedSpotIDUnscoped(ctx interface{}, relatedSpotID interface{}) *NotificationRepository_FindByRelatedSpotIDUnscoped_Call {
+	return &NotificationRepository_FindByRelatedSpotIDUnscoped_Call{Call: _e.mock.On("FindByRelatedSpotIDUnscoped", ctx, relatedSpotID)}
+}
+
+func (_c *NotificationRepository_FindByRelatedSpotIDUnscoped_Call) Run(run func(ctx context.Context, relatedSpotID uint)) *NotificationRepository_FindByRelatedSpotIDUnscoped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_FindByRelatedSpotIDUnscoped_Call) Return(_a0 []domain.Notification, _a1 error) *NotificationRepository_FindByRelatedSpotIDUnscoped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationRepository_FindByRelatedSpotIDUnscoped_Call) RunAndReturn(run func(context.Context, uint) ([]domain.Notification, error)) *NotificationRepository_FindByRelatedSpotIDUnscoped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID, filter
+func (_m *NotificationRepository) FindByUserID(ctx context.Context, userID uint, filter repository.NotificationFilter) ([]domain.Notification, int64, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []domain.Notification
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.NotificationFilter) ([]domain.Notification, int64, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.NotificationFilter) []domain.Notification); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, repository.NotificationFilter) int64); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint, repository.NotificationFilter) error); ok {
+		r2 = rf(ctx, userID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NotificationRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type NotificationRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - filter repository.NotificationFilter
+func (_e *NotificationRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}, filter interface{}) *NotificationRepository_FindByUserID_Call {
+	return &NotificationRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID, filter)}
+}
+
+func (_c *NotificationRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID uint, filter repository.NotificationFilter)) *NotificationRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(repository.NotificationFilter))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_FindByUserID_Call) Return(_a0 []domain.Notification, _a1 int64, _a2 error) *NotificationRepository_FindByUserID_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *NotificationRepository_FindByUserID_Call) RunAndReturn(run func(context.Context, uint, repository.NotificationFilter) ([]domain.Notification, int64, error)) *NotificationRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HardDelete provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) HardDelete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HardDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_HardDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HardDelete'
+type NotificationRepository_HardDelete_Call struct {
+	*mock.Call
+}
+
+// HardDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *NotificationRepository_Expecter) HardDelete(ctx interface{}, id interface{}) *NotificationRepository_HardDelete_Call {
+	return &NotificationRepository_HardDelete_Call{Call: _e.mock.On("HardDelete", ctx, id)}
+}
+
+func (_c *NotificationRepository_HardDelete_Call) Run(run func(ctx context.Context, id uint)) *NotificationRepository_HardDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_HardDelete_Call) Return(_a0 error) *NotificationRepository_HardDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_HardDelete_Call) RunAndReturn(run func(context.Context, uint) error) *NotificationRepository_HardDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllAsRead provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) MarkAllAsRead(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllAsRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_MarkAllAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllAsRead'
+type NotificationRepository_MarkAllAsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllAsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *NotificationRepository_Expecter) MarkAllAsRead(ctx interface{}, userID interface{}) *NotificationRepository_MarkAllAsRead_Call {
+	return &NotificationRepository_MarkAllAsRead_Call{Call: _e.mock.On("MarkAllAsRead", ctx, userID)}
+}
+
+func (_c *NotificationRepository_MarkAllAsRead_Call) Run(run func(ctx context.Context, userID uint)) *NotificationRepository_MarkAllAsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllAsRead_Call) Return(_a0 error) *NotificationRepository_MarkAllAsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllAsRead_Call) RunAndReturn(run func(context.Context, uint) error) *NotificationRepository_MarkAllAsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsRead provides a mock function with given fields: ctx, id
+func (_m *NotificationRepository) MarkAsRead(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationRepository_MarkAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsRead'
+type NotificationRepository_MarkAsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *NotificationRepository_Expecter) MarkAsRead(ctx interface{}, id interface{}) *NotificationRepository_MarkAsRead_Call {
+	return &NotificationRepository_MarkAsRead_Call{Call: _e.mock.On("MarkAsRead", ctx, id)}
+}
+
+func (_c *NotificationRepository_MarkAsRead_Call) Run(run func(ctx context.Context, id uint)) *NotificationRepository_MarkAsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAsRead_Call) Return(_a0 error) *NotificationRepository_MarkAsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAsRead_Call) RunAndReturn(run func(context.Context, uint) error) *NotificationRepository_MarkAsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,10 @@ import (
 	domain "hopSpotAPI/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
 )
 
 // NotificationService is an autogenerated mock type for the NotificationService type
@@ -23,6 +27,364 @@ func (_m *NotificationService) EXPECT() *NotificationService_Expecter {
 	return &NotificationService_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: ctx, notificationID, userID
+func (_m *NotificationService) Delete(ctx context.Context, notificationID uint, userID uint) error {
+	ret := _m.Called(ctx, notificationID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, notificationID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type NotificationService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notificationID uint
+//   - userID uint
+func (_e *NotificationService_Expecter) Delete(ctx interface{}, notificationID interface{}, userID interface{}) *NotificationService_Delete_Call {
+	return &NotificationService_Delete_Call{Call: _e.mock.On("Delete", ctx, notificationID, userID)}
+}
+
+func (_c *NotificationService_Delete_Call) Run(run func(ctx context.Context, notificationID uint, userID uint)) *NotificationService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationService_Delete_Call) Return(_a0 error) *NotificationService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_Delete_Call) RunAndReturn(run func(context.Context, uint, uint) error) *NotificationService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnreadCount provides a mock function with given fields: ctx, userID
+func (_m *NotificationService) GetUnreadCount(ctx context.Context, userID uint) (*responses.UnreadCountResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnreadCount")
+	}
+
+	var r0 *responses.UnreadCountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*responses.UnreadCountResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *responses.UnreadCountResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.UnreadCountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_GetUnreadCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnreadCount'
+type NotificationService_GetUnreadCount_Call struct {
+	*mock.Call
+}
+
+// GetUnreadCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *NotificationService_Expecter) GetUnreadCount(ctx interface{}, userID interface{}) *NotificationService_GetUnreadCount_Call {
+	return &NotificationService_GetUnreadCount_Call{Call: _e.mock.On("GetUnreadCount", ctx, userID)}
+}
+
+func (_c *NotificationService_GetUnreadCount_Call) Run(run func(ctx context.Context, userID uint)) *NotificationService_GetUnreadCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationService_GetUnreadCount_Call) Return(_a0 *responses.UnreadCountResponse, _a1 error) *NotificationService_GetUnreadCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_GetUnreadCount_Call) RunAndReturn(run func(context.Context, uint) (*responses.UnreadCountResponse, error)) *NotificationService_GetUnreadCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID, req
+func (_m *NotificationService) List(ctx context.Context, userID uint, req *requests.ListNotificationsRequest) (*responses.PaginatedNotificationsResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *responses.PaginatedNotificationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListNotificationsRequest) (*responses.PaginatedNotificationsResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListNotificationsRequest) *responses.PaginatedNotificationsResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.PaginatedNotificationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.ListNotificationsRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type NotificationService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.ListNotificationsRequest
+func (_e *NotificationService_Expecter) List(ctx interface{}, userID interface{}, req interface{}) *NotificationService_List_Call {
+	return &NotificationService_List_Call{Call: _e.mock.On("List", ctx, userID, req)}
+}
+
+func (_c *NotificationService_List_Call) Run(run func(ctx context.Context, userID uint, req *requests.ListNotificationsRequest)) *NotificationService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.ListNotificationsRequest))
+	})
+	return _c
+}
+
+func (_c *NotificationService_List_Call) Return(_a0 *responses.PaginatedNotificationsResponse, _a1 error) *NotificationService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationService_List_Call) RunAndReturn(run func(context.Context, uint, *requests.ListNotificationsRequest) (*responses.PaginatedNotificationsResponse, error)) *NotificationService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllAsRead provides a mock function with given fields: ctx, userID
+func (_m *NotificationService) MarkAllAsRead(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllAsRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_MarkAllAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllAsRead'
+type NotificationService_MarkAllAsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllAsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *NotificationService_Expecter) MarkAllAsRead(ctx interface{}, userID interface{}) *NotificationService_MarkAllAsRead_Call {
+	return &NotificationService_MarkAllAsRead_Call{Call: _e.mock.On("MarkAllAsRead", ctx, userID)}
+}
+
+func (_c *NotificationService_MarkAllAsRead_Call) Run(run func(ctx context.Context, userID uint)) *NotificationService_MarkAllAsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationService_MarkAllAsRead_Call) Return(_a0 error) *NotificationService_MarkAllAsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_MarkAllAsRead_Call) RunAndReturn(run func(context.Context, uint) error) *NotificationService_MarkAllAsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsRead provides a mock function with given fields: ctx, notificationID, userID
+func (_m *NotificationService) MarkAsRead(ctx context.Context, notificationID uint, userID uint) error {
+	ret := _m.Called(ctx, notificationID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, notificationID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_MarkAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsRead'
+type NotificationService_MarkAsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notificationID uint
+//   - userID uint
+func (_e *NotificationService_Expecter) MarkAsRead(ctx interface{}, notificationID interface{}, userID interface{}) *NotificationService_MarkAsRead_Call {
+	return &NotificationService_MarkAsRead_Call{Call: _e.mock.On("MarkAsRead", ctx, notificationID, userID)}
+}
+
+func (_c *NotificationService_MarkAsRead_Call) Run(run func(ctx context.Context, notificationID uint, userID uint)) *NotificationService_MarkAsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationService_MarkAsRead_Call) Return(_a0 error) *NotificationService_MarkAsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_MarkAsRead_Call) RunAndReturn(run func(context.Context, uint, uint) error) *NotificationService_MarkAsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyFollowAccepted provides a mock function with given fields: ctx, followerID, followeeID
+func (_m *NotificationService) NotifyFollowAccepted(ctx context.Context, followerID uint, followeeID uint) error {
+	ret := _m.Called(ctx, followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyFollowAccepted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_NotifyFollowAccepted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyFollowAccepted'
+type NotificationService_NotifyFollowAccepted_Call struct {
+	*mock.Call
+}
+
+// NotifyFollowAccepted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uint
+//   - followeeID uint
+func (_e *NotificationService_Expecter) NotifyFollowAccepted(ctx interface{}, followerID interface{}, followeeID interface{}) *NotificationService_NotifyFollowAccepted_Call {
+	return &NotificationService_NotifyFollowAccepted_Call{Call: _e.mock.On("NotifyFollowAccepted", ctx, followerID, followeeID)}
+}
+
+func (_c *NotificationService_NotifyFollowAccepted_Call) Run(run func(ctx context.Context, followerID uint, followeeID uint)) *NotificationService_NotifyFollowAccepted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationService_NotifyFollowAccepted_Call) Return(_a0 error) *NotificationService_NotifyFollowAccepted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_NotifyFollowAccepted_Call) RunAndReturn(run func(context.Context, uint, uint) error) *NotificationService_NotifyFollowAccepted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyFollowRequest provides a mock function with given fields: ctx, followerID, followeeID
+func (_m *NotificationService) NotifyFollowRequest(ctx context.Context, followerID uint, followeeID uint) error {
+	ret := _m.Called(ctx, followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyFollowRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationService_NotifyFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyFollowRequest'
+type NotificationService_NotifyFollowRequest_Call struct {
+	*mock.Call
+}
+
+// NotifyFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID uint
+//   - followeeID uint
+func (_e *NotificationService_Expecter) NotifyFollowRequest(ctx interface{}, followerID interface{}, followeeID interface{}) *NotificationService_NotifyFollowRequest_Call {
+	return &NotificationService_NotifyFollowRequest_Call{Call: _e.mock.On("NotifyFollowRequest", ctx, followerID, followeeID)}
+}
+
+func (_c *NotificationService_NotifyFollowRequest_Call) Run(run func(ctx context.Context, followerID uint, followeeID uint)) *NotificationService_NotifyFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *NotificationService_NotifyFollowRequest_Call) Return(_a0 error) *NotificationService_NotifyFollowRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationService_NotifyFollowRequest_Call) RunAndReturn(run func(context.Context, uint, uint) error) *NotificationService_NotifyFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NotifyNewSpot provides a mock function with given fields: ctx, spot, creatorID
 func (_m *NotificationService) NotifyNewSpot(ctx context.Context, spot *domain.Spot, creatorID uint) error {
 	ret := _m.Called(ctx, spot, creatorID)
@@ -0,0 +1,201 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OIDCAuthRequestRepository is an autogenerated mock type for the OIDCAuthRequestRepository type
+type OIDCAuthRequestRepository struct {
+	mock.Mock
+}
+
+type OIDCAuthRequestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OIDCAuthRequestRepository) EXPECT() *OIDCAuthRequestRepository_Expecter {
+	return &OIDCAuthRequestRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, request
+func (_m *OIDCAuthRequestRepository) Create(ctx context.Context, request *domain.OIDCAuthRequest) error {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.OIDCAuthRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCAuthRequestRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type OIDCAuthRequestRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *domain.OIDCAuthRequest
+func (_e *OIDCAuthRequestRepository_Expecter) Create(ctx interface{}, request interface{}) *OIDCAuthRequestRepository_Create_Call {
+	return &OIDCAuthRequestRepository_Create_Call{Call: _e.mock.On("Create", ctx, request)}
+}
+
+func (_c *OIDCAuthRequestRepository_Create_Call) Run(run func(ctx context.Context, request *domain.OIDCAuthRequest)) *OIDCAuthRequestRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.OIDCAuthRequest))
+	})
+	return _c
+}
+
+func (_c *OIDCAuthRequestRepository_Create_Call) Return(_a0 error) *OIDCAuthRequestRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OIDCAuthRequestRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.OIDCAuthRequest) error) *OIDCAuthRequestRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByStateHash provides a mock function with given fields: ctx, stateHash
+func (_m *OIDCAuthRequestRepository) FindByStateHash(ctx context.Context, stateHash string) (*domain.OIDCAuthRequest, error) {
+	ret := _m.Called(ctx, stateHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByStateHash")
+	}
+
+	var r0 *domain.OIDCAuthRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.OIDCAuthRequest, error)); ok {
+		return rf(ctx, stateHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.OIDCAuthRequest); ok {
+		r0 = rf(ctx, stateHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OIDCAuthRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, stateHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAuthRequestRepository_FindByStateHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByStateHash'
+type OIDCAuthRequestRepository_FindByStateHash_Call struct {
+	*mock.Call
+}
+
+// FindByStateHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stateHash string
+func (_e *OIDCAuthRequestRepository_Expecter) FindByStateHash(ctx interface{}, stateHash interface{}) *OIDCAuthRequestRepository_FindByStateHash_Call {
+	return &OIDCAuthRequestRepository_FindByStateHash_Call{Call: _e.mock.On("FindByStateHash", ctx, stateHash)}
+}
+
+func (_c *OIDCAuthRequestRepository_FindByStateHash_Call) Run(run func(ctx context.Context, stateHash string)) *OIDCAuthRequestRepository_FindByStateHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OIDCAuthRequestRepository_FindByStateHash_Call) Return(_a0 *domain.OIDCAuthRequest, _a1 error) *OIDCAuthRequestRepository_FindByStateHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAuthRequestRepository_FindByStateHash_Call) RunAndReturn(run func(context.Context, string) (*domain.OIDCAuthRequest, error)) *OIDCAuthRequestRepository_FindByStateHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsUsed provides a mock function with given fields: ctx, id
+func (_m *OIDCAuthRequestRepository) MarkAsUsed(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsUsed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAuthRequestRepository_MarkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUsed'
+type OIDCAuthRequestRepository_MarkAsUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAsUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *OIDCAuthRequestRepository_Expecter) MarkAsUsed(ctx interface{}, id interface{}) *OIDCAuthRequestRepository_MarkAsUsed_Call {
+	return &OIDCAuthRequestRepository_MarkAsUsed_Call{Call: _e.mock.On("MarkAsUsed", ctx, id)}
+}
+
+func (_c *OIDCAuthRequestRepository_MarkAsUsed_Call) Run(run func(ctx context.Context, id uint)) *OIDCAuthRequestRepository_MarkAsUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *OIDCAuthRequestRepository_MarkAsUsed_Call) Return(_a0 bool, _a1 error) *OIDCAuthRequestRepository_MarkAsUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAuthRequestRepository_MarkAsUsed_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *OIDCAuthRequestRepository_MarkAsUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOIDCAuthRequestRepository creates a new instance of OIDCAuthRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOIDCAuthRequestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OIDCAuthRequestRepository {
+	mock := &OIDCAuthRequestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
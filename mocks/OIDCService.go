@@ -0,0 +1,556 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	oidc "hopSpotAPI/pkg/oidc"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// OIDCService is an autogenerated mock type for the OIDCService type
+type OIDCService struct {
+	mock.Mock
+}
+
+type OIDCService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OIDCService) EXPECT() *OIDCService_Expecter {
+	return &OIDCService_Expecter{mock: &_m.Mock}
+}
+
+// Authorize provides a mock function with given fields: ctx, provider
+func (_m *OIDCService) Authorize(ctx context.Context, provider string) (*responses.OIDCAuthorizeResponse, error) {
+	ret := _m.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 *responses.OIDCAuthorizeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*responses.OIDCAuthorizeResponse, error)); ok {
+		return rf(ctx, provider)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *responses.OIDCAuthorizeResponse); ok {
+		r0 = rf(ctx, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.OIDCAuthorizeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCService_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type OIDCService_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+func (_e *OIDCService_Expecter) Authorize(ctx interface{}, provider interface{}) *OIDCService_Authorize_Call {
+	return &OIDCService_Authorize_Call{Call: _e.mock.On("Authorize", ctx, provider)}
+}
+
+func (_c *OIDCService_Authorize_Call) Run(run func(ctx context.Context, provider string)) *OIDCService_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OIDCService_Authorize_Call) Return(_a0 *responses.OIDCAuthorizeResponse, _a1 error) *OIDCService_Authorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCService_Authorize_Call) RunAndReturn(run func(context.Context, string) (*responses.OIDCAuthorizeResponse, error)) *OIDCService_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLink provides a mock function with given fields: ctx, userID, identity
+func (_m *OIDCService) CreateLink(ctx context.Context, userID uint, identity *oidc.Identity) (*domain.ExternalIdentity, error) {
+	ret := _m.Called(ctx, userID, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLink")
+	}
+
+	var r0 *domain.ExternalIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *oidc.Identity) (*domain.ExternalIdentity, error)); ok {
+		return rf(ctx, userID, identity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *oidc.Identity) *domain.ExternalIdentity); ok {
+		r0 = rf(ctx, userID, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExternalIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *oidc.Identity) error); ok {
+		r1 = rf(ctx, userID, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCService_CreateLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLink'
+type OIDCService_CreateLink_Call struct {
+	*mock.Call
+}
+
+// CreateLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - identity *oidc.Identity
+func (_e *OIDCService_Expecter) CreateLink(ctx interface{}, userID interface{}, identity interface{}) *OIDCService_CreateLink_Call {
+	return &OIDCService_CreateLink_Call{Call: _e.mock.On("CreateLink", ctx, userID, identity)}
+}
+
+func (_c *OIDCService_CreateLink_Call) Run(run func(ctx context.Context, userID uint, identity *oidc.Identity)) *OIDCService_CreateLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*oidc.Identity))
+	})
+	return _c
+}
+
+func (_c *OIDCService_CreateLink_Call) Return(_a0 *domain.ExternalIdentity, _a1 error) *OIDCService_CreateLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCService_CreateLink_Call) RunAndReturn(run func(context.Context, uint, *oidc.Identity) (*domain.ExternalIdentity, error)) *OIDCService_CreateLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLinkedUser provides a mock function with given fields: ctx, identity
+func (_m *OIDCService) FindLinkedUser(ctx context.Context, identity *oidc.Identity) (*domain.User, error) {
+	ret := _m.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLinkedUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *oidc.Identity) (*domain.User, error)); ok {
+		return rf(ctx, identity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *oidc.Identity) *domain.User); ok {
+		r0 = rf(ctx, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *oidc.Identity) error); ok {
+		r1 = rf(ctx, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCService_FindLinkedUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLinkedUser'
+type OIDCService_FindLinkedUser_Call struct {
+	*mock.Call
+}
+
+// FindLinkedUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *oidc.Identity
+func (_e *OIDCService_Expecter) FindLinkedUser(ctx interface{}, identity interface{}) *OIDCService_FindLinkedUser_Call {
+	return &OIDCService_FindLinkedUser_Call{Call: _e.mock.On("FindLinkedUser", ctx, identity)}
+}
+
+func (_c *OIDCService_FindLinkedUser_Call) Run(run func(ctx context.Context, identity *oidc.Identity)) *OIDCService_FindLinkedUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*oidc.Identity))
+	})
+	return _c
+}
+
+func (_c *OIDCService_FindLinkedUser_Call) Return(_a0 *domain.User, _a1 error) *OIDCService_FindLinkedUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCService_FindLinkedUser_Call) RunAndReturn(run func(context.Context, *oidc.Identity) (*domain.User, error)) *OIDCService_FindLinkedUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueNonce provides a mock function with given fields: ctx, provider
+func (_m *OIDCService) IssueNonce(ctx context.Context, provider string) (*responses.OIDCNonceResponse, error) {
+	ret := _m.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueNonce")
+	}
+
+	var r0 *responses.OIDCNonceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*responses.OIDCNonceResponse, error)); ok {
+		return rf(ctx, provider)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *responses.OIDCNonceResponse); ok {
+		r0 = rf(ctx, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.OIDCNonceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCService_IssueNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueNonce'
+type OIDCService_IssueNonce_Call struct {
+	*mock.Call
+}
+
+// IssueNonce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+func (_e *OIDCService_Expecter) IssueNonce(ctx interface{}, provider interface{}) *OIDCService_IssueNonce_Call {
+	return &OIDCService_IssueNonce_Call{Call: _e.mock.On("IssueNonce", ctx, provider)}
+}
+
+func (_c *OIDCService_IssueNonce_Call) Run(run func(ctx context.Context, provider string)) *OIDCService_IssueNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OIDCService_IssueNonce_Call) Return(_a0 *responses.OIDCNonceResponse, _a1 error) *OIDCService_IssueNonce_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCService_IssueNonce_Call) RunAndReturn(run func(context.Context, string) (*responses.OIDCNonceResponse, error)) *OIDCService_IssueNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkIdentity provides a mock function with given fields: ctx, userID, provider, credentials
+func (_m *OIDCService) LinkIdentity(ctx context.Context, userID uint, provider string, credentials *requests.OIDCCredentials) (*responses.ExternalIdentityResponse, error) {
+	ret := _m.Called(ctx, userID, provider, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkIdentity")
+	}
+
+	var r0 *responses.ExternalIdentityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, *requests.OIDCCredentials) (*responses.ExternalIdentityResponse, error)); ok {
+		return rf(ctx, userID, provider, credentials)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, *requests.OIDCCredentials) *responses.ExternalIdentityResponse); ok {
+		r0 = rf(ctx, userID, provider, credentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.ExternalIdentityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string, *requests.OIDCCredentials) error); ok {
+		r1 = rf(ctx, userID, provider, credentials)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCService_LinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkIdentity'
+type OIDCService_LinkIdentity_Call struct {
+	*mock.Call
+}
+
+// LinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - provider string
+//   - credentials *requests.OIDCCredentials
+func (_e *OIDCService_Expecter) LinkIdentity(ctx interface{}, userID interface{}, provider interface{}, credentials interface{}) *OIDCService_LinkIdentity_Call {
+	return &OIDCService_LinkIdentity_Call{Call: _e.mock.On("LinkIdentity", ctx, userID, provider, credentials)}
+}
+
+func (_c *OIDCService_LinkIdentity_Call) Run(run func(ctx context.Context, userID uint, provider string, credentials *requests.OIDCCredentials)) *OIDCService_LinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string), args[3].(*requests.OIDCCredentials))
+	})
+	return _c
+}
+
+func (_c *OIDCService_LinkIdentity_Call) Return(_a0 *responses.ExternalIdentityResponse, _a1 error) *OIDCService_LinkIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCService_LinkIdentity_Call) RunAndReturn(run func(context.Context, uint, string, *requests.OIDCCredentials) (*responses.ExternalIdentityResponse, error)) *OIDCService_LinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentities provides a mock function with given fields: ctx, userID
+func (_m *OIDCService) ListIdentities(ctx context.Context, userID uint) ([]responses.ExternalIdentityResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentities")
+	}
+
+	var r0 []responses.ExternalIdentityResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]responses.ExternalIdentityResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []responses.ExternalIdentityResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]responses.ExternalIdentityResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCService_ListIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentities'
+type OIDCService_ListIdentities_Call struct {
+	*mock.Call
+}
+
+// ListIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *OIDCService_Expecter) ListIdentities(ctx interface{}, userID interface{}) *OIDCService_ListIdentities_Call {
+	return &OIDCService_ListIdentities_Call{Call: _e.mock.On("ListIdentities", ctx, userID)}
+}
+
+func (_c *OIDCService_ListIdentities_Call) Run(run func(ctx context.Context, userID uint)) *OIDCService_ListIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *OIDCService_ListIdentities_Call) Return(_a0 []responses.ExternalIdentityResponse, _a1 error) *OIDCService_ListIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCService_ListIdentities_Call) RunAndReturn(run func(context.Context, uint) ([]responses.ExternalIdentityResponse, error)) *OIDCService_ListIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProviders provides a mock function with no fields
+func (_m *OIDCService) ListProviders() []responses.OIDCProviderResponse {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProviders")
+	}
+
+	var r0 []responses.OIDCProviderResponse
+	if rf, ok := ret.Get(0).(func() []responses.OIDCProviderResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]responses.OIDCProviderResponse)
+		}
+	}
+
+	return r0
+}
+
+// OIDCService_ListProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProviders'
+type OIDCService_ListProviders_Call struct {
+	*mock.Call
+}
+
+// ListProviders is a helper method to define mock.On call
+func (_e *OIDCService_Expecter) ListProviders() *OIDCService_ListProviders_Call {
+	return &OIDCService_ListProviders_Call{Call: _e.mock.On("ListProviders")}
+}
+
+func (_c *OIDCService_ListProviders_Call) Run(run func()) *OIDCService_ListProviders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OIDCService_ListProviders_Call) Return(_a0 []responses.OIDCProviderResponse) *OIDCService_ListProviders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OIDCService_ListProviders_Call) RunAndReturn(run func() []responses.OIDCProviderResponse) *OIDCService_ListProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlinkIdentity provides a mock function with given fields: ctx, userID, provider
+func (_m *OIDCService) UnlinkIdentity(ctx context.Context, userID uint, provider string) error {
+	ret := _m.Called(ctx, userID, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) error); ok {
+		r0 = rf(ctx, userID, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCService_UnlinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkIdentity'
+type OIDCService_UnlinkIdentity_Call struct {
+	*mock.Call
+}
+
+// UnlinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - provider string
+func (_e *OIDCService_Expecter) UnlinkIdentity(ctx interface{}, userID interface{}, provider interface{}) *OIDCService_UnlinkIdentity_Call {
+	return &OIDCService_UnlinkIdentity_Call{Call: _e.mock.On("UnlinkIdentity", ctx, userID, provider)}
+}
+
+func (_c *OIDCService_UnlinkIdentity_Call) Run(run func(ctx context.Context, userID uint, provider string)) *OIDCService_UnlinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OIDCService_UnlinkIdentity_Call) Return(_a0 error) *OIDCService_UnlinkIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OIDCService_UnlinkIdentity_Call) RunAndReturn(run func(context.Context, uint, string) error) *OIDCService_UnlinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: ctx, provider, credentials
+func (_m *OIDCService) Verify(ctx context.Context, provider string, credentials *requests.OIDCCredentials) (*oidc.Identity, error) {
+	ret := _m.Called(ctx, provider, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 *oidc.Identity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *requests.OIDCCredentials) (*oidc.Identity, error)); ok {
+		return rf(ctx, provider, credentials)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *requests.OIDCCredentials) *oidc.Identity); ok {
+		r0 = rf(ctx, provider, credentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oidc.Identity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *requests.OIDCCredentials) error); ok {
+		r1 = rf(ctx, provider, credentials)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCService_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type OIDCService_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - credentials *requests.OIDCCredentials
+func (_e *OIDCService_Expecter) Verify(ctx interface{}, provider interface{}, credentials interface{}) *OIDCService_Verify_Call {
+	return &OIDCService_Verify_Call{Call: _e.mock.On("Verify", ctx, provider, credentials)}
+}
+
+func (_c *OIDCService_Verify_Call) Run(run func(ctx context.Context, provider string, credentials *requests.OIDCCredentials)) *OIDCService_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*requests.OIDCCredentials))
+	})
+	return _c
+}
+
+func (_c *OIDCService_Verify_Call) Return(_a0 *oidc.Identity, _a1 error) *OIDCService_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCService_Verify_Call) RunAndReturn(run func(context.Context, string, *requests.OIDCCredentials) (*oidc.Identity, error)) *OIDCService_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOIDCService creates a new instance of OIDCService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOIDCService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OIDCService {
+	mock := &OIDCService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
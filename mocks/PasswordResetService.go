@@ -0,0 +1,132 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+)
+
+// PasswordResetService is an autogenerated mock type for the PasswordResetService type
+type PasswordResetService struct {
+	mock.Mock
+}
+
+type PasswordResetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PasswordResetService) EXPECT() *PasswordResetService_Expecter {
+	return &PasswordResetService_Expecter{mock: &_m.Mock}
+}
+
+// ForgotPassword provides a mock function with given fields: ctx, req
+func (_m *PasswordResetService) ForgotPassword(ctx context.Context, req *requests.ForgotPasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgotPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.ForgotPasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordResetService_ForgotPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgotPassword'
+type PasswordResetService_ForgotPassword_Call struct {
+	*mock.Call
+}
+
+// ForgotPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.ForgotPasswordRequest
+func (_e *PasswordResetService_Expecter) ForgotPassword(ctx interface{}, req interface{}) *PasswordResetService_ForgotPassword_Call {
+	return &PasswordResetService_ForgotPassword_Call{Call: _e.mock.On("ForgotPassword", ctx, req)}
+}
+
+func (_c *PasswordResetService_ForgotPassword_Call) Run(run func(ctx context.Context, req *requests.ForgotPasswordRequest)) *PasswordResetService_ForgotPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.ForgotPasswordRequest))
+	})
+	return _c
+}
+
+func (_c *PasswordResetService_ForgotPassword_Call) Return(_a0 error) *PasswordResetService_ForgotPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordResetService_ForgotPassword_Call) RunAndReturn(run func(context.Context, *requests.ForgotPasswordRequest) error) *PasswordResetService_ForgotPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function with given fields: ctx, req
+func (_m *PasswordResetService) ResetPassword(ctx context.Context, req *requests.ResetPasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.ResetPasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordResetService_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type PasswordResetService_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.ResetPasswordRequest
+func (_e *PasswordResetService_Expecter) ResetPassword(ctx interface{}, req interface{}) *PasswordResetService_ResetPassword_Call {
+	return &PasswordResetService_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, req)}
+}
+
+func (_c *PasswordResetService_ResetPassword_Call) Run(run func(ctx context.Context, req *requests.ResetPasswordRequest)) *PasswordResetService_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.ResetPasswordRequest))
+	})
+	return _c
+}
+
+func (_c *PasswordResetService_ResetPassword_Call) Return(_a0 error) *PasswordResetService_ResetPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordResetService_ResetPassword_Call) RunAndReturn(run func(context.Context, *requests.ResetPasswordRequest) error) *PasswordResetService_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPasswordResetService creates a new instance of PasswordResetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPasswordResetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PasswordResetService {
+	mock := &PasswordResetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,248 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PasswordResetTokenRepository is an autogenerated mock type for the PasswordResetTokenRepository type
+type PasswordResetTokenRepository struct {
+	mock.Mock
+}
+
+type PasswordResetTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PasswordResetTokenRepository) EXPECT() *PasswordResetTokenRepository_Expecter {
+	return &PasswordResetTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, token
+func (_m *PasswordResetTokenRepository) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PasswordResetToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordResetTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PasswordResetTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *domain.PasswordResetToken
+func (_e *PasswordResetTokenRepository_Expecter) Create(ctx interface{}, token interface{}) *PasswordResetTokenRepository_Create_Call {
+	return &PasswordResetTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *PasswordResetTokenRepository_Create_Call) Run(run func(ctx context.Context, token *domain.PasswordResetToken)) *PasswordResetTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PasswordResetToken))
+	})
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_Create_Call) Return(_a0 error) *PasswordResetTokenRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.PasswordResetToken) error) *PasswordResetTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *PasswordResetTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 *domain.PasswordResetToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.PasswordResetToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.PasswordResetToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PasswordResetToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasswordResetTokenRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type PasswordResetTokenRepository_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *PasswordResetTokenRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *PasswordResetTokenRepository_FindByTokenHash_Call {
+	return &PasswordResetTokenRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *PasswordResetTokenRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *PasswordResetTokenRepository_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_FindByTokenHash_Call) Return(_a0 *domain.PasswordResetToken, _a1 error) *PasswordResetTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_FindByTokenHash_Call) RunAndReturn(run func(context.Context, string) (*domain.PasswordResetToken, error)) *PasswordResetTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateByUserID provides a mock function with given fields: ctx, userID
+func (_m *PasswordResetTokenRepository) InvalidateByUserID(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PasswordResetTokenRepository_InvalidateByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateByUserID'
+type PasswordResetTokenRepository_InvalidateByUserID_Call struct {
+	*mock.Call
+}
+
+// InvalidateByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *PasswordResetTokenRepository_Expecter) InvalidateByUserID(ctx interface{}, userID interface{}) *PasswordResetTokenRepository_InvalidateByUserID_Call {
+	return &PasswordResetTokenRepository_InvalidateByUserID_Call{Call: _e.mock.On("InvalidateByUserID", ctx, userID)}
+}
+
+func (_c *PasswordResetTokenRepository_InvalidateByUserID_Call) Run(run func(ctx context.Context, userID uint)) *PasswordResetTokenRepository_InvalidateByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_InvalidateByUserID_Call) Return(_a0 error) *PasswordResetTokenRepository_InvalidateByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_InvalidateByUserID_Call) RunAndReturn(run func(context.Context, uint) error) *PasswordResetTokenRepository_InvalidateByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsUsed provides a mock function with given fields: ctx, id
+func (_m *PasswordResetTokenRepository) MarkAsUsed(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsUsed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasswordResetTokenRepository_MarkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUsed'
+type PasswordResetTokenRepository_MarkAsUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAsUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *PasswordResetTokenRepository_Expecter) MarkAsUsed(ctx interface{}, id interface{}) *PasswordResetTokenRepository_MarkAsUsed_Call {
+	return &PasswordResetTokenRepository_MarkAsUsed_Call{Call: _e.mock.On("MarkAsUsed", ctx, id)}
+}
+
+func (_c *PasswordResetTokenRepository_MarkAsUsed_Call) Run(run func(ctx context.Context, id uint)) *PasswordResetTokenRepository_MarkAsUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_MarkAsUsed_Call) Return(_a0 bool, _a1 error) *PasswordResetTokenRepository_MarkAsUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasswordResetTokenRepository_MarkAsUsed_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *PasswordResetTokenRepository_MarkAsUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPasswordResetTokenRepository creates a new instance of PasswordResetTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPasswordResetTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PasswordResetTokenRepository {
+	mock := &PasswordResetTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
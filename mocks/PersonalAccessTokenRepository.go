@@ -0,0 +1,311 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PersonalAccessTokenRepository is an autogenerated mock type for the PersonalAccessTokenRepository type
+type PersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+type PersonalAccessTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PersonalAccessTokenRepository) EXPECT() *PersonalAccessTokenRepository_Expecter {
+	return &PersonalAccessTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, token
+func (_m *PersonalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PersonalAccessToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PersonalAccessTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PersonalAccessTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *domain.PersonalAccessToken
+func (_e *PersonalAccessTokenRepository_Expecter) Create(ctx interface{}, token interface{}) *PersonalAccessTokenRepository_Create_Call {
+	return &PersonalAccessTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *PersonalAccessTokenRepository_Create_Call) Run(run func(ctx context.Context, token *domain.PersonalAccessToken)) *PersonalAccessTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.PersonalAccessToken))
+	})
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_Create_Call) Return(_a0 error) *PersonalAccessTokenRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.PersonalAccessToken) error) *PersonalAccessTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUser provides a mock function with given fields: ctx, id, userID
+func (_m *PersonalAccessTokenRepository) DeleteByUser(ctx context.Context, id uint, userID uint) (bool, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUser")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (bool, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) bool); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PersonalAccessTokenRepository_DeleteByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUser'
+type PersonalAccessTokenRepository_DeleteByUser_Call struct {
+	*mock.Call
+}
+
+// DeleteByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - userID uint
+func (_e *PersonalAccessTokenRepository_Expecter) DeleteByUser(ctx interface{}, id interface{}, userID interface{}) *PersonalAccessTokenRepository_DeleteByUser_Call {
+	return &PersonalAccessTokenRepository_DeleteByUser_Call{Call: _e.mock.On("DeleteByUser", ctx, id, userID)}
+}
+
+func (_c *PersonalAccessTokenRepository_DeleteByUser_Call) Run(run func(ctx context.Context, id uint, userID uint)) *PersonalAccessTokenRepository_DeleteByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_DeleteByUser_Call) Return(_a0 bool, _a1 error) *PersonalAccessTokenRepository_DeleteByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_DeleteByUser_Call) RunAndReturn(run func(context.Context, uint, uint) (bool, error)) *PersonalAccessTokenRepository_DeleteByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *PersonalAccessTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 *domain.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.PersonalAccessToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.PersonalAccessToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PersonalAccessTokenRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type PersonalAccessTokenRepository_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *PersonalAccessTokenRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *PersonalAccessTokenRepository_FindByTokenHash_Call {
+	return &PersonalAccessTokenRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *PersonalAccessTokenRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *PersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_FindByTokenHash_Call) Return(_a0 *domain.PersonalAccessToken, _a1 error) *PersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_FindByTokenHash_Call) RunAndReturn(run func(context.Context, string) (*domain.PersonalAccessToken, error)) *PersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID
+func (_m *PersonalAccessTokenRepository) FindByUserID(ctx context.Context, userID uint) ([]domain.PersonalAccessToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []domain.PersonalAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.PersonalAccessToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.PersonalAccessToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PersonalAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PersonalAccessTokenRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type PersonalAccessTokenRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *PersonalAccessTokenRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *PersonalAccessTokenRepository_FindByUserID_Call {
+	return &PersonalAccessTokenRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *PersonalAccessTokenRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID uint)) *PersonalAccessTokenRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_FindByUserID_Call) Return(_a0 []domain.PersonalAccessToken, _a1 error) *PersonalAccessTokenRepository_FindByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_FindByUserID_Call) RunAndReturn(run func(context.Context, uint) ([]domain.PersonalAccessToken, error)) *PersonalAccessTokenRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastUsed provides a mock function with given fields: ctx, id, olderThan
+func (_m *PersonalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id uint, olderThan time.Time) error {
+	ret := _m.Called(ctx, id, olderThan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, time.Time) error); ok {
+		r0 = rf(ctx, id, olderThan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PersonalAccessTokenRepository_UpdateLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastUsed'
+type PersonalAccessTokenRepository_UpdateLastUsed_Call struct {
+	*mock.Call
+}
+
+// UpdateLastUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - olderThan time.Time
+func (_e *PersonalAccessTokenRepository_Expecter) UpdateLastUsed(ctx interface{}, id interface{}, olderThan interface{}) *PersonalAccessTokenRepository_UpdateLastUsed_Call {
+	return &PersonalAccessTokenRepository_UpdateLastUsed_Call{Call: _e.mock.On("UpdateLastUsed", ctx, id, olderThan)}
+}
+
+func (_c *PersonalAccessTokenRepository_UpdateLastUsed_Call) Run(run func(ctx context.Context, id uint, olderThan time.Time)) *PersonalAccessTokenRepository_UpdateLastUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_UpdateLastUsed_Call) Return(_a0 error) *PersonalAccessTokenRepository_UpdateLastUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PersonalAccessTokenRepository_UpdateLastUsed_Call) RunAndReturn(run func(context.Context, uint, time.Time) error) *PersonalAccessTokenRepository_UpdateLastUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPersonalAccessTokenRepository creates a new instance of PersonalAccessTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPersonalAccessTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PersonalAccessTokenRepository {
+	mock := &PersonalAccessTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
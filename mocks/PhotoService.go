@@ -4,10 +4,13 @@ package mocks
 
 import (
 	context "context"
-	multipart "mime/multipart"
+
+	domain "hopSpotAPI/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
 
+	multipart "mime/multipart"
+
 	responses "hopSpotAPI/internal/dto/responses"
 )
 
@@ -24,17 +27,17 @@ func (_m *PhotoService) EXPECT() *PhotoService_Expecter {
 	return &PhotoService_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function with given fields: ctx, photoID, userID, isAdmin
-func (_m *PhotoService) Delete(ctx context.Context, photoID uint, userID uint, isAdmin bool) error {
-	ret := _m.Called(ctx, photoID, userID, isAdmin)
+// Delete provides a mock function with given fields: ctx, photoID, principal
+func (_m *PhotoService) Delete(ctx context.Context, photoID uint, principal domain.Principal) error {
+	ret := _m.Called(ctx, photoID, principal)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, bool) error); ok {
-		r0 = rf(ctx, photoID, userID, isAdmin)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Principal) error); ok {
+		r0 = rf(ctx, photoID, principal)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -50,15 +53,14 @@ type PhotoService_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - photoID uint
-//   - userID uint
-//   - isAdmin bool
-func (_e *PhotoService_Expecter) Delete(ctx interface{}, photoID interface{}, userID interface{}, isAdmin interface{}) *PhotoService_Delete_Call {
-	return &PhotoService_Delete_Call{Call: _e.mock.On("Delete", ctx, photoID, userID, isAdmin)}
+//   - principal domain.Principal
+func (_e *PhotoService_Expecter) Delete(ctx interface{}, photoID interface{}, principal interface{}) *PhotoService_Delete_Call {
+	return &PhotoService_Delete_Call{Call: _e.mock.On("Delete", ctx, photoID, principal)}
 }
 
-func (_c *PhotoService_Delete_Call) Run(run func(ctx context.Context, photoID uint, userID uint, isAdmin bool)) *PhotoService_Delete_Call {
+func (_c *PhotoService_Delete_Call) Run(run func(ctx context.Context, photoID uint, principal domain.Principal)) *PhotoService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(bool))
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Principal))
 	})
 	return _c
 }
@@ -68,26 +70,26 @@ func (_c *PhotoService_Delete_Call) Return(_a0 error) *PhotoService_Delete_Call
 	return _c
 }
 
-func (_c *PhotoService_Delete_Call) RunAndReturn(run func(context.Context, uint, uint, bool) error) *PhotoService_Delete_Call {
+func (_c *PhotoService_Delete_Call) RunAndReturn(run func(context.Context, uint, domain.Principal) error) *PhotoService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByBenchID provides a mock function with given fields: ctx, benchID
-func (_m *PhotoService) GetByBenchID(ctx context.Context, benchID uint) ([]responses.PhotoResponse, error) {
-	ret := _m.Called(ctx, benchID)
+// GetBySpotID provides a mock function with given fields: ctx, spotID
+func (_m *PhotoService) GetBySpotID(ctx context.Context, spotID uint) ([]responses.PhotoResponse, error) {
+	ret := _m.Called(ctx, spotID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByBenchID")
+		panic("no return value specified for GetBySpotID")
 	}
 
 	var r0 []responses.PhotoResponse
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]responses.PhotoResponse, error)); ok {
-		return rf(ctx, benchID)
+		return rf(ctx, spotID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uint) []responses.PhotoResponse); ok {
-		r0 = rf(ctx, benchID)
+		r0 = rf(ctx, spotID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]responses.PhotoResponse)
@@ -95,7 +97,7 @@ func (_m *PhotoService) GetByBenchID(ctx context.Context, benchID uint) ([]respo
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
-		r1 = rf(ctx, benchID)
+		r1 = rf(ctx, spotID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -103,31 +105,31 @@ func (_m *PhotoService) GetByBenchID(ctx context.Context, benchID uint) ([]respo
 	return r0, r1
 }
 
-// PhotoService_GetByBenchID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByBenchID'
-type PhotoService_GetByBenchID_Call struct {
+// PhotoService_GetBySpotID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySpotID'
+type PhotoService_GetBySpotID_Call struct {
 	*mock.Call
 }
 
-// GetByBenchID is a helper method to define mock.On call
+// GetBySpotID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - benchID uint
-func (_e *PhotoService_Expecter) GetByBenchID(ctx interface{}, benchID interface{}) *PhotoService_GetByBenchID_Call {
-	return &PhotoService_GetByBenchID_Call{Call: _e.mock.On("GetByBenchID", ctx, benchID)}
+//   - spotID uint
+func (_e *PhotoService_Expecter) GetBySpotID(ctx interface{}, spotID interface{}) *PhotoService_GetBySpotID_Call {
+	return &PhotoService_GetBySpotID_Call{Call: _e.mock.On("GetBySpotID", ctx, spotID)}
 }
 
-func (_c *PhotoService_GetByBenchID_Call) Run(run func(ctx context.Context, benchID uint)) *PhotoService_GetByBenchID_Call {
+func (_c *PhotoService_GetBySpotID_Call) Run(run func(ctx context.Context, spotID uint)) *PhotoService_GetBySpotID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *PhotoService_GetByBenchID_Call) Return(_a0 []responses.PhotoResponse, _a1 error) *PhotoService_GetByBenchID_Call {
+func (_c *PhotoService_GetBySpotID_Call) Return(_a0 []responses.PhotoResponse, _a1 error) *PhotoService_GetBySpotID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PhotoService_GetByBenchID_Call) RunAndReturn(run func(context.Context, uint) ([]responses.PhotoResponse, error)) *PhotoService_GetByBenchID_Call {
+func (_c *PhotoService_GetBySpotID_Call) RunAndReturn(run func(context.Context, uint) ([]responses.PhotoResponse, error)) *PhotoService_GetBySpotID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -190,17 +192,17 @@ func (_c *PhotoService_GetPresignedURL_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// SetMainPhoto provides a mock function with given fields: ctx, photoID, userID, isAdmin
-func (_m *PhotoService) SetMainPhoto(ctx context.Context, photoID uint, userID uint, isAdmin bool) error {
-	ret := _m.Called(ctx, photoID, userID, isAdmin)
+// SetMainPhoto provides a mock function with given fields: ctx, photoID, principal
+func (_m *PhotoService) SetMainPhoto(ctx context.Context, photoID uint, principal domain.Principal) error {
+	ret := _m.Called(ctx, photoID, principal)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetMainPhoto")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, bool) error); ok {
-		r0 = rf(ctx, photoID, userID, isAdmin)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Principal) error); ok {
+		r0 = rf(ctx, photoID, principal)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -216,15 +218,14 @@ type PhotoService_SetMainPhoto_Call struct {
 // SetMainPhoto is a helper method to define mock.On call
 //   - ctx context.Context
 //   - photoID uint
-//   - userID uint
-//   - isAdmin bool
-func (_e *PhotoService_Expecter) SetMainPhoto(ctx interface{}, photoID interface{}, userID interface{}, isAdmin interface{}) *PhotoService_SetMainPhoto_Call {
-	return &PhotoService_SetMainPhoto_Call{Call: _e.mock.On("SetMainPhoto", ctx, photoID, userID, isAdmin)}
+//   - principal domain.Principal
+func (_e *PhotoService_Expecter) SetMainPhoto(ctx interface{}, photoID interface{}, principal interface{}) *PhotoService_SetMainPhoto_Call {
+	return &PhotoService_SetMainPhoto_Call{Call: _e.mock.On("SetMainPhoto", ctx, photoID, principal)}
 }
 
-func (_c *PhotoService_SetMainPhoto_Call) Run(run func(ctx context.Context, photoID uint, userID uint, isAdmin bool)) *PhotoService_SetMainPhoto_Call {
+func (_c *PhotoService_SetMainPhoto_Call) Run(run func(ctx context.Context, photoID uint, principal domain.Principal)) *PhotoService_SetMainPhoto_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(bool))
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Principal))
 	})
 	return _c
 }
@@ -234,14 +235,14 @@ func (_c *PhotoService_SetMainPhoto_Call) Return(_a0 error) *PhotoService_SetMai
 	return _c
 }
 
-func (_c *PhotoService_SetMainPhoto_Call) RunAndReturn(run func(context.Context, uint, uint, bool) error) *PhotoService_SetMainPhoto_Call {
+func (_c *PhotoService_SetMainPhoto_Call) RunAndReturn(run func(context.Context, uint, domain.Principal) error) *PhotoService_SetMainPhoto_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Upload provides a mock function with given fields: ctx, benchID, userID, file, isMain
-func (_m *PhotoService) Upload(ctx context.Context, benchID uint, userID uint, file *multipart.FileHeader, isMain bool) (*responses.PhotoResponse, error) {
-	ret := _m.Called(ctx, benchID, userID, file, isMain)
+// Upload provides a mock function with given fields: ctx, spotID, userID, file, isMain
+func (_m *PhotoService) Upload(ctx context.Context, spotID uint, userID uint, file *multipart.FileHeader, isMain bool) (*responses.PhotoResponse, error) {
+	ret := _m.Called(ctx, spotID, userID, file, isMain)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Upload")
@@ -250,10 +251,10 @@ func (_m *PhotoService) Upload(ctx context.Context, benchID uint, userID uint, f
 	var r0 *responses.PhotoResponse
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *multipart.FileHeader, bool) (*responses.PhotoResponse, error)); ok {
-		return rf(ctx, benchID, userID, file, isMain)
+		return rf(ctx, spotID, userID, file, isMain)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *multipart.FileHeader, bool) *responses.PhotoResponse); ok {
-		r0 = rf(ctx, benchID, userID, file, isMain)
+		r0 = rf(ctx, spotID, userID, file, isMain)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*responses.PhotoResponse)
@@ -261,7 +262,7 @@ func (_m *PhotoService) Upload(ctx context.Context, benchID uint, userID uint, f
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *multipart.FileHeader, bool) error); ok {
-		r1 = rf(ctx, benchID, userID, file, isMain)
+		r1 = rf(ctx, spotID, userID, file, isMain)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -276,15 +277,15 @@ type PhotoService_Upload_Call struct {
 
 // Upload is a helper method to define mock.On call
 //   - ctx context.Context
-//   - benchID uint
+//   - spotID uint
 //   - userID uint
 //   - file *multipart.FileHeader
 //   - isMain bool
-func (_e *PhotoService_Expecter) Upload(ctx interface{}, benchID interface{}, userID interface{}, file interface{}, isMain interface{}) *PhotoService_Upload_Call {
-	return &PhotoService_Upload_Call{Call: _e.mock.On("Upload", ctx, benchID, userID, file, isMain)}
+func (_e *PhotoService_Expecter) Upload(ctx interface{}, spotID interface{}, userID interface{}, file interface{}, isMain interface{}) *PhotoService_Upload_Call {
+	return &PhotoService_Upload_Call{Call: _e.mock.On("Upload", ctx, spotID, userID, file, isMain)}
 }
 
-func (_c *PhotoService_Upload_Call) Run(run func(ctx context.Context, benchID uint, userID uint, file *multipart.FileHeader, isMain bool)) *PhotoService_Upload_Call {
+func (_c *PhotoService_Upload_Call) Run(run func(ctx context.Context, spotID uint, userID uint, file *multipart.FileHeader, isMain bool)) *PhotoService_Upload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*multipart.FileHeader), args[4].(bool))
 	})
@@ -0,0 +1,250 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RecoveryCodeRepository is an autogenerated mock type for the RecoveryCodeRepository type
+type RecoveryCodeRepository struct {
+	mock.Mock
+}
+
+type RecoveryCodeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RecoveryCodeRepository) EXPECT() *RecoveryCodeRepository_Expecter {
+	return &RecoveryCodeRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteByUserID provides a mock function with given fields: ctx, userID
+func (_m *RecoveryCodeRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecoveryCodeRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type RecoveryCodeRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *RecoveryCodeRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *RecoveryCodeRepository_DeleteByUserID_Call {
+	return &RecoveryCodeRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *RecoveryCodeRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID uint)) *RecoveryCodeRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_DeleteByUserID_Call) Return(_a0 error) *RecoveryCodeRepository_DeleteByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_DeleteByUserID_Call) RunAndReturn(run func(context.Context, uint) error) *RecoveryCodeRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUnusedByHash provides a mock function with given fields: ctx, userID, codeHash
+func (_m *RecoveryCodeRepository) FindUnusedByHash(ctx context.Context, userID uint, codeHash string) (*domain.RecoveryCode, error) {
+	ret := _m.Called(ctx, userID, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnusedByHash")
+	}
+
+	var r0 *domain.RecoveryCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) (*domain.RecoveryCode, error)); ok {
+		return rf(ctx, userID, codeHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) *domain.RecoveryCode); ok {
+		r0 = rf(ctx, userID, codeHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RecoveryCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, userID, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecoveryCodeRepository_FindUnusedByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnusedByHash'
+type RecoveryCodeRepository_FindUnusedByHash_Call struct {
+	*mock.Call
+}
+
+// FindUnusedByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - codeHash string
+func (_e *RecoveryCodeRepository_Expecter) FindUnusedByHash(ctx interface{}, userID interface{}, codeHash interface{}) *RecoveryCodeRepository_FindUnusedByHash_Call {
+	return &RecoveryCodeRepository_FindUnusedByHash_Call{Call: _e.mock.On("FindUnusedByHash", ctx, userID, codeHash)}
+}
+
+func (_c *RecoveryCodeRepository_FindUnusedByHash_Call) Run(run func(ctx context.Context, userID uint, codeHash string)) *RecoveryCodeRepository_FindUnusedByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_FindUnusedByHash_Call) Return(_a0 *domain.RecoveryCode, _a1 error) *RecoveryCodeRepository_FindUnusedByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_FindUnusedByHash_Call) RunAndReturn(run func(context.Context, uint, string) (*domain.RecoveryCode, error)) *RecoveryCodeRepository_FindUnusedByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsUsed provides a mock function with given fields: ctx, id
+func (_m *RecoveryCodeRepository) MarkAsUsed(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsUsed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecoveryCodeRepository_MarkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUsed'
+type RecoveryCodeRepository_MarkAsUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAsUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *RecoveryCodeRepository_Expecter) MarkAsUsed(ctx interface{}, id interface{}) *RecoveryCodeRepository_MarkAsUsed_Call {
+	return &RecoveryCodeRepository_MarkAsUsed_Call{Call: _e.mock.On("MarkAsUsed", ctx, id)}
+}
+
+func (_c *RecoveryCodeRepository_MarkAsUsed_Call) Run(run func(ctx context.Context, id uint)) *RecoveryCodeRepository_MarkAsUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_MarkAsUsed_Call) Return(_a0 bool, _a1 error) *RecoveryCodeRepository_MarkAsUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_MarkAsUsed_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *RecoveryCodeRepository_MarkAsUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceByUserID provides a mock function with given fields: ctx, userID, codes
+func (_m *RecoveryCodeRepository) ReplaceByUserID(ctx context.Context, userID uint, codes []domain.RecoveryCode) error {
+	ret := _m.Called(ctx, userID, codes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []domain.RecoveryCode) error); ok {
+		r0 = rf(ctx, userID, codes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecoveryCodeRepository_ReplaceByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceByUserID'
+type RecoveryCodeRepository_ReplaceByUserID_Call struct {
+	*mock.Call
+}
+
+// ReplaceByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - codes []domain.RecoveryCode
+func (_e *RecoveryCodeRepository_Expecter) ReplaceByUserID(ctx interface{}, userID interface{}, codes interface{}) *RecoveryCodeRepository_ReplaceByUserID_Call {
+	return &RecoveryCodeRepository_ReplaceByUserID_Call{Call: _e.mock.On("ReplaceByUserID", ctx, userID, codes)}
+}
+
+func (_c *RecoveryCodeRepository_ReplaceByUserID_Call) Run(run func(ctx context.Context, userID uint, codes []domain.RecoveryCode)) *RecoveryCodeRepository_ReplaceByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].([]domain.RecoveryCode))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_ReplaceByUserID_Call) Return(_a0 error) *RecoveryCodeRepository_ReplaceByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecoveryCodeRepository_ReplaceByUserID_Call) RunAndReturn(run func(context.Context, uint, []domain.RecoveryCode) error) *RecoveryCodeRepository_ReplaceByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRecoveryCodeRepository creates a new instance of RecoveryCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecoveryCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecoveryCodeRepository {
+	mock := &RecoveryCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
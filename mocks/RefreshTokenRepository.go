@@ -116,6 +116,183 @@ func (_c *RefreshTokenRepository_DeleteExpired_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// FindActiveByUserID provides a mock function with given fields: ctx, userID
+func (_m *RefreshTokenRepository) FindActiveByUserID(ctx context.Context, userID uint) ([]domain.RefreshToken, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveByUserID")
+	}
+
+	var r0 []domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.RefreshToken, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.RefreshToken); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTokenRepository_FindActiveByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActiveByUserID'
+type RefreshTokenRepository_FindActiveByUserID_Call struct {
+	*mock.Call
+}
+
+// FindActiveByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *RefreshTokenRepository_Expecter) FindActiveByUserID(ctx interface{}, userID interface{}) *RefreshTokenRepository_FindActiveByUserID_Call {
+	return &RefreshTokenRepository_FindActiveByUserID_Call{Call: _e.mock.On("FindActiveByUserID", ctx, userID)}
+}
+
+func (_c *RefreshTokenRepository_FindActiveByUserID_Call) Run(run func(ctx context.Context, userID uint)) *RefreshTokenRepository_FindActiveByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_FindActiveByUserID_Call) Return(_a0 []domain.RefreshToken, _a1 error) *RefreshTokenRepository_FindActiveByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_FindActiveByUserID_Call) RunAndReturn(run func(context.Context, uint) ([]domain.RefreshToken, error)) *RefreshTokenRepository_FindActiveByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *RefreshTokenRepository) FindByID(ctx context.Context, id uint) (*domain.RefreshToken, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.RefreshToken, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.RefreshToken); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTokenRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type RefreshTokenRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *RefreshTokenRepository_Expecter) FindByID(ctx interface{}, id interface{}) *RefreshTokenRepository_FindByID_Call {
+	return &RefreshTokenRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *RefreshTokenRepository_FindByID_Call) Run(run func(ctx context.Context, id uint)) *RefreshTokenRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_FindByID_Call) Return(_a0 *domain.RefreshToken, _a1 error) *RefreshTokenRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.RefreshToken, error)) *RefreshTokenRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByRotatedHash provides a mock function with given fields: ctx, tokenHash
+func (_m *RefreshTokenRepository) FindByRotatedHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByRotatedHash")
+	}
+
+	var r0 *domain.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTokenRepository_FindByRotatedHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRotatedHash'
+type RefreshTokenRepository_FindByRotatedHash_Call struct {
+	*mock.Call
+}
+
+// FindByRotatedHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *RefreshTokenRepository_Expecter) FindByRotatedHash(ctx interface{}, tokenHash interface{}) *RefreshTokenRepository_FindByRotatedHash_Call {
+	return &RefreshTokenRepository_FindByRotatedHash_Call{Call: _e.mock.On("FindByRotatedHash", ctx, tokenHash)}
+}
+
+func (_c *RefreshTokenRepository_FindByRotatedHash_Call) Run(run func(ctx context.Context, tokenHash string)) *RefreshTokenRepository_FindByRotatedHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_FindByRotatedHash_Call) Return(_a0 *domain.RefreshToken, _a1 error) *RefreshTokenRepository_FindByRotatedHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_FindByRotatedHash_Call) RunAndReturn(run func(context.Context, string) (*domain.RefreshToken, error)) *RefreshTokenRepository_FindByRotatedHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByTokenHash provides a mock function with given fields: ctx, tokenHash
 func (_m *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
 	ret := _m.Called(ctx, tokenHash)
@@ -269,6 +446,102 @@ func (_c *RefreshTokenRepository_RevokeByUserID_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// RevokeOthers provides a mock function with given fields: ctx, userID, keepID
+func (_m *RefreshTokenRepository) RevokeOthers(ctx context.Context, userID uint, keepID uint) error {
+	ret := _m.Called(ctx, userID, keepID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOthers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, userID, keepID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepository_RevokeOthers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOthers'
+type RefreshTokenRepository_RevokeOthers_Call struct {
+	*mock.Call
+}
+
+// RevokeOthers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - keepID uint
+func (_e *RefreshTokenRepository_Expecter) RevokeOthers(ctx interface{}, userID interface{}, keepID interface{}) *RefreshTokenRepository_RevokeOthers_Call {
+	return &RefreshTokenRepository_RevokeOthers_Call{Call: _e.mock.On("RevokeOthers", ctx, userID, keepID)}
+}
+
+func (_c *RefreshTokenRepository_RevokeOthers_Call) Run(run func(ctx context.Context, userID uint, keepID uint)) *RefreshTokenRepository_RevokeOthers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_RevokeOthers_Call) Return(_a0 error) *RefreshTokenRepository_RevokeOthers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_RevokeOthers_Call) RunAndReturn(run func(context.Context, uint, uint) error) *RefreshTokenRepository_RevokeOthers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function with given fields: ctx, token, previousHash
+func (_m *RefreshTokenRepository) Rotate(ctx context.Context, token *domain.RefreshToken, previousHash string) error {
+	ret := _m.Called(ctx, token, previousHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.RefreshToken, string) error); ok {
+		r0 = rf(ctx, token, previousHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepository_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type RefreshTokenRepository_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *domain.RefreshToken
+//   - previousHash string
+func (_e *RefreshTokenRepository_Expecter) Rotate(ctx interface{}, token interface{}, previousHash interface{}) *RefreshTokenRepository_Rotate_Call {
+	return &RefreshTokenRepository_Rotate_Call{Call: _e.mock.On("Rotate", ctx, token, previousHash)}
+}
+
+func (_c *RefreshTokenRepository_Rotate_Call) Run(run func(ctx context.Context, token *domain.RefreshToken, previousHash string)) *RefreshTokenRepository_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.RefreshToken), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepository_Rotate_Call) Return(_a0 error) *RefreshTokenRepository_Rotate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepository_Rotate_Call) RunAndReturn(run func(context.Context, *domain.RefreshToken, string) error) *RefreshTokenRepository_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRefreshTokenRepository creates a new instance of RefreshTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRefreshTokenRepository(t interface {
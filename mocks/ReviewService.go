@@ -0,0 +1,274 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// ReviewService is an autogenerated mock type for the ReviewService type
+type ReviewService struct {
+	mock.Mock
+}
+
+type ReviewService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReviewService) EXPECT() *ReviewService_Expecter {
+	return &ReviewService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, spotID, req, userID
+func (_m *ReviewService) Create(ctx context.Context, spotID uint, req *requests.CreateReviewRequest, userID uint) (*responses.ReviewResponse, error) {
+	ret := _m.Called(ctx, spotID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *responses.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.CreateReviewRequest, uint) (*responses.ReviewResponse, error)); ok {
+		return rf(ctx, spotID, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.CreateReviewRequest, uint) *responses.ReviewResponse); ok {
+		r0 = rf(ctx, spotID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.ReviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.CreateReviewRequest, uint) error); ok {
+		r1 = rf(ctx, spotID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ReviewService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+//   - req *requests.CreateReviewRequest
+//   - userID uint
+func (_e *ReviewService_Expecter) Create(ctx interface{}, spotID interface{}, req interface{}, userID interface{}) *ReviewService_Create_Call {
+	return &ReviewService_Create_Call{Call: _e.mock.On("Create", ctx, spotID, req, userID)}
+}
+
+func (_c *ReviewService_Create_Call) Run(run func(ctx context.Context, spotID uint, req *requests.CreateReviewRequest, userID uint)) *ReviewService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.CreateReviewRequest), args[3].(uint))
+	})
+	return _c
+}
+
+func (_c *ReviewService_Create_Call) Return(_a0 *responses.ReviewResponse, _a1 error) *ReviewService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReviewService_Create_Call) RunAndReturn(run func(context.Context, uint, *requests.CreateReviewRequest, uint) (*responses.ReviewResponse, error)) *ReviewService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, spotID, reviewID, principal
+func (_m *ReviewService) Delete(ctx context.Context, spotID uint, reviewID uint, principal domain.Principal) error {
+	ret := _m.Called(ctx, spotID, reviewID, principal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, domain.Principal) error); ok {
+		r0 = rf(ctx, spotID, reviewID, principal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReviewService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ReviewService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+//   - reviewID uint
+//   - principal domain.Principal
+func (_e *ReviewService_Expecter) Delete(ctx interface{}, spotID interface{}, reviewID interface{}, principal interface{}) *ReviewService_Delete_Call {
+	return &ReviewService_Delete_Call{Call: _e.mock.On("Delete", ctx, spotID, reviewID, principal)}
+}
+
+func (_c *ReviewService_Delete_Call) Run(run func(ctx context.Context, spotID uint, reviewID uint, principal domain.Principal)) *ReviewService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(domain.Principal))
+	})
+	return _c
+}
+
+func (_c *ReviewService_Delete_Call) Return(_a0 error) *ReviewService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReviewService_Delete_Call) RunAndReturn(run func(context.Context, uint, uint, domain.Principal) error) *ReviewService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, spotID, req
+func (_m *ReviewService) List(ctx context.Context, spotID uint, req *requests.ListReviewsRequest) (*responses.PaginatedReviewsResponse, error) {
+	ret := _m.Called(ctx, spotID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *responses.PaginatedReviewsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListReviewsRequest) (*responses.PaginatedReviewsResponse, error)); ok {
+		return rf(ctx, spotID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ListReviewsRequest) *responses.PaginatedReviewsResponse); ok {
+		r0 = rf(ctx, spotID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.PaginatedReviewsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.ListReviewsRequest) error); ok {
+		r1 = rf(ctx, spotID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ReviewService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+//   - req *requests.ListReviewsRequest
+func (_e *ReviewService_Expecter) List(ctx interface{}, spotID interface{}, req interface{}) *ReviewService_List_Call {
+	return &ReviewService_List_Call{Call: _e.mock.On("List", ctx, spotID, req)}
+}
+
+func (_c *ReviewService_List_Call) Run(run func(ctx context.Context, spotID uint, req *requests.ListReviewsRequest)) *ReviewService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.ListReviewsRequest))
+	})
+	return _c
+}
+
+func (_c *ReviewService_List_Call) Return(_a0 *responses.PaginatedReviewsResponse, _a1 error) *ReviewService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReviewService_List_Call) RunAndReturn(run func(context.Context, uint, *requests.ListReviewsRequest) (*responses.PaginatedReviewsResponse, error)) *ReviewService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, spotID, reviewID, req, principal
+func (_m *ReviewService) Update(ctx context.Context, spotID uint, reviewID uint, req *requests.UpdateReviewRequest, principal domain.Principal) (*responses.ReviewResponse, error) {
+	ret := _m.Called(ctx, spotID, reviewID, req, principal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *responses.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *requests.UpdateReviewRequest, domain.Principal) (*responses.ReviewResponse, error)); ok {
+		return rf(ctx, spotID, reviewID, req, principal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *requests.UpdateReviewRequest, domain.Principal) *responses.ReviewResponse); ok {
+		r0 = rf(ctx, spotID, reviewID, req, principal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.ReviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *requests.UpdateReviewRequest, domain.Principal) error); ok {
+		r1 = rf(ctx, spotID, reviewID, req, principal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ReviewService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+//   - reviewID uint
+//   - req *requests.UpdateReviewRequest
+//   - principal domain.Principal
+func (_e *ReviewService_Expecter) Update(ctx interface{}, spotID interface{}, reviewID interface{}, req interface{}, principal interface{}) *ReviewService_Update_Call {
+	return &ReviewService_Update_Call{Call: _e.mock.On("Update", ctx, spotID, reviewID, req, principal)}
+}
+
+func (_c *ReviewService_Update_Call) Run(run func(ctx context.Context, spotID uint, reviewID uint, req *requests.UpdateReviewRequest, principal domain.Principal)) *ReviewService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*requests.UpdateReviewRequest), args[4].(domain.Principal))
+	})
+	return _c
+}
+
+func (_c *ReviewService_Update_Call) Return(_a0 *responses.ReviewResponse, _a1 error) *ReviewService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReviewService_Update_Call) RunAndReturn(run func(context.Context, uint, uint, *requests.UpdateReviewRequest, domain.Principal) (*responses.ReviewResponse, error)) *ReviewService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReviewService creates a new instance of ReviewService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewService {
+	mock := &ReviewService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SecurityEventRepository is an autogenerated mock type for the SecurityEventRepository type
+type SecurityEventRepository struct {
+	mock.Mock
+}
+
+type SecurityEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecurityEventRepository) EXPECT() *SecurityEventRepository_Expecter {
+	return &SecurityEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, event
+func (_m *SecurityEventRepository) Create(ctx context.Context, event *domain.SecurityEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SecurityEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecurityEventRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SecurityEventRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.SecurityEvent
+func (_e *SecurityEventRepository_Expecter) Create(ctx interface{}, event interface{}) *SecurityEventRepository_Create_Call {
+	return &SecurityEventRepository_Create_Call{Call: _e.mock.On("Create", ctx, event)}
+}
+
+func (_c *SecurityEventRepository_Create_Call) Run(run func(ctx context.Context, event *domain.SecurityEvent)) *SecurityEventRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SecurityEvent))
+	})
+	return _c
+}
+
+func (_c *SecurityEventRepository_Create_Call) Return(_a0 error) *SecurityEventRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecurityEventRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.SecurityEvent) error) *SecurityEventRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecurityEventRepository creates a new instance of SecurityEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecurityEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecurityEventRepository {
+	mock := &SecurityEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -25,6 +25,63 @@ func (_m *SpotRepository) EXPECT() *SpotRepository_Expecter {
 	return &SpotRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountByCreator provides a mock function with given fields: ctx, userID
+func (_m *SpotRepository) CountByCreator(ctx context.Context, userID uint) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCreator")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotRepository_CountByCreator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByCreator'
+type SpotRepository_CountByCreator_Call struct {
+	*mock.Call
+}
+
+// CountByCreator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *SpotRepository_Expecter) CountByCreator(ctx interface{}, userID interface{}) *SpotRepository_CountByCreator_Call {
+	return &SpotRepository_CountByCreator_Call{Call: _e.mock.On("CountByCreator", ctx, userID)}
+}
+
+func (_c *SpotRepository_CountByCreator_Call) Run(run func(ctx context.Context, userID uint)) *SpotRepository_CountByCreator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_CountByCreator_Call) Return(_a0 int64, _a1 error) *SpotRepository_CountByCreator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotRepository_CountByCreator_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *SpotRepository_CountByCreator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, spot
 func (_m *SpotRepository) Create(ctx context.Context, spot *domain.Spot) error {
 	ret := _m.Called(ctx, spot)
@@ -185,6 +242,305 @@ func (_c *SpotRepository_FindAll_Call) RunAndReturn(run func(context.Context, re
 	return _c
 }
 
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *SpotRepository) FindByID(ctx context.Context, id uint) (*domain.Spot, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.Spot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Spot, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Spot); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Spot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type SpotRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *SpotRepository_Expecter) FindByID(ctx interface{}, id interface{}) *SpotRepository_FindByID_Call {
+	return &SpotRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *SpotRepository_FindByID_Call) Run(run func(ctx context.Context, id uint)) *SpotRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_FindByID_Call) Return(_a0 *domain.Spot, _a1 error) *SpotRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Spot, error)) *SpotRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByName provides a mock function with given fields: ctx, name
+func (_m *SpotRepository) FindByName(ctx context.Context, name string) (*domain.Spot, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 *domain.Spot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Spot, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Spot); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Spot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
+type SpotRepository_FindByName_Call struct {
+	*mock.Call
+}
+
+// FindByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *SpotRepository_Expecter) FindByName(ctx interface{}, name interface{}) *SpotRepository_FindByName_Call {
+	return &SpotRepository_FindByName_Call{Call: _e.mock.On("FindByName", ctx, name)}
+}
+
+func (_c *SpotRepository_FindByName_Call) Run(run func(ctx context.Context, name string)) *SpotRepository_FindByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_FindByName_Call) Return(_a0 *domain.Spot, _a1 error) *SpotRepository_FindByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotRepository_FindByName_Call) RunAndReturn(run func(context.Context, string) (*domain.Spot, error)) *SpotRepository_FindByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindClustersInBounds provides a mock function with given fields: ctx, bounds, cellSize
+func (_m *SpotRepository) FindClustersInBounds(ctx context.Context, bounds repository.BoundingBox, cellSize float64) ([]repository.SpotCluster, error) {
+	ret := _m.Called(ctx, bounds, cellSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindClustersInBounds")
+	}
+
+	var r0 []repository.SpotCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.BoundingBox, float64) ([]repository.SpotCluster, error)); ok {
+		return rf(ctx, bounds, cellSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.BoundingBox, float64) []repository.SpotCluster); ok {
+		r0 = rf(ctx, bounds, cellSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SpotCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.BoundingBox, float64) error); ok {
+		r1 = rf(ctx, bounds, cellSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotRepository_FindClustersInBounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindClustersInBounds'
+type SpotRepository_FindClustersInBounds_Call struct {
+	*mock.Call
+}
+
+// FindClustersInBounds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bounds repository.BoundingBox
+//   - cellSize float64
+func (_e *SpotRepository_Expecter) FindClustersInBounds(ctx interface{}, bounds interface{}, cellSize interface{}) *SpotRepository_FindClustersInBounds_Call {
+	return &SpotRepository_FindClustersInBounds_Call{Call: _e.mock.On("FindClustersInBounds", ctx, bounds, cellSize)}
+}
+
+func (_c *SpotRepository_FindClustersInBounds_Call) Run(run func(ctx context.Context, bounds repository.BoundingBox, cellSize float64)) *SpotRepository_FindClustersInBounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.BoundingBox), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_FindClustersInBounds_Call) Return(_a0 []repository.SpotCluster, _a1 error) *SpotRepository_FindClustersInBounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotRepository_FindClustersInBounds_Call) RunAndReturn(run func(context.Context, repository.BoundingBox, float64) ([]repository.SpotCluster, error)) *SpotRepository_FindClustersInBounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMarkersInBounds provides a mock function with given fields: ctx, bounds, limit
+func (_m *SpotRepository) FindMarkersInBounds(ctx context.Context, bounds repository.BoundingBox, limit int) ([]repository.SpotMarker, error) {
+	ret := _m.Called(ctx, bounds, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMarkersInBounds")
+	}
+
+	var r0 []repository.SpotMarker
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.BoundingBox, int) ([]repository.SpotMarker, error)); ok {
+		return rf(ctx, bounds, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.BoundingBox, int) []repository.SpotMarker); ok {
+		r0 = rf(ctx, bounds, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SpotMarker)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.BoundingBox, int) error); ok {
+		r1 = rf(ctx, bounds, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotRepository_FindMarkersInBounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMarkersInBounds'
+type SpotRepository_FindMarkersInBounds_Call struct {
+	*mock.Call
+}
+
+// FindMarkersInBounds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bounds repository.BoundingBox
+//   - limit int
+func (_e *SpotRepository_Expecter) FindMarkersInBounds(ctx interface{}, bounds interface{}, limit interface{}) *SpotRepository_FindMarkersInBounds_Call {
+	return &SpotRepository_FindMarkersInBounds_Call{Call: _e.mock.On("FindMarkersInBounds", ctx, bounds, limit)}
+}
+
+func (_c *SpotRepository_FindMarkersInBounds_Call) Run(run func(ctx context.Context, bounds repository.BoundingBox, limit int)) *SpotRepository_FindMarkersInBounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.BoundingBox), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_FindMarkersInBounds_Call) Return(_a0 []repository.SpotMarker, _a1 error) *SpotRepository_FindMarkersInBounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotRepository_FindMarkersInBounds_Call) RunAndReturn(run func(context.Context, repository.BoundingBox, int) ([]repository.SpotMarker, error)) *SpotRepository_FindMarkersInBounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindNearest provides a mock function with given fields: ctx, lat, lon, limit
+func (_m *SpotRepository) FindNearest(ctx context.Context, lat float64, lon float64, limit int) ([]domain.Spot, error) {
+	ret := _m.Called(ctx, lat, lon, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNearest")
+	}
+
+	var r0 []domain.Spot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64, int) ([]domain.Spot, error)); ok {
+		return rf(ctx, lat, lon, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64, int) []domain.Spot); ok {
+		r0 = rf(ctx, lat, lon, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Spot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, float64, float64, int) error); ok {
+		r1 = rf(ctx, lat, lon, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotRepository_FindNearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNearest'
+type SpotRepository_FindNearest_Call struct {
+	*mock.Call
+}
+
+// FindNearest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lat float64
+//   - lon float64
+//   - limit int
+func (_e *SpotRepository_Expecter) FindNearest(ctx interface{}, lat interface{}, lon interface{}, limit interface{}) *SpotRepository_FindNearest_Call {
+	return &SpotRepository_FindNearest_Call{Call: _e.mock.On("FindNearest", ctx, lat, lon, limit)}
+}
+
+func (_c *SpotRepository_FindNearest_Call) Run(run func(ctx context.Context, lat float64, lon float64, limit int)) *SpotRepository_FindNearest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(float64), args[2].(float64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_FindNearest_Call) Return(_a0 []domain.Spot, _a1 error) *SpotRepository_FindNearest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotRepository_FindNearest_Call) RunAndReturn(run func(context.Context, float64, float64, int) ([]domain.Spot, error)) *SpotRepository_FindNearest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindRandom provides a mock function with given fields: ctx
 func (_m *SpotRepository) FindRandom(ctx context.Context) (*domain.Spot, error) {
 	ret := _m.Called(ctx)
@@ -243,29 +599,29 @@ func (_c *SpotRepository_FindRandom_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
-// FindByID provides a mock function with given fields: ctx, id
-func (_m *SpotRepository) FindByID(ctx context.Context, id uint) (*domain.Spot, error) {
-	ret := _m.Called(ctx, id)
+// FindWithinRadius provides a mock function with given fields: ctx, lat, lon, radius, excludeID
+func (_m *SpotRepository) FindWithinRadius(ctx context.Context, lat float64, lon float64, radius float64, excludeID uint) ([]domain.Spot, error) {
+	ret := _m.Called(ctx, lat, lon, radius, excludeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByID")
+		panic("no return value specified for FindWithinRadius")
 	}
 
-	var r0 *domain.Spot
+	var r0 []domain.Spot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.Spot, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64, float64, uint) ([]domain.Spot, error)); ok {
+		return rf(ctx, lat, lon, radius, excludeID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.Spot); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64, float64, uint) []domain.Spot); ok {
+		r0 = rf(ctx, lat, lon, radius, excludeID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Spot)
+			r0 = ret.Get(0).([]domain.Spot)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, float64, float64, float64, uint) error); ok {
+		r1 = rf(ctx, lat, lon, radius, excludeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -273,31 +629,130 @@ func (_m *SpotRepository) FindByID(ctx context.Context, id uint) (*domain.Spot,
 	return r0, r1
 }
 
-// SpotRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
-type SpotRepository_FindByID_Call struct {
+// SpotRepository_FindWithinRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWithinRadius'
+type SpotRepository_FindWithinRadius_Call struct {
 	*mock.Call
 }
 
-// FindByID is a helper method to define mock.On call
+// FindWithinRadius is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uint
-func (_e *SpotRepository_Expecter) FindByID(ctx interface{}, id interface{}) *SpotRepository_FindByID_Call {
-	return &SpotRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+//   - lat float64
+//   - lon float64
+//   - radius float64
+//   - excludeID uint
+func (_e *SpotRepository_Expecter) FindWithinRadius(ctx interface{}, lat interface{}, lon interface{}, radius interface{}, excludeID interface{}) *SpotRepository_FindWithinRadius_Call {
+	return &SpotRepository_FindWithinRadius_Call{Call: _e.mock.On("FindWithinRadius", ctx, lat, lon, radius, excludeID)}
 }
 
-func (_c *SpotRepository_FindByID_Call) Run(run func(ctx context.Context, id uint)) *SpotRepository_FindByID_Call {
+func (_c *SpotRepository_FindWithinRadius_Call) Run(run func(ctx context.Context, lat float64, lon float64, radius float64, excludeID uint)) *SpotRepository_FindWithinRadius_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint))
+		run(args[0].(context.Context), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(uint))
 	})
 	return _c
 }
 
-func (_c *SpotRepository_FindByID_Call) Return(_a0 *domain.Spot, _a1 error) *SpotRepository_FindByID_Call {
+func (_c *SpotRepository_FindWithinRadius_Call) Return(_a0 []domain.Spot, _a1 error) *SpotRepository_FindWithinRadius_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SpotRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.Spot, error)) *SpotRepository_FindByID_Call {
+func (_c *SpotRepository_FindWithinRadius_Call) RunAndReturn(run func(context.Context, float64, float64, float64, uint) ([]domain.Spot, error)) *SpotRepository_FindWithinRadius_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: ctx, sourceID, targetID
+func (_m *SpotRepository) Merge(ctx context.Context, sourceID uint, targetID uint) error {
+	ret := _m.Called(ctx, sourceID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, sourceID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SpotRepository_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type SpotRepository_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceID uint
+//   - targetID uint
+func (_e *SpotRepository_Expecter) Merge(ctx interface{}, sourceID interface{}, targetID interface{}) *SpotRepository_Merge_Call {
+	return &SpotRepository_Merge_Call{Call: _e.mock.On("Merge", ctx, sourceID, targetID)}
+}
+
+func (_c *SpotRepository_Merge_Call) Run(run func(ctx context.Context, sourceID uint, targetID uint)) *SpotRepository_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_Merge_Call) Return(_a0 error) *SpotRepository_Merge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SpotRepository_Merge_Call) RunAndReturn(run func(context.Context, uint, uint) error) *SpotRepository_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceAmenities provides a mock function with given fields: ctx, spotID, amenities
+func (_m *SpotRepository) ReplaceAmenities(ctx context.Context, spotID uint, amenities []domain.Amenity) error {
+	ret := _m.Called(ctx, spotID, amenities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceAmenities")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []domain.Amenity) error); ok {
+		r0 = rf(ctx, spotID, amenities)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SpotRepository_ReplaceAmenities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceAmenities'
+type SpotRepository_ReplaceAmenities_Call struct {
+	*mock.Call
+}
+
+// ReplaceAmenities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+//   - amenities []domain.Amenity
+func (_e *SpotRepository_Expecter) ReplaceAmenities(ctx interface{}, spotID interface{}, amenities interface{}) *SpotRepository_ReplaceAmenities_Call {
+	return &SpotRepository_ReplaceAmenities_Call{Call: _e.mock.On("ReplaceAmenities", ctx, spotID, amenities)}
+}
+
+func (_c *SpotRepository_ReplaceAmenities_Call) Run(run func(ctx context.Context, spotID uint, amenities []domain.Amenity)) *SpotRepository_ReplaceAmenities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].([]domain.Amenity))
+	})
+	return _c
+}
+
+func (_c *SpotRepository_ReplaceAmenities_Call) Return(_a0 error) *SpotRepository_ReplaceAmenities_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SpotRepository_ReplaceAmenities_Call) RunAndReturn(run func(context.Context, uint, []domain.Amenity) error) *SpotRepository_ReplaceAmenities_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,424 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "hopSpotAPI/internal/repository"
+)
+
+// SpotReviewRepository is an autogenerated mock type for the SpotReviewRepository type
+type SpotReviewRepository struct {
+	mock.Mock
+}
+
+type SpotReviewRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SpotReviewRepository) EXPECT() *SpotReviewRepository_Expecter {
+	return &SpotReviewRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, review
+func (_m *SpotReviewRepository) Create(ctx context.Context, review *domain.SpotReview) (bool, error) {
+	ret := _m.Called(ctx, review)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SpotReview) (bool, error)); ok {
+		return rf(ctx, review)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SpotReview) bool); ok {
+		r0 = rf(ctx, review)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.SpotReview) error); ok {
+		r1 = rf(ctx, review)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotReviewRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SpotReviewRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - review *domain.SpotReview
+func (_e *SpotReviewRepository_Expecter) Create(ctx interface{}, review interface{}) *SpotReviewRepository_Create_Call {
+	return &SpotReviewRepository_Create_Call{Call: _e.mock.On("Create", ctx, review)}
+}
+
+func (_c *SpotReviewRepository_Create_Call) Run(run func(ctx context.Context, review *domain.SpotReview)) *SpotReviewRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SpotReview))
+	})
+	return _c
+}
+
+func (_c *SpotReviewRepository_Create_Call) Return(_a0 bool, _a1 error) *SpotReviewRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotReviewRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.SpotReview) (bool, error)) *SpotReviewRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SpotReviewRepository) Delete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SpotReviewRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SpotReviewRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *SpotReviewRepository_Expecter) Delete(ctx interface{}, id interface{}) *SpotReviewRepository_Delete_Call {
+	return &SpotReviewRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *SpotReviewRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *SpotReviewRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotReviewRepository_Delete_Call) Return(_a0 error) *SpotReviewRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SpotReviewRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *SpotReviewRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBySpotID provides a mock function with given fields: ctx, spotID
+func (_m *SpotReviewRepository) DeleteBySpotID(ctx context.Context, spotID uint) error {
+	ret := _m.Called(ctx, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySpotID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, spotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SpotReviewRepository_DeleteBySpotID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBySpotID'
+type SpotReviewRepository_DeleteBySpotID_Call struct {
+	*mock.Call
+}
+
+// DeleteBySpotID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+func (_e *SpotReviewRepository_Expecter) DeleteBySpotID(ctx interface{}, spotID interface{}) *SpotReviewRepository_DeleteBySpotID_Call {
+	return &SpotReviewRepository_DeleteBySpotID_Call{Call: _e.mock.On("DeleteBySpotID", ctx, spotID)}
+}
+
+func (_c *SpotReviewRepository_DeleteBySpotID_Call) Run(run func(ctx context.Context, spotID uint)) *SpotReviewRepository_DeleteBySpotID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotReviewRepository_DeleteBySpotID_Call) Return(_a0 error) *SpotReviewRepository_DeleteBySpotID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SpotReviewRepository_DeleteBySpotID_Call) RunAndReturn(run func(context.Context, uint) error) *SpotReviewRepository_DeleteBySpotID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *SpotReviewRepository) FindByID(ctx context.Context, id uint) (*domain.SpotReview, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.SpotReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*domain.SpotReview, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *domain.SpotReview); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SpotReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotReviewRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type SpotReviewRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *SpotReviewRepository_Expecter) FindByID(ctx interface{}, id interface{}) *SpotReviewRepository_FindByID_Call {
+	return &SpotReviewRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *SpotReviewRepository_FindByID_Call) Run(run func(ctx context.Context, id uint)) *SpotReviewRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotReviewRepository_FindByID_Call) Return(_a0 *domain.SpotReview, _a1 error) *SpotReviewRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotReviewRepository_FindByID_Call) RunAndReturn(run func(context.Context, uint) (*domain.SpotReview, error)) *SpotReviewRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySpotID provides a mock function with given fields: ctx, spotID, filter
+func (_m *SpotReviewRepository) FindBySpotID(ctx context.Context, spotID uint, filter repository.ReviewFilter) ([]domain.SpotReview, int64, error) {
+	ret := _m.Called(ctx, spotID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySpotID")
+	}
+
+	var r0 []domain.SpotReview
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.ReviewFilter) ([]domain.SpotReview, int64, error)); ok {
+		return rf(ctx, spotID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, repository.ReviewFilter) []domain.SpotReview); ok {
+		r0 = rf(ctx, spotID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SpotReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, repository.ReviewFilter) int64); ok {
+		r1 = rf(ctx, spotID, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint, repository.ReviewFilter) error); ok {
+		r2 = rf(ctx, spotID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SpotReviewRepository_FindBySpotID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySpotID'
+type SpotReviewRepository_FindBySpotID_Call struct {
+	*mock.Call
+}
+
+// FindBySpotID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+//   - filter repository.ReviewFilter
+func (_e *SpotReviewRepository_Expecter) FindBySpotID(ctx interface{}, spotID interface{}, filter interface{}) *SpotReviewRepository_FindBySpotID_Call {
+	return &SpotReviewRepository_FindBySpotID_Call{Call: _e.mock.On("FindBySpotID", ctx, spotID, filter)}
+}
+
+func (_c *SpotReviewRepository_FindBySpotID_Call) Run(run func(ctx context.Context, spotID uint, filter repository.ReviewFilter)) *SpotReviewRepository_FindBySpotID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(repository.ReviewFilter))
+	})
+	return _c
+}
+
+func (_c *SpotReviewRepository_FindBySpotID_Call) Return(_a0 []domain.SpotReview, _a1 int64, _a2 error) *SpotReviewRepository_FindBySpotID_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SpotReviewRepository_FindBySpotID_Call) RunAndReturn(run func(context.Context, uint, repository.ReviewFilter) ([]domain.SpotReview, int64, error)) *SpotReviewRepository_FindBySpotID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserAndSpot provides a mock function with given fields: ctx, userID, spotID
+func (_m *SpotReviewRepository) FindByUserAndSpot(ctx context.Context, userID uint, spotID uint) (*domain.SpotReview, error) {
+	ret := _m.Called(ctx, userID, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserAndSpot")
+	}
+
+	var r0 *domain.SpotReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (*domain.SpotReview, error)); ok {
+		return rf(ctx, userID, spotID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) *domain.SpotReview); ok {
+		r0 = rf(ctx, userID, spotID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SpotReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, userID, spotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotReviewRepository_FindByUserAndSpot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserAndSpot'
+type SpotReviewRepository_FindByUserAndSpot_Call struct {
+	*mock.Call
+}
+
+// FindByUserAndSpot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - spotID uint
+func (_e *SpotReviewRepository_Expecter) FindByUserAndSpot(ctx interface{}, userID interface{}, spotID interface{}) *SpotReviewRepository_FindByUserAndSpot_Call {
+	return &SpotReviewRepository_FindByUserAndSpot_Call{Call: _e.mock.On("FindByUserAndSpot", ctx, userID, spotID)}
+}
+
+func (_c *SpotReviewRepository_FindByUserAndSpot_Call) Run(run func(ctx context.Context, userID uint, spotID uint)) *SpotReviewRepository_FindByUserAndSpot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotReviewRepository_FindByUserAndSpot_Call) Return(_a0 *domain.SpotReview, _a1 error) *SpotReviewRepository_FindByUserAndSpot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotReviewRepository_FindByUserAndSpot_Call) RunAndReturn(run func(context.Context, uint, uint) (*domain.SpotReview, error)) *SpotReviewRepository_FindByUserAndSpot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, review
+func (_m *SpotReviewRepository) Update(ctx context.Context, review *domain.SpotReview) error {
+	ret := _m.Called(ctx, review)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SpotReview) error); ok {
+		r0 = rf(ctx, review)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SpotReviewRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SpotReviewRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - review *domain.SpotReview
+func (_e *SpotReviewRepository_Expecter) Update(ctx interface{}, review interface{}) *SpotReviewRepository_Update_Call {
+	return &SpotReviewRepository_Update_Call{Call: _e.mock.On("Update", ctx, review)}
+}
+
+func (_c *SpotReviewRepository_Update_Call) Run(run func(ctx context.Context, review *domain.SpotReview)) *SpotReviewRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SpotReview))
+	})
+	return _c
+}
+
+func (_c *SpotReviewRepository_Update_Call) Return(_a0 error) *SpotReviewRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SpotReviewRepository_Update_Call) RunAndReturn(run func(context.Context, *domain.SpotReview) error) *SpotReviewRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSpotReviewRepository creates a new instance of SpotReviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSpotReviewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SpotReviewRepository {
+	mock := &SpotReviewRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,10 +5,14 @@ package mocks
 import (
 	context "context"
 
-	requests "hopSpotAPI/internal/dto/requests"
+	domain "hopSpotAPI/internal/domain"
+
+	io "io"
 
 	mock "github.com/stretchr/testify/mock"
 
+	requests "hopSpotAPI/internal/dto/requests"
+
 	responses "hopSpotAPI/internal/dto/responses"
 )
 
@@ -85,17 +89,17 @@ func (_c *SpotService_Create_Call) RunAndReturn(run func(context.Context, *reque
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id, userID, isAdmin
-func (_m *SpotService) Delete(ctx context.Context, id uint, userID uint, isAdmin bool) error {
-	ret := _m.Called(ctx, id, userID, isAdmin)
+// Delete provides a mock function with given fields: ctx, id, principal
+func (_m *SpotService) Delete(ctx context.Context, id uint, principal domain.Principal) error {
+	ret := _m.Called(ctx, id, principal)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, bool) error); ok {
-		r0 = rf(ctx, id, userID, isAdmin)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Principal) error); ok {
+		r0 = rf(ctx, id, principal)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -111,15 +115,14 @@ type SpotService_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uint
-//   - userID uint
-//   - isAdmin bool
-func (_e *SpotService_Expecter) Delete(ctx interface{}, id interface{}, userID interface{}, isAdmin interface{}) *SpotService_Delete_Call {
-	return &SpotService_Delete_Call{Call: _e.mock.On("Delete", ctx, id, userID, isAdmin)}
+//   - principal domain.Principal
+func (_e *SpotService_Expecter) Delete(ctx interface{}, id interface{}, principal interface{}) *SpotService_Delete_Call {
+	return &SpotService_Delete_Call{Call: _e.mock.On("Delete", ctx, id, principal)}
 }
 
-func (_c *SpotService_Delete_Call) Run(run func(ctx context.Context, id uint, userID uint, isAdmin bool)) *SpotService_Delete_Call {
+func (_c *SpotService_Delete_Call) Run(run func(ctx context.Context, id uint, principal domain.Principal)) *SpotService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(bool))
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Principal))
 	})
 	return _c
 }
@@ -129,7 +132,55 @@ func (_c *SpotService_Delete_Call) Return(_a0 error) *SpotService_Delete_Call {
 	return _c
 }
 
-func (_c *SpotService_Delete_Call) RunAndReturn(run func(context.Context, uint, uint, bool) error) *SpotService_Delete_Call {
+func (_c *SpotService_Delete_Call) RunAndReturn(run func(context.Context, uint, domain.Principal) error) *SpotService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: ctx, req, w
+func (_m *SpotService) Export(ctx context.Context, req *requests.ExportSpotsRequest, w io.Writer) error {
+	ret := _m.Called(ctx, req, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.ExportSpotsRequest, io.Writer) error); ok {
+		r0 = rf(ctx, req, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SpotService_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type SpotService_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.ExportSpotsRequest
+//   - w io.Writer
+func (_e *SpotService_Expecter) Export(ctx interface{}, req interface{}, w interface{}) *SpotService_Export_Call {
+	return &SpotService_Export_Call{Call: _e.mock.On("Export", ctx, req, w)}
+}
+
+func (_c *SpotService_Export_Call) Run(run func(ctx context.Context, req *requests.ExportSpotsRequest, w io.Writer)) *SpotService_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.ExportSpotsRequest), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *SpotService_Export_Call) Return(_a0 error) *SpotService_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SpotService_Export_Call) RunAndReturn(run func(context.Context, *requests.ExportSpotsRequest, io.Writer) error) *SpotService_Export_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -193,6 +244,65 @@ func (_c *SpotService_GetByID_Call) RunAndReturn(run func(context.Context, uint)
 	return _c
 }
 
+// GetMap provides a mock function with given fields: ctx, req
+func (_m *SpotService) GetMap(ctx context.Context, req *requests.MapSpotsRequest) (*responses.SpotMapResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMap")
+	}
+
+	var r0 *responses.SpotMapResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.MapSpotsRequest) (*responses.SpotMapResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.MapSpotsRequest) *responses.SpotMapResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.SpotMapResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.MapSpotsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotService_GetMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMap'
+type SpotService_GetMap_Call struct {
+	*mock.Call
+}
+
+// GetMap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.MapSpotsRequest
+func (_e *SpotService_Expecter) GetMap(ctx interface{}, req interface{}) *SpotService_GetMap_Call {
+	return &SpotService_GetMap_Call{Call: _e.mock.On("GetMap", ctx, req)}
+}
+
+func (_c *SpotService_GetMap_Call) Run(run func(ctx context.Context, req *requests.MapSpotsRequest)) *SpotService_GetMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.MapSpotsRequest))
+	})
+	return _c
+}
+
+func (_c *SpotService_GetMap_Call) Return(_a0 *responses.SpotMapResponse, _a1 error) *SpotService_GetMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotService_GetMap_Call) RunAndReturn(run func(context.Context, *requests.MapSpotsRequest) (*responses.SpotMapResponse, error)) *SpotService_GetMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRandom provides a mock function with given fields: ctx
 func (_m *SpotService) GetRandom(ctx context.Context) (*responses.SpotResponse, error) {
 	ret := _m.Called(ctx)
@@ -251,6 +361,66 @@ func (_c *SpotService_GetRandom_Call) RunAndReturn(run func(context.Context) (*r
 	return _c
 }
 
+// Import provides a mock function with given fields: ctx, data, userID
+func (_m *SpotService) Import(ctx context.Context, data []byte, userID uint) (*responses.ImportSpotsResponse, error) {
+	ret := _m.Called(ctx, data, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *responses.ImportSpotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint) (*responses.ImportSpotsResponse, error)); ok {
+		return rf(ctx, data, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint) *responses.ImportSpotsResponse); ok {
+		r0 = rf(ctx, data, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.ImportSpotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint) error); ok {
+		r1 = rf(ctx, data, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type SpotService_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data []byte
+//   - userID uint
+func (_e *SpotService_Expecter) Import(ctx interface{}, data interface{}, userID interface{}) *SpotService_Import_Call {
+	return &SpotService_Import_Call{Call: _e.mock.On("Import", ctx, data, userID)}
+}
+
+func (_c *SpotService_Import_Call) Run(run func(ctx context.Context, data []byte, userID uint)) *SpotService_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotService_Import_Call) Return(_a0 *responses.ImportSpotsResponse, _a1 error) *SpotService_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotService_Import_Call) RunAndReturn(run func(context.Context, []byte, uint) (*responses.ImportSpotsResponse, error)) *SpotService_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, req
 func (_m *SpotService) List(ctx context.Context, req *requests.ListSpotsRequest) (*responses.PaginatedSpotsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -310,9 +480,128 @@ func (_c *SpotService_List_Call) RunAndReturn(run func(context.Context, *request
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, id, req, userID, isAdmin
-func (_m *SpotService) Update(ctx context.Context, id uint, req *requests.UpdateSpotRequest, userID uint, isAdmin bool) (*responses.SpotResponse, error) {
-	ret := _m.Called(ctx, id, req, userID, isAdmin)
+// ListNearest provides a mock function with given fields: ctx, req
+func (_m *SpotService) ListNearest(ctx context.Context, req *requests.NearestSpotsRequest) ([]responses.SpotListResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNearest")
+	}
+
+	var r0 []responses.SpotListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.NearestSpotsRequest) ([]responses.SpotListResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.NearestSpotsRequest) []responses.SpotListResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]responses.SpotListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.NearestSpotsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotService_ListNearest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNearest'
+type SpotService_ListNearest_Call struct {
+	*mock.Call
+}
+
+// ListNearest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.NearestSpotsRequest
+func (_e *SpotService_Expecter) ListNearest(ctx interface{}, req interface{}) *SpotService_ListNearest_Call {
+	return &SpotService_ListNearest_Call{Call: _e.mock.On("ListNearest", ctx, req)}
+}
+
+func (_c *SpotService_ListNearest_Call) Run(run func(ctx context.Context, req *requests.NearestSpotsRequest)) *SpotService_ListNearest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.NearestSpotsRequest))
+	})
+	return _c
+}
+
+func (_c *SpotService_ListNearest_Call) Return(_a0 []responses.SpotListResponse, _a1 error) *SpotService_ListNearest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotService_ListNearest_Call) RunAndReturn(run func(context.Context, *requests.NearestSpotsRequest) ([]responses.SpotListResponse, error)) *SpotService_ListNearest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: ctx, req, userID
+func (_m *SpotService) Merge(ctx context.Context, req *requests.MergeSpotsRequest, userID uint) (*responses.SpotResponse, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 *responses.SpotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.MergeSpotsRequest, uint) (*responses.SpotResponse, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *requests.MergeSpotsRequest, uint) *responses.SpotResponse); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.SpotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *requests.MergeSpotsRequest, uint) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpotService_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type SpotService_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *requests.MergeSpotsRequest
+//   - userID uint
+func (_e *SpotService_Expecter) Merge(ctx interface{}, req interface{}, userID interface{}) *SpotService_Merge_Call {
+	return &SpotService_Merge_Call{Call: _e.mock.On("Merge", ctx, req, userID)}
+}
+
+func (_c *SpotService_Merge_Call) Run(run func(ctx context.Context, req *requests.MergeSpotsRequest, userID uint)) *SpotService_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*requests.MergeSpotsRequest), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *SpotService_Merge_Call) Return(_a0 *responses.SpotResponse, _a1 error) *SpotService_Merge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SpotService_Merge_Call) RunAndReturn(run func(context.Context, *requests.MergeSpotsRequest, uint) (*responses.SpotResponse, error)) *SpotService_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req, principal
+func (_m *SpotService) Update(ctx context.Context, id uint, req *requests.UpdateSpotRequest, principal domain.Principal) (*responses.SpotResponse, error) {
+	ret := _m.Called(ctx, id, req, principal)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -320,19 +609,19 @@ func (_m *SpotService) Update(ctx context.Context, id uint, req *requests.Update
 
 	var r0 *responses.SpotResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.UpdateSpotRequest, uint, bool) (*responses.SpotResponse, error)); ok {
-		return rf(ctx, id, req, userID, isAdmin)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.UpdateSpotRequest, domain.Principal) (*responses.SpotResponse, error)); ok {
+		return rf(ctx, id, req, principal)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.UpdateSpotRequest, uint, bool) *responses.SpotResponse); ok {
-		r0 = rf(ctx, id, req, userID, isAdmin)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.UpdateSpotRequest, domain.Principal) *responses.SpotResponse); ok {
+		r0 = rf(ctx, id, req, principal)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*responses.SpotResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.UpdateSpotRequest, uint, bool) error); ok {
-		r1 = rf(ctx, id, req, userID, isAdmin)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.UpdateSpotRequest, domain.Principal) error); ok {
+		r1 = rf(ctx, id, req, principal)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -349,15 +638,14 @@ type SpotService_Update_Call struct {
 //   - ctx context.Context
 //   - id uint
 //   - req *requests.UpdateSpotRequest
-//   - userID uint
-//   - isAdmin bool
-func (_e *SpotService_Expecter) Update(ctx interface{}, id interface{}, req interface{}, userID interface{}, isAdmin interface{}) *SpotService_Update_Call {
-	return &SpotService_Update_Call{Call: _e.mock.On("Update", ctx, id, req, userID, isAdmin)}
+//   - principal domain.Principal
+func (_e *SpotService_Expecter) Update(ctx interface{}, id interface{}, req interface{}, principal interface{}) *SpotService_Update_Call {
+	return &SpotService_Update_Call{Call: _e.mock.On("Update", ctx, id, req, principal)}
 }
 
-func (_c *SpotService_Update_Call) Run(run func(ctx context.Context, id uint, req *requests.UpdateSpotRequest, userID uint, isAdmin bool)) *SpotService_Update_Call {
+func (_c *SpotService_Update_Call) Run(run func(ctx context.Context, id uint, req *requests.UpdateSpotRequest, principal domain.Principal)) *SpotService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.UpdateSpotRequest), args[3].(uint), args[4].(bool))
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.UpdateSpotRequest), args[3].(domain.Principal))
 	})
 	return _c
 }
@@ -367,7 +655,7 @@ func (_c *SpotService_Update_Call) Return(_a0 *responses.SpotResponse, _a1 error
 	return _c
 }
 
-func (_c *SpotService_Update_Call) RunAndReturn(run func(context.Context, uint, *requests.UpdateSpotRequest, uint, bool) (*responses.SpotResponse, error)) *SpotService_Update_Call {
+func (_c *SpotService_Update_Call) RunAndReturn(run func(context.Context, uint, *requests.UpdateSpotRequest, domain.Principal) (*responses.SpotResponse, error)) *SpotService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,140 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TokenVersionService is an autogenerated mock type for the TokenVersionService type
+type TokenVersionService struct {
+	mock.Mock
+}
+
+type TokenVersionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenVersionService) EXPECT() *TokenVersionService_Expecter {
+	return &TokenVersionService_Expecter{mock: &_m.Mock}
+}
+
+// Current provides a mock function with given fields: ctx, userID
+func (_m *TokenVersionService) Current(ctx context.Context, userID uint) (uint, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Current")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (uint, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) uint); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenVersionService_Current_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Current'
+type TokenVersionService_Current_Call struct {
+	*mock.Call
+}
+
+// Current is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *TokenVersionService_Expecter) Current(ctx interface{}, userID interface{}) *TokenVersionService_Current_Call {
+	return &TokenVersionService_Current_Call{Call: _e.mock.On("Current", ctx, userID)}
+}
+
+func (_c *TokenVersionService_Current_Call) Run(run func(ctx context.Context, userID uint)) *TokenVersionService_Current_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *TokenVersionService_Current_Call) Return(_a0 uint, _a1 error) *TokenVersionService_Current_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenVersionService_Current_Call) RunAndReturn(run func(context.Context, uint) (uint, error)) *TokenVersionService_Current_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function with given fields: ctx, userID
+func (_m *TokenVersionService) Invalidate(ctx context.Context, userID uint) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenVersionService_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type TokenVersionService_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *TokenVersionService_Expecter) Invalidate(ctx interface{}, userID interface{}) *TokenVersionService_Invalidate_Call {
+	return &TokenVersionService_Invalidate_Call{Call: _e.mock.On("Invalidate", ctx, userID)}
+}
+
+func (_c *TokenVersionService_Invalidate_Call) Run(run func(ctx context.Context, userID uint)) *TokenVersionService_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *TokenVersionService_Invalidate_Call) Return(_a0 error) *TokenVersionService_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenVersionService_Invalidate_Call) RunAndReturn(run func(context.Context, uint) error) *TokenVersionService_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenVersionService creates a new instance of TokenVersionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenVersionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenVersionService {
+	mock := &TokenVersionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
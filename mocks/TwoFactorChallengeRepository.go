@@ -0,0 +1,248 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TwoFactorChallengeRepository is an autogenerated mock type for the TwoFactorChallengeRepository type
+type TwoFactorChallengeRepository struct {
+	mock.Mock
+}
+
+type TwoFactorChallengeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TwoFactorChallengeRepository) EXPECT() *TwoFactorChallengeRepository_Expecter {
+	return &TwoFactorChallengeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, challenge
+func (_m *TwoFactorChallengeRepository) Create(ctx context.Context, challenge *domain.TwoFactorChallenge) error {
+	ret := _m.Called(ctx, challenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TwoFactorChallenge) error); ok {
+		r0 = rf(ctx, challenge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TwoFactorChallengeRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TwoFactorChallengeRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challenge *domain.TwoFactorChallenge
+func (_e *TwoFactorChallengeRepository_Expecter) Create(ctx interface{}, challenge interface{}) *TwoFactorChallengeRepository_Create_Call {
+	return &TwoFactorChallengeRepository_Create_Call{Call: _e.mock.On("Create", ctx, challenge)}
+}
+
+func (_c *TwoFactorChallengeRepository_Create_Call) Run(run func(ctx context.Context, challenge *domain.TwoFactorChallenge)) *TwoFactorChallengeRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.TwoFactorChallenge))
+	})
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_Create_Call) Return(_a0 error) *TwoFactorChallengeRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.TwoFactorChallenge) error) *TwoFactorChallengeRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *TwoFactorChallengeRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.TwoFactorChallenge, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 *domain.TwoFactorChallenge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.TwoFactorChallenge, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.TwoFactorChallenge); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TwoFactorChallenge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactorChallengeRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type TwoFactorChallengeRepository_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *TwoFactorChallengeRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *TwoFactorChallengeRepository_FindByTokenHash_Call {
+	return &TwoFactorChallengeRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *TwoFactorChallengeRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *TwoFactorChallengeRepository_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_FindByTokenHash_Call) Return(_a0 *domain.TwoFactorChallenge, _a1 error) *TwoFactorChallengeRepository_FindByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_FindByTokenHash_Call) RunAndReturn(run func(context.Context, string) (*domain.TwoFactorChallenge, error)) *TwoFactorChallengeRepository_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementAttempts provides a mock function with given fields: ctx, id
+func (_m *TwoFactorChallengeRepository) IncrementAttempts(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementAttempts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TwoFactorChallengeRepository_IncrementAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementAttempts'
+type TwoFactorChallengeRepository_IncrementAttempts_Call struct {
+	*mock.Call
+}
+
+// IncrementAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *TwoFactorChallengeRepository_Expecter) IncrementAttempts(ctx interface{}, id interface{}) *TwoFactorChallengeRepository_IncrementAttempts_Call {
+	return &TwoFactorChallengeRepository_IncrementAttempts_Call{Call: _e.mock.On("IncrementAttempts", ctx, id)}
+}
+
+func (_c *TwoFactorChallengeRepository_IncrementAttempts_Call) Run(run func(ctx context.Context, id uint)) *TwoFactorChallengeRepository_IncrementAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_IncrementAttempts_Call) Return(_a0 error) *TwoFactorChallengeRepository_IncrementAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_IncrementAttempts_Call) RunAndReturn(run func(context.Context, uint) error) *TwoFactorChallengeRepository_IncrementAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsUsed provides a mock function with given fields: ctx, id
+func (_m *TwoFactorChallengeRepository) MarkAsUsed(ctx context.Context, id uint) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsUsed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactorChallengeRepository_MarkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUsed'
+type TwoFactorChallengeRepository_MarkAsUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAsUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *TwoFactorChallengeRepository_Expecter) MarkAsUsed(ctx interface{}, id interface{}) *TwoFactorChallengeRepository_MarkAsUsed_Call {
+	return &TwoFactorChallengeRepository_MarkAsUsed_Call{Call: _e.mock.On("MarkAsUsed", ctx, id)}
+}
+
+func (_c *TwoFactorChallengeRepository_MarkAsUsed_Call) Run(run func(ctx context.Context, id uint)) *TwoFactorChallengeRepository_MarkAsUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_MarkAsUsed_Call) Return(_a0 bool, _a1 error) *TwoFactorChallengeRepository_MarkAsUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactorChallengeRepository_MarkAsUsed_Call) RunAndReturn(run func(context.Context, uint) (bool, error)) *TwoFactorChallengeRepository_MarkAsUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTwoFactorChallengeRepository creates a new instance of TwoFactorChallengeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTwoFactorChallengeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TwoFactorChallengeRepository {
+	mock := &TwoFactorChallengeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
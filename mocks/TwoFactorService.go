@@ -0,0 +1,387 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "hopSpotAPI/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	requests "hopSpotAPI/internal/dto/requests"
+
+	responses "hopSpotAPI/internal/dto/responses"
+)
+
+// TwoFactorService is an autogenerated mock type for the TwoFactorService type
+type TwoFactorService struct {
+	mock.Mock
+}
+
+type TwoFactorService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TwoFactorService) EXPECT() *TwoFactorService_Expecter {
+	return &TwoFactorService_Expecter{mock: &_m.Mock}
+}
+
+// CreateChallenge provides a mock function with given fields: ctx, userID
+func (_m *TwoFactorService) CreateChallenge(ctx context.Context, userID uint) (string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChallenge")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactorService_CreateChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChallenge'
+type TwoFactorService_CreateChallenge_Call struct {
+	*mock.Call
+}
+
+// CreateChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *TwoFactorService_Expecter) CreateChallenge(ctx interface{}, userID interface{}) *TwoFactorService_CreateChallenge_Call {
+	return &TwoFactorService_CreateChallenge_Call{Call: _e.mock.On("CreateChallenge", ctx, userID)}
+}
+
+func (_c *TwoFactorService_CreateChallenge_Call) Run(run func(ctx context.Context, userID uint)) *TwoFactorService_CreateChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *TwoFactorService_CreateChallenge_Call) Return(_a0 string, _a1 error) *TwoFactorService_CreateChallenge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactorService_CreateChallenge_Call) RunAndReturn(run func(context.Context, uint) (string, error)) *TwoFactorService_CreateChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Disable provides a mock function with given fields: ctx, userID, req
+func (_m *TwoFactorService) Disable(ctx context.Context, userID uint, req *requests.DisableTwoFactorRequest) error {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.DisableTwoFactorRequest) error); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TwoFactorService_Disable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disable'
+type TwoFactorService_Disable_Call struct {
+	*mock.Call
+}
+
+// Disable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.DisableTwoFactorRequest
+func (_e *TwoFactorService_Expecter) Disable(ctx interface{}, userID interface{}, req interface{}) *TwoFactorService_Disable_Call {
+	return &TwoFactorService_Disable_Call{Call: _e.mock.On("Disable", ctx, userID, req)}
+}
+
+func (_c *TwoFactorService_Disable_Call) Run(run func(ctx context.Context, userID uint, req *requests.DisableTwoFactorRequest)) *TwoFactorService_Disable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.DisableTwoFactorRequest))
+	})
+	return _c
+}
+
+func (_c *TwoFactorService_Disable_Call) Return(_a0 error) *TwoFactorService_Disable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TwoFactorService_Disable_Call) RunAndReturn(run func(context.Context, uint, *requests.DisableTwoFactorRequest) error) *TwoFactorService_Disable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enable provides a mock function with given fields: ctx, userID, req
+func (_m *TwoFactorService) Enable(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enable")
+	}
+
+	var r0 *responses.RecoveryCodesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.TwoFactorCodeRequest) *responses.RecoveryCodesResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.RecoveryCodesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.TwoFactorCodeRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactorService_Enable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enable'
+type TwoFactorService_Enable_Call struct {
+	*mock.Call
+}
+
+// Enable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.TwoFactorCodeRequest
+func (_e *TwoFactorService_Expecter) Enable(ctx interface{}, userID interface{}, req interface{}) *TwoFactorService_Enable_Call {
+	return &TwoFactorService_Enable_Call{Call: _e.mock.On("Enable", ctx, userID, req)}
+}
+
+func (_c *TwoFactorService_Enable_Call) Run(run func(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest)) *TwoFactorService_Enable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.TwoFactorCodeRequest))
+	})
+	return _c
+}
+
+func (_c *TwoFactorService_Enable_Call) Return(_a0 *responses.RecoveryCodesResponse, _a1 error) *TwoFactorService_Enable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactorService_Enable_Call) RunAndReturn(run func(context.Context, uint, *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error)) *TwoFactorService_Enable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegenerateRecoveryCodes provides a mock function with given fields: ctx, userID, req
+func (_m *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateRecoveryCodes")
+	}
+
+	var r0 *responses.RecoveryCodesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.TwoFactorCodeRequest) *responses.RecoveryCodesResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.RecoveryCodesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.TwoFactorCodeRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactorService_RegenerateRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateRecoveryCodes'
+type TwoFactorService_RegenerateRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// RegenerateRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.TwoFactorCodeRequest
+func (_e *TwoFactorService_Expecter) RegenerateRecoveryCodes(ctx interface{}, userID interface{}, req interface{}) *TwoFactorService_RegenerateRecoveryCodes_Call {
+	return &TwoFactorService_RegenerateRecoveryCodes_Call{Call: _e.mock.On("RegenerateRecoveryCodes", ctx, userID, req)}
+}
+
+func (_c *TwoFactorService_RegenerateRecoveryCodes_Call) Run(run func(ctx context.Context, userID uint, req *requests.TwoFactorCodeRequest)) *TwoFactorService_RegenerateRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.TwoFactorCodeRequest))
+	})
+	return _c
+}
+
+func (_c *TwoFactorService_RegenerateRecoveryCodes_Call) Return(_a0 *responses.RecoveryCodesResponse, _a1 error) *TwoFactorService_RegenerateRecoveryCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactorService_RegenerateRecoveryCodes_Call) RunAndReturn(run func(context.Context, uint, *requests.TwoFactorCodeRequest) (*responses.RecoveryCodesResponse, error)) *TwoFactorService_RegenerateRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Setup provides a mock function with given fields: ctx, userID, req
+func (_m *TwoFactorService) Setup(ctx context.Context, userID uint, req *requests.TwoFactorSetupRequest) (*responses.TwoFactorSetupResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Setup")
+	}
+
+	var r0 *responses.TwoFactorSetupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.TwoFactorSetupRequest) (*responses.TwoFactorSetupResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.TwoFactorSetupRequest) *responses.TwoFactorSetupResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.TwoFactorSetupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *requests.TwoFactorSetupRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactorService_Setup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Setup'
+type TwoFactorService_Setup_Call struct {
+	*mock.Call
+}
+
+// Setup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.TwoFactorSetupRequest
+func (_e *TwoFactorService_Expecter) Setup(ctx interface{}, userID interface{}, req interface{}) *TwoFactorService_Setup_Call {
+	return &TwoFactorService_Setup_Call{Call: _e.mock.On("Setup", ctx, userID, req)}
+}
+
+func (_c *TwoFactorService_Setup_Call) Run(run func(ctx context.Context, userID uint, req *requests.TwoFactorSetupRequest)) *TwoFactorService_Setup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.TwoFactorSetupRequest))
+	})
+	return _c
+}
+
+func (_c *TwoFactorService_Setup_Call) Return(_a0 *responses.TwoFactorSetupResponse, _a1 error) *TwoFactorService_Setup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactorService_Setup_Call) RunAndReturn(run func(context.Context, uint, *requests.TwoFactorSetupRequest) (*responses.TwoFactorSetupResponse, error)) *TwoFactorService_Setup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyChallenge provides a mock function with given fields: ctx, challengeToken, code
+func (_m *TwoFactorService) VerifyChallenge(ctx context.Context, challengeToken string, code string) (*domain.User, error) {
+	ret := _m.Called(ctx, challengeToken, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyChallenge")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return rf(ctx, challengeToken, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = rf(ctx, challengeToken, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, challengeToken, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TwoFactorService_VerifyChallenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyChallenge'
+type TwoFactorService_VerifyChallenge_Call struct {
+	*mock.Call
+}
+
+// VerifyChallenge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challengeToken string
+//   - code string
+func (_e *TwoFactorService_Expecter) VerifyChallenge(ctx interface{}, challengeToken interface{}, code interface{}) *TwoFactorService_VerifyChallenge_Call {
+	return &TwoFactorService_VerifyChallenge_Call{Call: _e.mock.On("VerifyChallenge", ctx, challengeToken, code)}
+}
+
+func (_c *TwoFactorService_VerifyChallenge_Call) Run(run func(ctx context.Context, challengeToken string, code string)) *TwoFactorService_VerifyChallenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TwoFactorService_VerifyChallenge_Call) Return(_a0 *domain.User, _a1 error) *TwoFactorService_VerifyChallenge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TwoFactorService_VerifyChallenge_Call) RunAndReturn(run func(context.Context, string, string) (*domain.User, error)) *TwoFactorService_VerifyChallenge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTwoFactorService creates a new instance of TwoFactorService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTwoFactorService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TwoFactorService {
+	mock := &TwoFactorService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
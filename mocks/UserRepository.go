@@ -128,6 +128,53 @@ func (_c *UserRepository_Create_Call) RunAndReturn(run func(context.Context, *do
 	return _c
 }
 
+// DeactivateMany provides a mock function with given fields: ctx, ids
+func (_m *UserRepository) DeactivateMany(ctx context.Context, ids []uint) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_DeactivateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateMany'
+type UserRepository_DeactivateMany_Call struct {
+	*mock.Call
+}
+
+// DeactivateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint
+func (_e *UserRepository_Expecter) DeactivateMany(ctx interface{}, ids interface{}) *UserRepository_DeactivateMany_Call {
+	return &UserRepository_DeactivateMany_Call{Call: _e.mock.On("DeactivateMany", ctx, ids)}
+}
+
+func (_c *UserRepository_DeactivateMany_Call) Run(run func(ctx context.Context, ids []uint)) *UserRepository_DeactivateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint))
+	})
+	return _c
+}
+
+func (_c *UserRepository_DeactivateMany_Call) Return(_a0 error) *UserRepository_DeactivateMany_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_DeactivateMany_Call) RunAndReturn(run func(context.Context, []uint) error) *UserRepository_DeactivateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *UserRepository) Delete(ctx context.Context, id uint) error {
 	ret := _m.Called(ctx, id)
@@ -359,21 +406,139 @@ func (_c *UserRepository_FindByID_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
-// GetAllFCMTokens provides a mock function with given fields: ctx, excludeUserID
-func (_m *UserRepository) GetAllFCMTokens(ctx context.Context, excludeUserID uint) ([]string, error) {
-	ret := _m.Called(ctx, excludeUserID)
+// FindTokenVersion provides a mock function with given fields: ctx, id
+func (_m *UserRepository) FindTokenVersion(ctx context.Context, id uint) (*uint, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTokenVersion")
+	}
+
+	var r0 *uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*uint, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *uint); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindTokenVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTokenVersion'
+type UserRepository_FindTokenVersion_Call struct {
+	*mock.Call
+}
+
+// FindTokenVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *UserRepository_Expecter) FindTokenVersion(ctx interface{}, id interface{}) *UserRepository_FindTokenVersion_Call {
+	return &UserRepository_FindTokenVersion_Call{Call: _e.mock.On("FindTokenVersion", ctx, id)}
+}
+
+func (_c *UserRepository_FindTokenVersion_Call) Run(run func(ctx context.Context, id uint)) *UserRepository_FindTokenVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindTokenVersion_Call) Return(_a0 *uint, _a1 error) *UserRepository_FindTokenVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindTokenVersion_Call) RunAndReturn(run func(context.Context, uint) (*uint, error)) *UserRepository_FindTokenVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveFollowerIDs provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) GetActiveFollowerIDs(ctx context.Context, userID uint) ([]uint, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveFollowerIDs")
+	}
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]uint, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []uint); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetActiveFollowerIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveFollowerIDs'
+type UserRepository_GetActiveFollowerIDs_Call struct {
+	*mock.Call
+}
+
+// GetActiveFollowerIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *UserRepository_Expecter) GetActiveFollowerIDs(ctx interface{}, userID interface{}) *UserRepository_GetActiveFollowerIDs_Call {
+	return &UserRepository_GetActiveFollowerIDs_Call{Call: _e.mock.On("GetActiveFollowerIDs", ctx, userID)}
+}
+
+func (_c *UserRepository_GetActiveFollowerIDs_Call) Run(run func(ctx context.Context, userID uint)) *UserRepository_GetActiveFollowerIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetActiveFollowerIDs_Call) Return(_a0 []uint, _a1 error) *UserRepository_GetActiveFollowerIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetActiveFollowerIDs_Call) RunAndReturn(run func(context.Context, uint) ([]uint, error)) *UserRepository_GetActiveFollowerIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowerFCMTokens provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) GetFollowerFCMTokens(ctx context.Context, userID uint) ([]string, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllFCMTokens")
+		panic("no return value specified for GetFollowerFCMTokens")
 	}
 
 	var r0 []string
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]string, error)); ok {
-		return rf(ctx, excludeUserID)
+		return rf(ctx, userID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uint) []string); ok {
-		r0 = rf(ctx, excludeUserID)
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -381,7 +546,7 @@ func (_m *UserRepository) GetAllFCMTokens(ctx context.Context, excludeUserID uin
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
-		r1 = rf(ctx, excludeUserID)
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -389,31 +554,78 @@ func (_m *UserRepository) GetAllFCMTokens(ctx context.Context, excludeUserID uin
 	return r0, r1
 }
 
-// UserRepository_GetAllFCMTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllFCMTokens'
-type UserRepository_GetAllFCMTokens_Call struct {
+// UserRepository_GetFollowerFCMTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowerFCMTokens'
+type UserRepository_GetFollowerFCMTokens_Call struct {
 	*mock.Call
 }
 
-// GetAllFCMTokens is a helper method to define mock.On call
+// GetFollowerFCMTokens is a helper method to define mock.On call
 //   - ctx context.Context
-//   - excludeUserID uint
-func (_e *UserRepository_Expecter) GetAllFCMTokens(ctx interface{}, excludeUserID interface{}) *UserRepository_GetAllFCMTokens_Call {
-	return &UserRepository_GetAllFCMTokens_Call{Call: _e.mock.On("GetAllFCMTokens", ctx, excludeUserID)}
+//   - userID uint
+func (_e *UserRepository_Expecter) GetFollowerFCMTokens(ctx interface{}, userID interface{}) *UserRepository_GetFollowerFCMTokens_Call {
+	return &UserRepository_GetFollowerFCMTokens_Call{Call: _e.mock.On("GetFollowerFCMTokens", ctx, userID)}
 }
 
-func (_c *UserRepository_GetAllFCMTokens_Call) Run(run func(ctx context.Context, excludeUserID uint)) *UserRepository_GetAllFCMTokens_Call {
+func (_c *UserRepository_GetFollowerFCMTokens_Call) Run(run func(ctx context.Context, userID uint)) *UserRepository_GetFollowerFCMTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *UserRepository_GetAllFCMTokens_Call) Return(_a0 []string, _a1 error) *UserRepository_GetAllFCMTokens_Call {
+func (_c *UserRepository_GetFollowerFCMTokens_Call) Return(_a0 []string, _a1 error) *UserRepository_GetFollowerFCMTokens_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UserRepository_GetAllFCMTokens_Call) RunAndReturn(run func(context.Context, uint) ([]string, error)) *UserRepository_GetAllFCMTokens_Call {
+func (_c *UserRepository_GetFollowerFCMTokens_Call) RunAndReturn(run func(context.Context, uint) ([]string, error)) *UserRepository_GetFollowerFCMTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementTokenVersion provides a mock function with given fields: ctx, id
+func (_m *UserRepository) IncrementTokenVersion(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementTokenVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_IncrementTokenVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementTokenVersion'
+type UserRepository_IncrementTokenVersion_Call struct {
+	*mock.Call
+}
+
+// IncrementTokenVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *UserRepository_Expecter) IncrementTokenVersion(ctx interface{}, id interface{}) *UserRepository_IncrementTokenVersion_Call {
+	return &UserRepository_IncrementTokenVersion_Call{Call: _e.mock.On("IncrementTokenVersion", ctx, id)}
+}
+
+func (_c *UserRepository_IncrementTokenVersion_Call) Run(run func(ctx context.Context, id uint)) *UserRepository_IncrementTokenVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *UserRepository_IncrementTokenVersion_Call) Return(_a0 error) *UserRepository_IncrementTokenVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_IncrementTokenVersion_Call) RunAndReturn(run func(context.Context, uint) error) *UserRepository_IncrementTokenVersion_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -513,6 +725,53 @@ func (_c *UserRepository_UpdateFCMToken_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UpdateTwoFactor provides a mock function with given fields: ctx, user
+func (_m *UserRepository) UpdateTwoFactor(ctx context.Context, user *domain.User) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTwoFactor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.User) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_UpdateTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTwoFactor'
+type UserRepository_UpdateTwoFactor_Call struct {
+	*mock.Call
+}
+
+// UpdateTwoFactor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+func (_e *UserRepository_Expecter) UpdateTwoFactor(ctx interface{}, user interface{}) *UserRepository_UpdateTwoFactor_Call {
+	return &UserRepository_UpdateTwoFactor_Call{Call: _e.mock.On("UpdateTwoFactor", ctx, user)}
+}
+
+func (_c *UserRepository_UpdateTwoFactor_Call) Run(run func(ctx context.Context, user *domain.User)) *UserRepository_UpdateTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.User))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateTwoFactor_Call) Return(_a0 error) *UserRepository_UpdateTwoFactor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_UpdateTwoFactor_Call) RunAndReturn(run func(context.Context, *domain.User) error) *UserRepository_UpdateTwoFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
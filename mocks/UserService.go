@@ -5,10 +5,12 @@ package mocks
 import (
 	context "context"
 
-	requests "hopSpotAPI/internal/dto/requests"
+	io "io"
 
 	mock "github.com/stretchr/testify/mock"
 
+	requests "hopSpotAPI/internal/dto/requests"
+
 	responses "hopSpotAPI/internal/dto/responses"
 )
 
@@ -25,17 +27,17 @@ func (_m *UserService) EXPECT() *UserService_Expecter {
 	return &UserService_Expecter{mock: &_m.Mock}
 }
 
-// ChangePassword provides a mock function with given fields: ctx, userID, req
-func (_m *UserService) ChangePassword(ctx context.Context, userID uint, req *requests.ChangePasswordRequest) error {
-	ret := _m.Called(ctx, userID, req)
+// ChangePassword provides a mock function with given fields: ctx, userID, currentSessionID, req
+func (_m *UserService) ChangePassword(ctx context.Context, userID uint, currentSessionID uint, req *requests.ChangePasswordRequest) error {
+	ret := _m.Called(ctx, userID, currentSessionID, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ChangePassword")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.ChangePasswordRequest) error); ok {
-		r0 = rf(ctx, userID, req)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *requests.ChangePasswordRequest) error); ok {
+		r0 = rf(ctx, userID, currentSessionID, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -51,14 +53,15 @@ type UserService_ChangePassword_Call struct {
 // ChangePassword is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uint
+//   - currentSessionID uint
 //   - req *requests.ChangePasswordRequest
-func (_e *UserService_Expecter) ChangePassword(ctx interface{}, userID interface{}, req interface{}) *UserService_ChangePassword_Call {
-	return &UserService_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, userID, req)}
+func (_e *UserService_Expecter) ChangePassword(ctx interface{}, userID interface{}, currentSessionID interface{}, req interface{}) *UserService_ChangePassword_Call {
+	return &UserService_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, userID, currentSessionID, req)}
 }
 
-func (_c *UserService_ChangePassword_Call) Run(run func(ctx context.Context, userID uint, req *requests.ChangePasswordRequest)) *UserService_ChangePassword_Call {
+func (_c *UserService_ChangePassword_Call) Run(run func(ctx context.Context, userID uint, currentSessionID uint, req *requests.ChangePasswordRequest)) *UserService_ChangePassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.ChangePasswordRequest))
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*requests.ChangePasswordRequest))
 	})
 	return _c
 }
@@ -68,7 +71,114 @@ func (_c *UserService_ChangePassword_Call) Return(_a0 error) *UserService_Change
 	return _c
 }
 
-func (_c *UserService_ChangePassword_Call) RunAndReturn(run func(context.Context, uint, *requests.ChangePasswordRequest) error) *UserService_ChangePassword_Call {
+func (_c *UserService_ChangePassword_Call) RunAndReturn(run func(context.Context, uint, uint, *requests.ChangePasswordRequest) error) *UserService_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function with given fields: ctx, userID, req
+func (_m *UserService) DeleteAccount(ctx context.Context, userID uint, req *requests.DeleteAccountRequest) error {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *requests.DeleteAccountRequest) error); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserService_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type UserService_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+//   - req *requests.DeleteAccountRequest
+func (_e *UserService_Expecter) DeleteAccount(ctx interface{}, userID interface{}, req interface{}) *UserService_DeleteAccount_Call {
+	return &UserService_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, userID, req)}
+}
+
+func (_c *UserService_DeleteAccount_Call) Run(run func(ctx context.Context, userID uint, req *requests.DeleteAccountRequest)) *UserService_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*requests.DeleteAccountRequest))
+	})
+	return _c
+}
+
+func (_c *UserService_DeleteAccount_Call) Return(_a0 error) *UserService_DeleteAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserService_DeleteAccount_Call) RunAndReturn(run func(context.Context, uint, *requests.DeleteAccountRequest) error) *UserService_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportData provides a mock function with given fields: ctx, userID
+func (_m *UserService) ExportData(ctx context.Context, userID uint) (*responses.AccountExportResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportData")
+	}
+
+	var r0 *responses.AccountExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*responses.AccountExportResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *responses.AccountExportResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.AccountExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_ExportData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportData'
+type UserService_ExportData_Call struct {
+	*mock.Call
+}
+
+// ExportData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *UserService_Expecter) ExportData(ctx interface{}, userID interface{}) *UserService_ExportData_Call {
+	return &UserService_ExportData_Call{Call: _e.mock.On("ExportData", ctx, userID)}
+}
+
+func (_c *UserService_ExportData_Call) Run(run func(ctx context.Context, userID uint)) *UserService_ExportData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *UserService_ExportData_Call) Return(_a0 *responses.AccountExportResponse, _a1 error) *UserService_ExportData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_ExportData_Call) RunAndReturn(run func(context.Context, uint) (*responses.AccountExportResponse, error)) *UserService_ExportData_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -132,6 +242,65 @@ func (_c *UserService_GetProfile_Call) RunAndReturn(run func(context.Context, ui
 	return _c
 }
 
+// GetPublicProfile provides a mock function with given fields: ctx, userID
+func (_m *UserService) GetPublicProfile(ctx context.Context, userID uint) (*responses.PublicProfileResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicProfile")
+	}
+
+	var r0 *responses.PublicProfileResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*responses.PublicProfileResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *responses.PublicProfileResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.PublicProfileResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetPublicProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicProfile'
+type UserService_GetPublicProfile_Call struct {
+	*mock.Call
+}
+
+// GetPublicProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint
+func (_e *UserService_Expecter) GetPublicProfile(ctx interface{}, userID interface{}) *UserService_GetPublicProfile_Call {
+	return &UserService_GetPublicProfile_Call{Call: _e.mock.On("GetPublicProfile", ctx, userID)}
+}
+
+func (_c *UserService_GetPublicProfile_Call) Run(run func(ctx context.Context, userID uint)) *UserService_GetPublicProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *UserService_GetPublicProfile_Call) Return(_a0 *responses.PublicProfileResponse, _a1 error) *UserService_GetPublicProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetPublicProfile_Call) RunAndReturn(run func(context.Context, uint) (*responses.PublicProfileResponse, error)) *UserService_GetPublicProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProfile provides a mock function with given fields: ctx, userID, req
 func (_m *UserService) UpdateProfile(ctx context.Context, userID uint, req *requests.UpdateProfileRequest) (*responses.UserResponse, error) {
 	ret := _m.Called(ctx, userID, req)
@@ -192,6 +361,54 @@ func (_c *UserService_UpdateProfile_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// WriteExportArchive provides a mock function with given fields: ctx, export, w
+func (_m *UserService) WriteExportArchive(ctx context.Context, export *responses.AccountExportResponse, w io.Writer) error {
+	ret := _m.Called(ctx, export, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteExportArchive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *responses.AccountExportResponse, io.Writer) error); ok {
+		r0 = rf(ctx, export, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserService_WriteExportArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteExportArchive'
+type UserService_WriteExportArchive_Call struct {
+	*mock.Call
+}
+
+// WriteExportArchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - export *responses.AccountExportResponse
+//   - w io.Writer
+func (_e *UserService_Expecter) WriteExportArchive(ctx interface{}, export interface{}, w interface{}) *UserService_WriteExportArchive_Call {
+	return &UserService_WriteExportArchive_Call{Call: _e.mock.On("WriteExportArchive", ctx, export, w)}
+}
+
+func (_c *UserService_WriteExportArchive_Call) Run(run func(ctx context.Context, export *responses.AccountExportResponse, w io.Writer)) *UserService_WriteExportArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*responses.AccountExportResponse), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *UserService_WriteExportArchive_Call) Return(_a0 error) *UserService_WriteExportArchive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserService_WriteExportArchive_Call) RunAndReturn(run func(context.Context, *responses.AccountExportResponse, io.Writer) error) *UserService_WriteExportArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserService(t interface {
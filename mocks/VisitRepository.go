@@ -82,165 +82,173 @@ func (_c *VisitRepository_CountBySpotID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// Create provides a mock function with given fields: ctx, visit
-func (_m *VisitRepository) Create(ctx context.Context, visit *domain.Visit) error {
-	ret := _m.Called(ctx, visit)
+// CountByUserID provides a mock function with given fields: ctx, userID
+func (_m *VisitRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for CountByUserID")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Visit) error); ok {
-		r0 = rf(ctx, visit)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, userID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// VisitRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type VisitRepository_Create_Call struct {
+// VisitRepository_CountByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUserID'
+type VisitRepository_CountByUserID_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// CountByUserID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - visit *domain.Visit
-func (_e *VisitRepository_Expecter) Create(ctx interface{}, visit interface{}) *VisitRepository_Create_Call {
-	return &VisitRepository_Create_Call{Call: _e.mock.On("Create", ctx, visit)}
+//   - userID uint
+func (_e *VisitRepository_Expecter) CountByUserID(ctx interface{}, userID interface{}) *VisitRepository_CountByUserID_Call {
+	return &VisitRepository_CountByUserID_Call{Call: _e.mock.On("CountByUserID", ctx, userID)}
 }
 
-func (_c *VisitRepository_Create_Call) Run(run func(ctx context.Context, visit *domain.Visit)) *VisitRepository_Create_Call {
+func (_c *VisitRepository_CountByUserID_Call) Run(run func(ctx context.Context, userID uint)) *VisitRepository_CountByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Visit))
+		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *VisitRepository_Create_Call) Return(_a0 error) *VisitRepository_Create_Call {
-	_c.Call.Return(_a0)
+func (_c *VisitRepository_CountByUserID_Call) Return(_a0 int64, _a1 error) *VisitRepository_CountByUserID_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *VisitRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Visit) error) *VisitRepository_Create_Call {
+func (_c *VisitRepository_CountByUserID_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *VisitRepository_CountByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *VisitRepository) Delete(ctx context.Context, id uint) error {
-	ret := _m.Called(ctx, id)
+// CountDistinctSpotsByUserID provides a mock function with given fields: ctx, userID
+func (_m *VisitRepository) CountDistinctSpotsByUserID(ctx context.Context, userID uint) (int64, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for CountDistinctSpotsByUserID")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
-		r0 = rf(ctx, id)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
+		r0 = rf(ctx, userID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// VisitRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type VisitRepository_Delete_Call struct {
+// VisitRepository_CountDistinctSpotsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountDistinctSpotsByUserID'
+type VisitRepository_CountDistinctSpotsByUserID_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// CountDistinctSpotsByUserID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uint
-func (_e *VisitRepository_Expecter) Delete(ctx interface{}, id interface{}) *VisitRepository_Delete_Call {
-	return &VisitRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - userID uint
+func (_e *VisitRepository_Expecter) CountDistinctSpotsByUserID(ctx interface{}, userID interface{}) *VisitRepository_CountDistinctSpotsByUserID_Call {
+	return &VisitRepository_CountDistinctSpotsByUserID_Call{Call: _e.mock.On("CountDistinctSpotsByUserID", ctx, userID)}
 }
 
-func (_c *VisitRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *VisitRepository_Delete_Call {
+func (_c *VisitRepository_CountDistinctSpotsByUserID_Call) Run(run func(ctx context.Context, userID uint)) *VisitRepository_CountDistinctSpotsByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *VisitRepository_Delete_Call) Return(_a0 error) *VisitRepository_Delete_Call {
-	_c.Call.Return(_a0)
+func (_c *VisitRepository_CountDistinctSpotsByUserID_Call) Return(_a0 int64, _a1 error) *VisitRepository_CountDistinctSpotsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *VisitRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *VisitRepository_Delete_Call {
+func (_c *VisitRepository_CountDistinctSpotsByUserID_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *VisitRepository_CountDistinctSpotsByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindBySpotIDUnscoped provides a mock function with given fields: ctx, spotID
-func (_m *VisitRepository) FindBySpotIDUnscoped(ctx context.Context, spotID uint) ([]domain.Visit, error) {
-	ret := _m.Called(ctx, spotID)
+// Create provides a mock function with given fields: ctx, visit
+func (_m *VisitRepository) Create(ctx context.Context, visit *domain.Visit) error {
+	ret := _m.Called(ctx, visit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindBySpotIDUnscoped")
-	}
-
-	var r0 []domain.Visit
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.Visit, error)); ok {
-		return rf(ctx, spotID)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.Visit); ok {
-		r0 = rf(ctx, spotID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Visit)
-		}
+		panic("no return value specified for Create")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
-		r1 = rf(ctx, spotID)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Visit) error); ok {
+		r0 = rf(ctx, visit)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// VisitRepository_FindBySpotIDUnscoped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySpotIDUnscoped'
-type VisitRepository_FindBySpotIDUnscoped_Call struct {
+// VisitRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type VisitRepository_Create_Call struct {
 	*mock.Call
 }
 
-// FindBySpotIDUnscoped is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - spotID uint
-func (_e *VisitRepository_Expecter) FindBySpotIDUnscoped(ctx interface{}, spotID interface{}) *VisitRepository_FindBySpotIDUnscoped_Call {
-	return &VisitRepository_FindBySpotIDUnscoped_Call{Call: _e.mock.On("FindBySpotIDUnscoped", ctx, spotID)}
+//   - visit *domain.Visit
+func (_e *VisitRepository_Expecter) Create(ctx interface{}, visit interface{}) *VisitRepository_Create_Call {
+	return &VisitRepository_Create_Call{Call: _e.mock.On("Create", ctx, visit)}
 }
 
-func (_c *VisitRepository_FindBySpotIDUnscoped_Call) Run(run func(ctx context.Context, spotID uint)) *VisitRepository_FindBySpotIDUnscoped_Call {
+func (_c *VisitRepository_Create_Call) Run(run func(ctx context.Context, visit *domain.Visit)) *VisitRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint))
+		run(args[0].(context.Context), args[1].(*domain.Visit))
 	})
 	return _c
 }
 
-func (_c *VisitRepository_FindBySpotIDUnscoped_Call) Return(_a0 []domain.Visit, _a1 error) *VisitRepository_FindBySpotIDUnscoped_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *VisitRepository_Create_Call) Return(_a0 error) *VisitRepository_Create_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *VisitRepository_FindBySpotIDUnscoped_Call) RunAndReturn(run func(context.Context, uint) ([]domain.Visit, error)) *VisitRepository_FindBySpotIDUnscoped_Call {
+func (_c *VisitRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Visit) error) *VisitRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HardDelete provides a mock function with given fields: ctx, id
-func (_m *VisitRepository) HardDelete(ctx context.Context, id uint) error {
+// Delete provides a mock function with given fields: ctx, id
+func (_m *VisitRepository) Delete(ctx context.Context, id uint) error {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HardDelete")
+		panic("no return value specified for Delete")
 	}
 
 	var r0 error
@@ -253,31 +261,31 @@ func (_m *VisitRepository) HardDelete(ctx context.Context, id uint) error {
 	return r0
 }
 
-// VisitRepository_HardDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HardDelete'
-type VisitRepository_HardDelete_Call struct {
+// VisitRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type VisitRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// HardDelete is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uint
-func (_e *VisitRepository_Expecter) HardDelete(ctx interface{}, id interface{}) *VisitRepository_HardDelete_Call {
-	return &VisitRepository_HardDelete_Call{Call: _e.mock.On("HardDelete", ctx, id)}
+func (_e *VisitRepository_Expecter) Delete(ctx interface{}, id interface{}) *VisitRepository_Delete_Call {
+	return &VisitRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
 }
 
-func (_c *VisitRepository_HardDelete_Call) Run(run func(ctx context.Context, id uint)) *VisitRepository_HardDelete_Call {
+func (_c *VisitRepository_Delete_Call) Run(run func(ctx context.Context, id uint)) *VisitRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *VisitRepository_HardDelete_Call) Return(_a0 error) *VisitRepository_HardDelete_Call {
+func (_c *VisitRepository_Delete_Call) Return(_a0 error) *VisitRepository_Delete_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *VisitRepository_HardDelete_Call) RunAndReturn(run func(context.Context, uint) error) *VisitRepository_HardDelete_Call {
+func (_c *VisitRepository_Delete_Call) RunAndReturn(run func(context.Context, uint) error) *VisitRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -341,6 +349,65 @@ func (_c *VisitRepository_FindByID_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindBySpotIDUnscoped provides a mock function with given fields: ctx, spotID
+func (_m *VisitRepository) FindBySpotIDUnscoped(ctx context.Context, spotID uint) ([]domain.Visit, error) {
+	ret := _m.Called(ctx, spotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySpotIDUnscoped")
+	}
+
+	var r0 []domain.Visit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]domain.Visit, error)); ok {
+		return rf(ctx, spotID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []domain.Visit); ok {
+		r0 = rf(ctx, spotID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Visit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, spotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VisitRepository_FindBySpotIDUnscoped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySpotIDUnscoped'
+type VisitRepository_FindBySpotIDUnscoped_Call struct {
+	*mock.Call
+}
+
+// FindBySpotIDUnscoped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spotID uint
+func (_e *VisitRepository_Expecter) FindBySpotIDUnscoped(ctx interface{}, spotID interface{}) *VisitRepository_FindBySpotIDUnscoped_Call {
+	return &VisitRepository_FindBySpotIDUnscoped_Call{Call: _e.mock.On("FindBySpotIDUnscoped", ctx, spotID)}
+}
+
+func (_c *VisitRepository_FindBySpotIDUnscoped_Call) Run(run func(ctx context.Context, spotID uint)) *VisitRepository_FindBySpotIDUnscoped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *VisitRepository_FindBySpotIDUnscoped_Call) Return(_a0 []domain.Visit, _a1 error) *VisitRepository_FindBySpotIDUnscoped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VisitRepository_FindBySpotIDUnscoped_Call) RunAndReturn(run func(context.Context, uint) ([]domain.Visit, error)) *VisitRepository_FindBySpotIDUnscoped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByUserID provides a mock function with given fields: ctx, userID, filter
 func (_m *VisitRepository) FindByUserID(ctx context.Context, userID uint, filter repository.VisitFilter) ([]domain.Visit, int64, error) {
 	ret := _m.Called(ctx, userID, filter)
@@ -408,6 +475,53 @@ func (_c *VisitRepository_FindByUserID_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// HardDelete provides a mock function with given fields: ctx, id
+func (_m *VisitRepository) HardDelete(ctx context.Context, id uint) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HardDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VisitRepository_HardDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HardDelete'
+type VisitRepository_HardDelete_Call struct {
+	*mock.Call
+}
+
+// HardDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *VisitRepository_Expecter) HardDelete(ctx interface{}, id interface{}) *VisitRepository_HardDelete_Call {
+	return &VisitRepository_HardDelete_Call{Call: _e.mock.On("HardDelete", ctx, id)}
+}
+
+func (_c *VisitRepository_HardDelete_Call) Run(run func(ctx context.Context, id uint)) *VisitRepository_HardDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *VisitRepository_HardDelete_Call) Return(_a0 error) *VisitRepository_HardDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VisitRepository_HardDelete_Call) RunAndReturn(run func(context.Context, uint) error) *VisitRepository_HardDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewVisitRepository creates a new instance of VisitRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVisitRepository(t interface {
@@ -5,10 +5,12 @@ package mocks
 import (
 	context "context"
 
-	requests "hopSpotAPI/internal/dto/requests"
+	domain "hopSpotAPI/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
 
+	requests "hopSpotAPI/internal/dto/requests"
+
 	responses "hopSpotAPI/internal/dto/responses"
 )
 
@@ -85,27 +87,75 @@ func (_c *VisitService_Create_Call) RunAndReturn(run func(context.Context, *requ
 	return _c
 }
 
-// GetCountByBenchID provides a mock function with given fields: ctx, benchID
-func (_m *VisitService) GetCountByBenchID(ctx context.Context, benchID uint) (int64, error) {
-	ret := _m.Called(ctx, benchID)
+// Delete provides a mock function with given fields: ctx, visitID, principal
+func (_m *VisitService) Delete(ctx context.Context, visitID uint, principal domain.Principal) error {
+	ret := _m.Called(ctx, visitID, principal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, domain.Principal) error); ok {
+		r0 = rf(ctx, visitID, principal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VisitService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type VisitService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - visitID uint
+//   - principal domain.Principal
+func (_e *VisitService_Expecter) Delete(ctx interface{}, visitID interface{}, principal interface{}) *VisitService_Delete_Call {
+	return &VisitService_Delete_Call{Call: _e.mock.On("Delete", ctx, visitID, principal)}
+}
+
+func (_c *VisitService_Delete_Call) Run(run func(ctx context.Context, visitID uint, principal domain.Principal)) *VisitService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(domain.Principal))
+	})
+	return _c
+}
+
+func (_c *VisitService_Delete_Call) Return(_a0 error) *VisitService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VisitService_Delete_Call) RunAndReturn(run func(context.Context, uint, domain.Principal) error) *VisitService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCountBySpotID provides a mock function with given fields: ctx, spotID
+func (_m *VisitService) GetCountBySpotID(ctx context.Context, spotID uint) (int64, error) {
+	ret := _m.Called(ctx, spotID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCountByBenchID")
+		panic("no return value specified for GetCountBySpotID")
 	}
 
 	var r0 int64
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uint) (int64, error)); ok {
-		return rf(ctx, benchID)
+		return rf(ctx, spotID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uint) int64); ok {
-		r0 = rf(ctx, benchID)
+		r0 = rf(ctx, spotID)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
-		r1 = rf(ctx, benchID)
+		r1 = rf(ctx, spotID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -113,31 +163,31 @@ func (_m *VisitService) GetCountByBenchID(ctx context.Context, benchID uint) (in
 	return r0, r1
 }
 
-// VisitService_GetCountByBenchID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCountByBenchID'
-type VisitService_GetCountByBenchID_Call struct {
+// VisitService_GetCountBySpotID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCountBySpotID'
+type VisitService_GetCountBySpotID_Call struct {
 	*mock.Call
 }
 
-// GetCountByBenchID is a helper method to define mock.On call
+// GetCountBySpotID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - benchID uint
-func (_e *VisitService_Expecter) GetCountByBenchID(ctx interface{}, benchID interface{}) *VisitService_GetCountByBenchID_Call {
-	return &VisitService_GetCountByBenchID_Call{Call: _e.mock.On("GetCountByBenchID", ctx, benchID)}
+//   - spotID uint
+func (_e *VisitService_Expecter) GetCountBySpotID(ctx interface{}, spotID interface{}) *VisitService_GetCountBySpotID_Call {
+	return &VisitService_GetCountBySpotID_Call{Call: _e.mock.On("GetCountBySpotID", ctx, spotID)}
 }
 
-func (_c *VisitService_GetCountByBenchID_Call) Run(run func(ctx context.Context, benchID uint)) *VisitService_GetCountByBenchID_Call {
+func (_c *VisitService_GetCountBySpotID_Call) Run(run func(ctx context.Context, spotID uint)) *VisitService_GetCountBySpotID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *VisitService_GetCountByBenchID_Call) Return(_a0 int64, _a1 error) *VisitService_GetCountByBenchID_Call {
+func (_c *VisitService_GetCountBySpotID_Call) Return(_a0 int64, _a1 error) *VisitService_GetCountBySpotID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *VisitService_GetCountByBenchID_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *VisitService_GetCountByBenchID_Call {
+func (_c *VisitService_GetCountBySpotID_Call) RunAndReturn(run func(context.Context, uint) (int64, error)) *VisitService_GetCountBySpotID_Call {
 	_c.Call.Return(run)
 	return _c
 }
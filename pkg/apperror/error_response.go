@@ -15,10 +15,31 @@ const (
 	ErrCodeInvalidCredentials  ErrorCode = "AUTH_INVALID_CREDENTIALS"
 	ErrCodeInvalidToken        ErrorCode = "AUTH_INVALID_TOKEN"
 	ErrCodeTokenExpired        ErrorCode = "AUTH_TOKEN_EXPIRED"
+	ErrCodeTokenRevoked        ErrorCode = "AUTH_TOKEN_REVOKED"
 	ErrCodeInvalidRefreshToken ErrorCode = "AUTH_INVALID_REFRESH_TOKEN"
 	ErrCodeAccountDeactivated  ErrorCode = "AUTH_ACCOUNT_DEACTIVATED"
+	ErrCodeAccountLocked       ErrorCode = "AUTH_ACCOUNT_LOCKED"
 	ErrCodeForbidden           ErrorCode = "AUTH_FORBIDDEN"
-	ErrCodeAdminRequired       ErrorCode = "AUTH_ADMIN_REQUIRED"
+	ErrCodePermissionDenied    ErrorCode = "AUTH_PERMISSION_DENIED"
+	ErrCodeSessionNotFound     ErrorCode = "AUTH_SESSION_NOT_FOUND"
+	ErrCodeInvalidResetToken   ErrorCode = "AUTH_INVALID_RESET_TOKEN"
+	ErrCodeInvalidTwoFactorCode      ErrorCode = "AUTH_INVALID_2FA_CODE"
+	ErrCodeInvalidTwoFactorChallenge ErrorCode = "AUTH_INVALID_2FA_CHALLENGE"
+	ErrCodeTwoFactorAlreadyEnabled   ErrorCode = "AUTH_2FA_ALREADY_ENABLED"
+	ErrCodeTwoFactorNotEnabled       ErrorCode = "AUTH_2FA_NOT_ENABLED"
+	ErrCodeTwoFactorRequired         ErrorCode = "AUTH_2FA_REQUIRED"
+	ErrCodeOIDCProviderNotFound          ErrorCode = "AUTH_OIDC_PROVIDER_NOT_FOUND"
+	ErrCodeOIDCLoginFailed               ErrorCode = "AUTH_OIDC_LOGIN_FAILED"
+	ErrCodeInvalidOIDCState              ErrorCode = "AUTH_OIDC_INVALID_STATE"
+	ErrCodeOIDCEmailMissing              ErrorCode = "AUTH_OIDC_EMAIL_MISSING"
+	ErrCodeExternalIdentityInUse         ErrorCode = "AUTH_OIDC_IDENTITY_IN_USE"
+	ErrCodeExternalIdentityAlreadyLinked ErrorCode = "AUTH_OIDC_ALREADY_LINKED"
+	ErrCodeExternalIdentityNotFound      ErrorCode = "AUTH_OIDC_IDENTITY_NOT_FOUND"
+	ErrCodeLastLoginMethod               ErrorCode = "AUTH_OIDC_LAST_LOGIN_METHOD"
+	ErrCodeInvalidScope                  ErrorCode = "AUTH_INVALID_SCOPE"
+	ErrCodeInsufficientScope             ErrorCode = "AUTH_INSUFFICIENT_SCOPE"
+	ErrCodeSessionRequired               ErrorCode = "AUTH_SESSION_REQUIRED"
+	ErrCodePersonalAccessTokenNotFound   ErrorCode = "AUTH_TOKEN_NOT_FOUND"
 )
 
 // Error codes - User
@@ -26,6 +47,10 @@ const (
 	ErrCodeUserNotFound       ErrorCode = "USER_NOT_FOUND"
 	ErrCodeEmailExists        ErrorCode = "USER_EMAIL_EXISTS"
 	ErrCodeCannotDeleteSelf   ErrorCode = "USER_CANNOT_DELETE_SELF"
+	ErrCodeCannotDeactivateSelf ErrorCode = "USER_CANNOT_DEACTIVATE_SELF"
+	ErrCodeInvalidEmailToken    ErrorCode = "USER_INVALID_EMAIL_TOKEN"
+	ErrCodeEmailAlreadyVerified ErrorCode = "USER_EMAIL_ALREADY_VERIFIED"
+	ErrCodeEmailUnchanged       ErrorCode = "USER_EMAIL_UNCHANGED"
 )
 
 // Error codes - Invitation
@@ -34,12 +59,17 @@ const (
 	ErrCodeInvitationAlreadyRedeemed   ErrorCode = "INVITATION_ALREADY_REDEEMED"
 	ErrCodeInvitationNotFound          ErrorCode = "INVITATION_NOT_FOUND"
 	ErrCodeInvitationCannotDeleteRedeemed ErrorCode = "INVITATION_CANNOT_DELETE_REDEEMED"
+	ErrCodeInvitationRequired             ErrorCode = "INVITATION_REQUIRED"
+	ErrCodeInvitationExpired              ErrorCode = "INVITATION_EXPIRED"
+	ErrCodeInvitationQuotaExceeded        ErrorCode = "INVITATION_QUOTA_EXCEEDED"
 )
 
 // Error codes - Spot
 const (
 	ErrCodeSpotNotFound  ErrorCode = "SPOT_NOT_FOUND"
 	ErrCodeSpotForbidden ErrorCode = "SPOT_FORBIDDEN"
+	ErrCodeSpotDuplicate ErrorCode = "SPOT_DUPLICATE"
+	ErrCodeSpotMergeSelf ErrorCode = "SPOT_MERGE_SELF"
 )
 
 // Error codes - Photo
@@ -63,6 +93,35 @@ const (
 	ErrCodeFavoriteAlreadyExists ErrorCode = "FAVORITE_ALREADY_EXISTS"
 )
 
+// Error codes - Review
+const (
+	ErrCodeReviewNotFound      ErrorCode = "REVIEW_NOT_FOUND"
+	ErrCodeReviewAlreadyExists ErrorCode = "REVIEW_ALREADY_EXISTS"
+)
+
+// Error codes - Amenity
+const (
+	ErrCodeAmenityNotFound   ErrorCode = "AMENITY_NOT_FOUND"
+	ErrCodeAmenityKeyExists  ErrorCode = "AMENITY_KEY_EXISTS"
+	ErrCodeAmenityInvalidKey ErrorCode = "AMENITY_INVALID_KEY"
+	ErrCodeAmenityUnknown    ErrorCode = "AMENITY_UNKNOWN"
+	ErrCodeAmenityProtected  ErrorCode = "AMENITY_PROTECTED"
+)
+
+// Error codes - Follow
+const (
+	ErrCodeCannotFollowSelf      ErrorCode = "FOLLOW_CANNOT_FOLLOW_SELF"
+	ErrCodeFollowAlreadyExists   ErrorCode = "FOLLOW_ALREADY_EXISTS"
+	ErrCodeFollowNotFound        ErrorCode = "FOLLOW_NOT_FOUND"
+	ErrCodeFollowRequestNotFound ErrorCode = "FOLLOW_REQUEST_NOT_FOUND"
+	ErrCodeFollowBlocked         ErrorCode = "FOLLOW_BLOCKED"
+)
+
+// Error codes - Notification
+const (
+	ErrCodeNotificationNotFound ErrorCode = "NOTIFICATION_NOT_FOUND"
+)
+
 // Error codes - Validation
 const (
 	ErrCodeValidationInvalidRequest ErrorCode = "VALIDATION_INVALID_REQUEST"
@@ -70,6 +129,9 @@ const (
 	ErrCodeValidationInvalidEmail   ErrorCode = "VALIDATION_INVALID_EMAIL"
 	ErrCodeValidationPasswordShort  ErrorCode = "VALIDATION_PASSWORD_TOO_SHORT"
 	ErrCodeValidationFieldRequired  ErrorCode = "VALIDATION_FIELD_REQUIRED"
+	ErrCodeValidationInvalidCursor  ErrorCode = "VALIDATION_INVALID_CURSOR"
+	ErrCodeValidationInvalidBBox    ErrorCode = "VALIDATION_INVALID_BBOX"
+	ErrCodeValidationInvalidImport  ErrorCode = "VALIDATION_INVALID_IMPORT_FILE"
 )
 
 // Error codes - System
@@ -84,6 +146,7 @@ type ErrorResponse struct {
 	ErrorCode ErrorCode `json:"error_code"`
 	Message   string    `json:"message"`
 	Details   string    `json:"details,omitempty"`
+	Data      any       `json:"data,omitempty"` // Structured context, e.g. the conflicting records
 }
 
 // AppError is a structured error with HTTP status and error code
@@ -141,10 +204,31 @@ var (
 	AppErrInvalidCredentials  = NewAppError(ErrCodeInvalidCredentials, "Invalid email or password", http.StatusUnauthorized)
 	AppErrInvalidToken        = NewAppError(ErrCodeInvalidToken, "Invalid token", http.StatusUnauthorized)
 	AppErrTokenExpired        = NewAppError(ErrCodeTokenExpired, "Token has expired", http.StatusUnauthorized)
+	AppErrTokenRevoked        = NewAppError(ErrCodeTokenRevoked, "Token has been revoked, please refresh", http.StatusUnauthorized)
 	AppErrInvalidRefreshToken = NewAppError(ErrCodeInvalidRefreshToken, "Invalid or expired refresh token", http.StatusUnauthorized)
 	AppErrAccountDeactivated  = NewAppError(ErrCodeAccountDeactivated, "Account is deactivated", http.StatusForbidden)
+	AppErrAccountLocked       = NewAppError(ErrCodeAccountLocked, "Too many failed logins, account is temporarily locked", http.StatusTooManyRequests)
 	AppErrForbidden           = NewAppError(ErrCodeForbidden, "Access forbidden", http.StatusForbidden)
-	AppErrAdminRequired       = NewAppError(ErrCodeAdminRequired, "Admin access required", http.StatusForbidden)
+	AppErrPermissionDenied    = NewAppError(ErrCodePermissionDenied, "Missing permission", http.StatusForbidden)
+	AppErrSessionNotFound     = NewAppError(ErrCodeSessionNotFound, "Session not found", http.StatusNotFound)
+	AppErrInvalidResetToken   = NewAppError(ErrCodeInvalidResetToken, "Invalid or expired password reset token", http.StatusBadRequest)
+	AppErrInvalidTwoFactorCode      = NewAppError(ErrCodeInvalidTwoFactorCode, "Invalid two-factor code", http.StatusUnauthorized)
+	AppErrInvalidTwoFactorChallenge = NewAppError(ErrCodeInvalidTwoFactorChallenge, "Invalid or expired login challenge, please log in again", http.StatusUnauthorized)
+	AppErrTwoFactorAlreadyEnabled   = NewAppError(ErrCodeTwoFactorAlreadyEnabled, "Two-factor authentication is already enabled", http.StatusConflict)
+	AppErrTwoFactorNotEnabled       = NewAppError(ErrCodeTwoFactorNotEnabled, "Two-factor authentication is not enabled", http.StatusBadRequest)
+	AppErrTwoFactorRequired         = NewAppError(ErrCodeTwoFactorRequired, "Two-factor authentication must be enabled for this account", http.StatusForbidden)
+	AppErrOIDCProviderNotFound          = NewAppError(ErrCodeOIDCProviderNotFound, "Unknown login provider", http.StatusNotFound)
+	AppErrOIDCLoginFailed               = NewAppError(ErrCodeOIDCLoginFailed, "Login with the external provider failed", http.StatusUnauthorized)
+	AppErrInvalidOIDCState              = NewAppError(ErrCodeInvalidOIDCState, "Invalid or expired login request, please try again", http.StatusBadRequest)
+	AppErrOIDCEmailMissing              = NewAppError(ErrCodeOIDCEmailMissing, "The login provider did not share an email address", http.StatusBadRequest)
+	AppErrExternalIdentityInUse         = NewAppError(ErrCodeExternalIdentityInUse, "This external account is linked to another user", http.StatusConflict)
+	AppErrExternalIdentityAlreadyLinked = NewAppError(ErrCodeExternalIdentityAlreadyLinked, "An account of this provider is already linked", http.StatusConflict)
+	AppErrExternalIdentityNotFound      = NewAppError(ErrCodeExternalIdentityNotFound, "No account of this provider is linked", http.StatusNotFound)
+	AppErrLastLoginMethod               = NewAppError(ErrCodeLastLoginMethod, "Set a password before unlinking the last external account", http.StatusBadRequest)
+	AppErrInvalidScope                  = NewAppError(ErrCodeInvalidScope, "Unknown or not permitted scope", http.StatusBadRequest)
+	AppErrInsufficientScope             = NewAppError(ErrCodeInsufficientScope, "The access token lacks the scope for this request", http.StatusForbidden)
+	AppErrSessionRequired               = NewAppError(ErrCodeSessionRequired, "This request needs a login session, access tokens are not accepted", http.StatusForbidden)
+	AppErrPersonalAccessTokenNotFound   = NewAppError(ErrCodePersonalAccessTokenNotFound, "Access token not found", http.StatusNotFound)
 )
 
 // Predefined AppErrors - User
@@ -152,6 +236,10 @@ var (
 	AppErrUserNotFound     = NewAppError(ErrCodeUserNotFound, "User not found", http.StatusNotFound)
 	AppErrEmailExists      = NewAppError(ErrCodeEmailExists, "Email already exists", http.StatusConflict)
 	AppErrCannotDeleteSelf = NewAppError(ErrCodeCannotDeleteSelf, "Admin cannot delete themselves", http.StatusBadRequest)
+	AppErrCannotDeactivateSelf = NewAppError(ErrCodeCannotDeactivateSelf, "Admin cannot deactivate themselves", http.StatusBadRequest)
+	AppErrInvalidEmailToken    = NewAppError(ErrCodeInvalidEmailToken, "Invalid or expired confirmation link", http.StatusBadRequest)
+	AppErrEmailAlreadyVerified = NewAppError(ErrCodeEmailAlreadyVerified, "Email already verified", http.StatusConflict)
+	AppErrEmailUnchanged       = NewAppError(ErrCodeEmailUnchanged, "New email equals the current email", http.StatusBadRequest)
 )
 
 // Predefined AppErrors - Invitation
@@ -160,12 +248,17 @@ var (
 	AppErrInvitationAlreadyRedeemed   = NewAppError(ErrCodeInvitationAlreadyRedeemed, "Invitation code already redeemed", http.StatusBadRequest)
 	AppErrInvitationNotFound          = NewAppError(ErrCodeInvitationNotFound, "Invitation code not found", http.StatusNotFound)
 	AppErrInvitationCannotDeleteRedeemed = NewAppError(ErrCodeInvitationCannotDeleteRedeemed, "Cannot delete redeemed invitation code", http.StatusBadRequest)
+	AppErrInvitationRequired             = NewAppError(ErrCodeInvitationRequired, "An invitation code is required to create an account", http.StatusForbidden)
+	AppErrInvitationExpired              = NewAppError(ErrCodeInvitationExpired, "Invitation code expired", http.StatusBadRequest)
+	AppErrInvitationQuotaExceeded        = NewAppError(ErrCodeInvitationQuotaExceeded, "No invitations left", http.StatusForbidden)
 )
 
 // Predefined AppErrors - Spot
 var (
 	AppErrSpotNotFound  = NewAppError(ErrCodeSpotNotFound, "Spot not found", http.StatusNotFound)
 	AppErrSpotForbidden = NewAppError(ErrCodeSpotForbidden, "No permission for this spot", http.StatusForbidden)
+	AppErrSpotDuplicate = NewAppError(ErrCodeSpotDuplicate, "Spots already exist nearby, send force=true to create anyway", http.StatusConflict)
+	AppErrSpotMergeSelf = NewAppError(ErrCodeSpotMergeSelf, "Cannot merge a spot into itself", http.StatusBadRequest)
 )
 
 // Predefined AppErrors - Photo
@@ -189,6 +282,35 @@ var (
 	AppErrFavoriteAlreadyExists = NewAppError(ErrCodeFavoriteAlreadyExists, "Already in favorites", http.StatusConflict)
 )
 
+// Predefined AppErrors - Review
+var (
+	AppErrReviewNotFound      = NewAppError(ErrCodeReviewNotFound, "Review not found", http.StatusNotFound)
+	AppErrReviewAlreadyExists = NewAppError(ErrCodeReviewAlreadyExists, "You have already reviewed this spot", http.StatusConflict)
+)
+
+// Predefined AppErrors - Amenity
+var (
+	AppErrAmenityNotFound   = NewAppError(ErrCodeAmenityNotFound, "Amenity not found", http.StatusNotFound)
+	AppErrAmenityKeyExists  = NewAppError(ErrCodeAmenityKeyExists, "Amenity key already exists", http.StatusConflict)
+	AppErrAmenityInvalidKey = NewAppError(ErrCodeAmenityInvalidKey, "Amenity key may only contain lowercase letters, digits and underscores", http.StatusBadRequest)
+	AppErrAmenityUnknown    = NewAppError(ErrCodeAmenityUnknown, "Unknown amenity key", http.StatusBadRequest)
+	AppErrAmenityProtected  = NewAppError(ErrCodeAmenityProtected, "Amenity is required by older app versions and cannot be deleted", http.StatusBadRequest)
+)
+
+// Predefined AppErrors - Follow
+var (
+	AppErrCannotFollowSelf      = NewAppError(ErrCodeCannotFollowSelf, "You cannot follow yourself", http.StatusBadRequest)
+	AppErrFollowAlreadyExists   = NewAppError(ErrCodeFollowAlreadyExists, "Already friends or request pending", http.StatusConflict)
+	AppErrFollowNotFound        = NewAppError(ErrCodeFollowNotFound, "Not following this user", http.StatusNotFound)
+	AppErrFollowRequestNotFound = NewAppError(ErrCodeFollowRequestNotFound, "Friend request not found", http.StatusNotFound)
+	AppErrFollowBlocked         = NewAppError(ErrCodeFollowBlocked, "You cannot follow this user", http.StatusForbidden)
+)
+
+// Predefined AppErrors - Notification
+var (
+	AppErrNotificationNotFound = NewAppError(ErrCodeNotificationNotFound, "Notification not found", http.StatusNotFound)
+)
+
 // Predefined AppErrors - Validation
 var (
 	AppErrValidationInvalidRequest = NewAppError(ErrCodeValidationInvalidRequest, "Invalid request", http.StatusBadRequest)
@@ -196,6 +318,9 @@ var (
 	AppErrValidationInvalidEmail   = NewAppError(ErrCodeValidationInvalidEmail, "Invalid email address", http.StatusBadRequest)
 	AppErrValidationPasswordShort  = NewAppError(ErrCodeValidationPasswordShort, "Password must be at least 8 characters", http.StatusBadRequest)
 	AppErrValidationFieldRequired  = NewAppError(ErrCodeValidationFieldRequired, "Required field missing", http.StatusBadRequest)
+	AppErrValidationInvalidCursor  = NewAppError(ErrCodeValidationInvalidCursor, "Invalid or expired cursor", http.StatusBadRequest)
+	AppErrValidationInvalidBBox    = NewAppError(ErrCodeValidationInvalidBBox, "Invalid bounding box, expected minLon,minLat,maxLon,maxLat", http.StatusBadRequest)
+	AppErrValidationInvalidImport  = NewAppError(ErrCodeValidationInvalidImport, "File is not valid GeoJSON or GPX", http.StatusBadRequest)
 )
 
 // Predefined AppErrors - System
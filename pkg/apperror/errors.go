@@ -1,6 +1,11 @@
 package apperror
 
-import "errors"
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+)
 
 // Legacy sentinel errors - kept for backward compatibility during migration
 // These are used in service layer and mapped to AppError in handlers
@@ -12,8 +17,15 @@ var (
 	ErrInvalidInvitationCode         = errors.New("invalid invitation code")
 	ErrInvitationCodeAlreadyRedeemed = errors.New("invitation code already redeemed")
 	ErrCannotDeleteSelf              = errors.New("admin cannot delete themselves")
+	ErrCannotDeactivateSelf          = errors.New("admin cannot deactivate themselves")
 	ErrInvitationCodeNotFound        = errors.New("invitation code not found")
 	ErrCannotDeleteRedeemedCode      = errors.New("cannot delete redeemed invitation code")
+	ErrInvitationCodeRequired        = errors.New("invitation code required")
+	ErrInvitationCodeExpired         = errors.New("invitation code expired")
+	ErrInvitationQuotaExceeded       = errors.New("invitation quota exceeded")
+	ErrInvalidEmailToken             = errors.New("invalid or expired email token")
+	ErrEmailAlreadyVerified          = errors.New("email already verified")
+	ErrEmailUnchanged                = errors.New("new email equals current email")
 )
 
 // Authentication-related errors
@@ -21,18 +33,55 @@ var (
 	ErrInvalidToken       = errors.New("invalid token")
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrAccountDeactivated = errors.New("account is deactivated")
+	ErrAccountLocked      = errors.New("account temporarily locked")
 	ErrForbidden          = errors.New("forbidden")
 )
 
+// Two-Factor Errors
+var (
+	ErrInvalidTwoFactorCode      = errors.New("invalid two-factor code")
+	ErrInvalidTwoFactorChallenge = errors.New("invalid or expired two-factor challenge")
+	ErrTwoFactorAlreadyEnabled   = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnabled       = errors.New("two-factor authentication not enabled")
+	ErrTwoFactorRequired         = errors.New("two-factor authentication required")
+)
+
+// OpenID Connect Errors
+var (
+	ErrOIDCProviderNotFound          = errors.New("unknown OIDC provider")
+	ErrOIDCLoginFailed               = errors.New("OIDC login failed")
+	ErrInvalidOIDCState              = errors.New("invalid or expired OIDC state")
+	ErrOIDCEmailMissing              = errors.New("OIDC provider returned no email")
+	ErrExternalIdentityInUse         = errors.New("external identity linked to another user")
+	ErrExternalIdentityAlreadyLinked = errors.New("provider already linked")
+	ErrExternalIdentityNotFound      = errors.New("external identity not found")
+	ErrLastLoginMethod               = errors.New("cannot remove last login method")
+)
+
+// Personal Access Token Errors
+var (
+	ErrInvalidScope                = errors.New("invalid scope")
+	ErrInsufficientScope           = errors.New("token lacks the required scope")
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+)
+
 // Refresh Token Errors
 var (
 	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrSessionNotFound     = errors.New("session not found")
+)
+
+// Password Reset Errors
+var (
+	ErrInvalidResetToken = errors.New("invalid or expired password reset token")
 )
 
 // Spot-related errors
 var (
 	ErrSpotNotFound  = errors.New("spot not found")
 	ErrSpotForbidden = errors.New("no permission for this spot")
+	ErrSpotDuplicate = errors.New("spot already exists nearby")
+	ErrSpotMergeSelf = errors.New("cannot merge a spot into itself")
 )
 
 // Photo Errors
@@ -56,6 +105,80 @@ var (
 	ErrFavoriteAlreadyExists = errors.New("already in favorites")
 )
 
+// Review Errors
+var (
+	ErrReviewNotFound      = errors.New("review not found")
+	ErrReviewAlreadyExists = errors.New("spot already reviewed by user")
+)
+
+// Amenity Errors
+var (
+	ErrAmenityNotFound   = errors.New("amenity not found")
+	ErrAmenityKeyExists  = errors.New("amenity key already exists")
+	ErrAmenityInvalidKey = errors.New("invalid amenity key")
+	ErrAmenityUnknown    = errors.New("unknown amenity key")
+	ErrAmenityProtected  = errors.New("amenity is required by older app versions")
+)
+
+// Follow Errors
+var (
+	ErrCannotFollowSelf      = errors.New("cannot follow yourself")
+	ErrFollowAlreadyExists   = errors.New("already following or request pending")
+	ErrFollowNotFound        = errors.New("follow not found")
+	ErrFollowRequestNotFound = errors.New("follow request not found")
+	ErrFollowBlocked         = errors.New("follow blocked")
+)
+
+// Notification Errors
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+// Validation Errors
+var (
+	ErrInvalidCursor      = errors.New("invalid pagination cursor")
+	ErrInvalidBoundingBox = errors.New("invalid bounding box")
+	ErrInvalidImportFile  = errors.New("invalid import file")
+)
+
+// DataError attaches structured data to a sentinel error.
+// The data is returned in the "data" field of the error response.
+type DataError struct {
+	Err  error
+	Data any
+}
+
+// Error implements the error interface
+func (e *DataError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the sentinel error
+func (e *DataError) Unwrap() error {
+	return e.Err
+}
+
+// WithData wraps a sentinel error together with data for the client
+func WithData(err error, data any) error {
+	return &DataError{Err: err, Data: data}
+}
+
+// RetryAfter is the data of errors that go away after some time, like a locked account.
+// RespondWithMappedError also sets the Retry-After header for it.
+type RetryAfter struct {
+	RetryAfterSeconds int       `json:"retry_after_seconds"`
+	RetryAt           time.Time `json:"retry_at"`
+}
+
+// WithRetryAfter wraps a sentinel error together with the time the request can be retried
+func WithRetryAfter(err error, retryAt time.Time) error {
+	seconds := int(math.Ceil(time.Until(retryAt).Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return WithData(err, RetryAfter{RetryAfterSeconds: seconds, RetryAt: retryAt})
+}
+
 // MapToAppError converts a legacy sentinel error to an AppError
 // This function is used to bridge the transition from simple errors to structured errors
 func MapToAppError(err error) *AppError {
@@ -71,10 +194,48 @@ func MapToAppError(err error) *AppError {
 		return AppErrInvalidToken
 	case errors.Is(err, ErrInvalidRefreshToken):
 		return AppErrInvalidRefreshToken
+	case errors.Is(err, ErrSessionNotFound):
+		return AppErrSessionNotFound
+	case errors.Is(err, ErrInvalidResetToken):
+		return AppErrInvalidResetToken
 	case errors.Is(err, ErrAccountDeactivated):
 		return AppErrAccountDeactivated
+	case errors.Is(err, ErrAccountLocked):
+		return AppErrAccountLocked
+	case errors.Is(err, ErrInvalidScope):
+		return AppErrInvalidScope
+	case errors.Is(err, ErrInsufficientScope):
+		return AppErrInsufficientScope
+	case errors.Is(err, ErrPersonalAccessTokenNotFound):
+		return AppErrPersonalAccessTokenNotFound
 	case errors.Is(err, ErrForbidden):
 		return AppErrForbidden
+	case errors.Is(err, ErrInvalidTwoFactorCode):
+		return AppErrInvalidTwoFactorCode
+	case errors.Is(err, ErrInvalidTwoFactorChallenge):
+		return AppErrInvalidTwoFactorChallenge
+	case errors.Is(err, ErrTwoFactorAlreadyEnabled):
+		return AppErrTwoFactorAlreadyEnabled
+	case errors.Is(err, ErrTwoFactorNotEnabled):
+		return AppErrTwoFactorNotEnabled
+	case errors.Is(err, ErrTwoFactorRequired):
+		return AppErrTwoFactorRequired
+	case errors.Is(err, ErrOIDCProviderNotFound):
+		return AppErrOIDCProviderNotFound
+	case errors.Is(err, ErrOIDCLoginFailed):
+		return AppErrOIDCLoginFailed
+	case errors.Is(err, ErrInvalidOIDCState):
+		return AppErrInvalidOIDCState
+	case errors.Is(err, ErrOIDCEmailMissing):
+		return AppErrOIDCEmailMissing
+	case errors.Is(err, ErrExternalIdentityInUse):
+		return AppErrExternalIdentityInUse
+	case errors.Is(err, ErrExternalIdentityAlreadyLinked):
+		return AppErrExternalIdentityAlreadyLinked
+	case errors.Is(err, ErrExternalIdentityNotFound):
+		return AppErrExternalIdentityNotFound
+	case errors.Is(err, ErrLastLoginMethod):
+		return AppErrLastLoginMethod
 
 	// User errors
 	case errors.Is(err, ErrUserNotFound):
@@ -83,6 +244,14 @@ func MapToAppError(err error) *AppError {
 		return AppErrEmailExists
 	case errors.Is(err, ErrCannotDeleteSelf):
 		return AppErrCannotDeleteSelf
+	case errors.Is(err, ErrCannotDeactivateSelf):
+		return AppErrCannotDeactivateSelf
+	case errors.Is(err, ErrInvalidEmailToken):
+		return AppErrInvalidEmailToken
+	case errors.Is(err, ErrEmailAlreadyVerified):
+		return AppErrEmailAlreadyVerified
+	case errors.Is(err, ErrEmailUnchanged):
+		return AppErrEmailUnchanged
 
 	// Invitation errors
 	case errors.Is(err, ErrInvalidInvitationCode):
@@ -93,12 +262,22 @@ func MapToAppError(err error) *AppError {
 		return AppErrInvitationNotFound
 	case errors.Is(err, ErrCannotDeleteRedeemedCode):
 		return AppErrInvitationCannotDeleteRedeemed
+	case errors.Is(err, ErrInvitationCodeRequired):
+		return AppErrInvitationRequired
+	case errors.Is(err, ErrInvitationCodeExpired):
+		return AppErrInvitationExpired
+	case errors.Is(err, ErrInvitationQuotaExceeded):
+		return AppErrInvitationQuotaExceeded
 
 	// Spot errors
 	case errors.Is(err, ErrSpotNotFound):
 		return AppErrSpotNotFound
 	case errors.Is(err, ErrSpotForbidden):
 		return AppErrSpotForbidden
+	case errors.Is(err, ErrSpotDuplicate):
+		return AppErrSpotDuplicate
+	case errors.Is(err, ErrSpotMergeSelf):
+		return AppErrSpotMergeSelf
 
 	// Photo errors
 	case errors.Is(err, ErrPhotoNotFound):
@@ -124,6 +303,48 @@ func MapToAppError(err error) *AppError {
 	case errors.Is(err, ErrFavoriteAlreadyExists):
 		return AppErrFavoriteAlreadyExists
 
+	// Review errors
+	case errors.Is(err, ErrReviewNotFound):
+		return AppErrReviewNotFound
+	case errors.Is(err, ErrReviewAlreadyExists):
+		return AppErrReviewAlreadyExists
+
+	// Amenity errors
+	case errors.Is(err, ErrAmenityNotFound):
+		return AppErrAmenityNotFound
+	case errors.Is(err, ErrAmenityKeyExists):
+		return AppErrAmenityKeyExists
+	case errors.Is(err, ErrAmenityInvalidKey):
+		return AppErrAmenityInvalidKey
+	case errors.Is(err, ErrAmenityUnknown):
+		return AppErrAmenityUnknown
+	case errors.Is(err, ErrAmenityProtected):
+		return AppErrAmenityProtected
+
+	// Follow errors
+	case errors.Is(err, ErrCannotFollowSelf):
+		return AppErrCannotFollowSelf
+	case errors.Is(err, ErrFollowAlreadyExists):
+		return AppErrFollowAlreadyExists
+	case errors.Is(err, ErrFollowNotFound):
+		return AppErrFollowNotFound
+	case errors.Is(err, ErrFollowRequestNotFound):
+		return AppErrFollowRequestNotFound
+	case errors.Is(err, ErrFollowBlocked):
+		return AppErrFollowBlocked
+
+	// Notification errors
+	case errors.Is(err, ErrNotificationNotFound):
+		return AppErrNotificationNotFound
+
+	// Validation errors
+	case errors.Is(err, ErrInvalidCursor):
+		return AppErrValidationInvalidCursor
+	case errors.Is(err, ErrInvalidBoundingBox):
+		return AppErrValidationInvalidBBox
+	case errors.Is(err, ErrInvalidImportFile):
+		return AppErrValidationInvalidImport
+
 	default:
 		return nil
 	}
@@ -134,10 +355,22 @@ func MapToAppError(err error) *AppError {
 func RespondWithMappedError(c interface{ JSON(int, any) }, err error) {
 	appErr := MapToAppError(err)
 	if appErr != nil {
-		c.JSON(appErr.HTTPStatus, ErrorResponse{
+		response := ErrorResponse{
 			ErrorCode: appErr.Code,
 			Message:   appErr.Message,
-		})
+		}
+
+		var dataErr *DataError
+		if errors.As(err, &dataErr) {
+			response.Data = dataErr.Data
+			if retry, ok := dataErr.Data.(RetryAfter); ok {
+				if h, ok := c.(interface{ Header(string, string) }); ok {
+					h.Header("Retry-After", strconv.Itoa(retry.RetryAfterSeconds))
+				}
+			}
+		}
+
+		c.JSON(appErr.HTTPStatus, response)
 		return
 	}
 
@@ -77,3 +77,17 @@ func (r *RedisClient) Increment(ctx context.Context, key string, ttl time.Durati
 
 	return count, nil
 }
+
+func (r *RedisClient) Delete(ctx context.Context, key string) error {
+	return r.client.Del(ctx, key).Err()
+}
+
+// SetNX stores the value only if the key does not exist yet
+func (r *RedisClient) SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	return r.client.SetNX(ctx, key, data, ttl).Result()
+}
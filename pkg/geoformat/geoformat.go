@@ -0,0 +1,173 @@
+// Package geoformat reads and writes point features as GeoJSON, GPX and KML.
+package geoformat
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported formats
+const (
+	FormatGeoJSON = "geojson"
+	FormatGPX     = "gpx"
+	FormatKML     = "kml"
+)
+
+// MaxFileSize is the largest document Decode accepts
+const MaxFileSize = 10 << 20
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported geo format")
+	ErrFileTooLarge      = errors.New("geo file too large")
+)
+
+// Feature is a single named point with additional properties
+type Feature struct {
+	Name         string
+	Description  string
+	Latitude     float64
+	Longitude    float64
+	GeometryType string                 // only "Point" is supported for import
+	Properties   map[string]interface{} // rating, amenity flags, ...
+}
+
+// Encoder writes features one by one, so large exports don't need to be buffered
+type Encoder interface {
+	Begin() error
+	Encode(feature Feature) error
+	End() error
+}
+
+// NewEncoder returns the encoder for the given format
+func NewEncoder(format string, w io.Writer) (Encoder, error) {
+	switch format {
+	case FormatGeoJSON:
+		return &geoJSONEncoder{w: w}, nil
+	case FormatGPX:
+		return &gpxEncoder{w: w}, nil
+	case FormatKML:
+		return &kmlEncoder{w: w}, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the MIME type of a format
+func ContentType(format string) string {
+	switch format {
+	case FormatGPX:
+		return "application/gpx+xml"
+	case FormatKML:
+		return "application/vnd.google-earth.kml+xml"
+	default:
+		return "application/geo+json"
+	}
+}
+
+// Decode reads GeoJSON or GPX, the format is detected from the content
+func Decode(data []byte) ([]Feature, string, error) {
+	if len(data) > MaxFileSize {
+		return nil, "", ErrFileTooLarge
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	switch trimmed[0] {
+	case '{':
+		features, err := decodeGeoJSON(trimmed)
+		return features, FormatGeoJSON, err
+	case '<':
+		features, err := decodeGPX(trimmed)
+		return features, FormatGPX, err
+	default:
+		return nil, "", ErrUnsupportedFormat
+	}
+}
+
+// Float returns a numeric property, also if it was stored as text (GPX)
+func (f Feature) Float(key string) (float64, bool) {
+	switch v := f.Properties[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		return parsed, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// Bool returns a boolean property, also if it was stored as text (GPX)
+func (f Feature) Bool(key string) (bool, bool) {
+	switch v := f.Properties[key].(type) {
+	case bool:
+		return v, true
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		return parsed, err == nil
+	default:
+		return false, false
+	}
+}
+
+// Strings returns a list property - a JSON array (GeoJSON) or comma-separated text (GPX/KML)
+func (f Feature) Strings(key string) ([]string, bool) {
+	switch v := f.Properties[key].(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return []string{}, true
+		}
+		values := strings.Split(v, ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// sortedKeys keeps the XML output stable
+func sortedKeys(properties map[string]interface{}) []string {
+	keys := make([]string, 0, len(properties))
+	for key, value := range properties {
+		if value != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(value)
+	}
+}
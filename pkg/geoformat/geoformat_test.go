@@ -0,0 +1,352 @@
+package geoformat
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testFeatures = []Feature{
+	{
+		Name:        "Seeblick",
+		Description: "Am Ufer <Nord> & Süd",
+		Latitude:    47.3769,
+		Longitude:   8.5417,
+		Properties: map[string]interface{}{
+			"id":             uint(1),
+			"average_rating": 3.6,
+			"has_toilet":     true,
+			"amenities":      []string{"shade", "toilet"},
+			"skipped":        nil,
+		},
+	},
+	{Name: "Waldrand", Latitude: -33.8688, Longitude: 151.2093, Properties: map[string]interface{}{}},
+}
+
+// encode writes all features with the encoder of the given format
+func encode(t *testing.T, format string, features []Feature) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	encoder, err := NewEncoder(format, &buf)
+	assert.NoError(t, err)
+
+	assert.NoError(t, encoder.Begin())
+	for _, feature := range features {
+		assert.NoError(t, encoder.Encode(feature))
+	}
+	assert.NoError(t, encoder.End())
+
+	return buf.Bytes()
+}
+
+func TestNewEncoder_UnsupportedFormat(t *testing.T) {
+	// Act
+	encoder, err := NewEncoder("csv", &bytes.Buffer{})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	assert.Nil(t, encoder)
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []string{FormatGeoJSON, FormatGPX} {
+		t.Run(format, func(t *testing.T) {
+			// Arrange
+			data := encode(t, format, testFeatures)
+
+			// Act
+			features, detected, err := Decode(data)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, format, detected)
+			assert.Len(t, features, len(testFeatures))
+
+			for i, want := range testFeatures {
+				got := features[i]
+				assert.Equal(t, "Point", got.GeometryType)
+				assert.Equal(t, want.Name, got.Name)
+				assert.Equal(t, want.Description, got.Description)
+				assert.Equal(t, want.Latitude, got.Latitude)
+				assert.Equal(t, want.Longitude, got.Longitude)
+				assert.NotContains(t, got.Properties, "skipped")
+			}
+
+			// GPX keeps every property as text, the helpers read both
+			rating, ok := features[0].Float("average_rating")
+			assert.True(t, ok)
+			assert.Equal(t, 3.6, rating)
+
+			id, ok := features[0].Float("id")
+			assert.True(t, ok)
+			assert.Equal(t, 1.0, id)
+
+			hasToilet, ok := features[0].Bool("has_toilet")
+			assert.True(t, ok)
+			assert.True(t, hasToilet)
+
+			amenities, ok := features[0].Strings("amenities")
+			assert.True(t, ok)
+			assert.Equal(t, []string{"shade", "toilet"}, amenities)
+		})
+	}
+}
+
+func TestRoundTrip_KML(t *testing.T) {
+	// Arrange
+	data := encode(t, FormatKML, testFeatures)
+
+	// KML is export only, read it back with the placemark type
+	var doc struct {
+		Placemarks []kmlPlacemark `xml:"Document>Placemark"`
+	}
+
+	// Act
+	err := xml.Unmarshal(data, &doc)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, doc.Placemarks, 2)
+
+	seeblick := doc.Placemarks[0]
+	assert.Equal(t, "Seeblick", seeblick.Name)
+	assert.Equal(t, "Am Ufer <Nord> & Süd", seeblick.Description)
+	assert.Equal(t, "8.5417,47.3769", seeblick.Coordinates) // lon,lat
+	assert.Equal(t, []kmlData{
+		{Name: "amenities", Value: "shade,toilet"},
+		{Name: "average_rating", Value: "3.6"},
+		{Name: "has_toilet", Value: "true"},
+		{Name: "id", Value: "1"},
+	}, seeblick.ExtendedData.Data)
+
+	waldrand := doc.Placemarks[1]
+	assert.Equal(t, "151.2093,-33.8688", waldrand.Coordinates)
+	assert.Nil(t, waldrand.ExtendedData)
+}
+
+func TestRoundTrip_Empty(t *testing.T) {
+	for _, format := range []string{FormatGeoJSON, FormatGPX} {
+		t.Run(format, func(t *testing.T) {
+			// Act
+			features, detected, err := Decode(encode(t, format, nil))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, format, detected)
+			assert.Empty(t, features)
+		})
+	}
+}
+
+func TestDecode_MalformedFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       []byte
+		wantErr    error
+		wantErrMsg string
+	}{
+		{name: "empty", data: []byte("  \n"), wantErr: ErrUnsupportedFormat},
+		{name: "CSV", data: []byte("name;lat;lon\nSeeblick;47.37;8.54"), wantErr: ErrUnsupportedFormat},
+		{name: "truncated GeoJSON", data: []byte(`{"type":"FeatureCollection","features":[`), wantErrMsg: "invalid GeoJSON"},
+		{name: "GeoJSON geometry only", data: []byte(`{"type":"Point","coordinates":[8.54,47.37]}`), wantErrMsg: `unsupported type "Point"`},
+		{name: "GeoJSON without type", data: []byte(`{"features":[]}`), wantErrMsg: "unsupported type"},
+		{name: "truncated GPX", data: []byte(`<gpx><wpt lat="47.37" lon="8.54">`), wantErrMsg: "invalid GPX"},
+		{name: "GPX latitude not a number", data: []byte(`<gpx><wpt lat="north" lon="8.54"><name>Seeblick</name></wpt></gpx>`), wantErrMsg: "invalid GPX"},
+		{
+			name:    "oversized",
+			data:    []byte(`{"type":"FeatureCollection","features":[]}` + strings.Repeat(" ", MaxFileSize)),
+			wantErr: ErrFileTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			features, _, err := Decode(tt.data)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			}
+			assert.Nil(t, features)
+		})
+	}
+}
+
+func TestDecode_GeoJSONFeatures(t *testing.T) {
+	tests := []struct {
+		name         string
+		feature      string
+		wantGeometry string
+		wantName     string
+		wantLat      float64
+		wantLon      float64
+	}{
+		{
+			name:         "coordinates are lon,lat",
+			feature:      `{"type":"Feature","geometry":{"type":"Point","coordinates":[8.5417,47.3769]},"properties":{"name":"Seeblick"}}`,
+			wantGeometry: "Point", wantName: "Seeblick", wantLat: 47.3769, wantLon: 8.5417,
+		},
+		{
+			name:         "altitude is ignored",
+			feature:      `{"type":"Feature","geometry":{"type":"Point","coordinates":[8.5417,47.3769,408]},"properties":{"name":"Seeblick"}}`,
+			wantGeometry: "Point", wantName: "Seeblick", wantLat: 47.3769, wantLon: 8.5417,
+		},
+		{
+			name:         "missing name",
+			feature:      `{"type":"Feature","geometry":{"type":"Point","coordinates":[8.5417,47.3769]},"properties":{"rating":4}}`,
+			wantGeometry: "Point", wantLat: 47.3769, wantLon: 8.5417,
+		},
+		{
+			name:         "name is not a string",
+			feature:      `{"type":"Feature","geometry":{"type":"Point","coordinates":[8.5417,47.3769]},"properties":{"name":42}}`,
+			wantGeometry: "Point", wantLat: 47.3769, wantLon: 8.5417,
+		},
+		{
+			name:         "null properties",
+			feature:      `{"type":"Feature","geometry":{"type":"Point","coordinates":[8.5417,47.3769]},"properties":null}`,
+			wantGeometry: "Point", wantLat: 47.3769, wantLon: 8.5417,
+		},
+		{
+			name:     "single coordinate",
+			feature:  `{"type":"Feature","geometry":{"type":"Point","coordinates":[8.5417]},"properties":{"name":"Seeblick"}}`,
+			wantName: "Seeblick",
+		},
+		{
+			name:     "coordinates as text",
+			feature:  `{"type":"Feature","geometry":{"type":"Point","coordinates":["8.5417","47.3769"]},"properties":{"name":"Seeblick"}}`,
+			wantName: "Seeblick",
+		},
+		{
+			name:         "line geometry",
+			feature:      `{"type":"Feature","geometry":{"type":"LineString","coordinates":[[8.54,47.37],[8.55,47.38]]},"properties":{"name":"Weg"}}`,
+			wantGeometry: "LineString", wantName: "Weg",
+		},
+		{
+			name:     "no geometry",
+			feature:  `{"type":"Feature","geometry":null,"properties":{"name":"Seeblick"}}`,
+			wantName: "Seeblick",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Both a single feature and a collection are accepted
+			documents := []string{tt.feature, `{"type":"FeatureCollection","features":[` + tt.feature + `]}`}
+
+			for _, document := range documents {
+				// Act
+				features, format, err := Decode([]byte(document))
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, FormatGeoJSON, format)
+				assert.Len(t, features, 1)
+				assert.Equal(t, tt.wantGeometry, features[0].GeometryType)
+				assert.Equal(t, tt.wantName, features[0].Name)
+				assert.NotNil(t, features[0].Properties)
+				if tt.wantGeometry == "Point" {
+					assert.Equal(t, tt.wantLat, features[0].Latitude)
+					assert.Equal(t, tt.wantLon, features[0].Longitude)
+				}
+			}
+		})
+	}
+}
+
+func TestDecode_GPXWaypoints(t *testing.T) {
+	// Arrange
+	data := []byte(`<?xml version="1.0"?>
+<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1" xmlns:hopspot="urn:hopspot:gpx:1">
+	<wpt lat="47.3769" lon="8.5417"><name>Seeblick</name><extensions><hopspot:rating>4</hopspot:rating></extensions></wpt>
+	<wpt lat="47.3769" lon="8.5417"></wpt>
+	<wpt lat="8.5417" lon="147.3769"><name>Vertauscht</name></wpt>
+</gpx>`)
+
+	// Act
+	features, format, err := Decode(data)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, FormatGPX, format)
+	assert.Len(t, features, 3)
+
+	assert.Equal(t, "Seeblick", features[0].Name)
+	assert.Equal(t, 47.3769, features[0].Latitude)
+	assert.Equal(t, 8.5417, features[0].Longitude)
+	assert.Equal(t, "4", features[0].Properties["rating"])
+
+	// Missing names and out of range coordinates are left to the importer
+	assert.Equal(t, "", features[1].Name)
+	assert.NotNil(t, features[1].Properties)
+	assert.Equal(t, 147.3769, features[2].Longitude)
+}
+
+func TestFeature_PropertyHelpers(t *testing.T) {
+	feature := Feature{Properties: map[string]interface{}{
+		"rating_number": 4.0,
+		"rating_int":    4,
+		"rating_text":   "4.5",
+		"rating_bad":    "four",
+		"flag_bool":     true,
+		"flag_text":     "false",
+		"flag_bad":      "yes please",
+		"list_json":     []interface{}{"shade", "toilet"},
+		"list_mixed":    []interface{}{"shade", 1.0},
+		"list_text":     "shade, toilet",
+		"list_blank":    " ",
+	}}
+
+	floats := map[string]struct {
+		value float64
+		ok    bool
+	}{
+		"rating_number":  {4, true},
+		"rating_int":     {4, true},
+		"rating_text":    {4.5, true},
+		"rating_bad":     {0, false},
+		"rating_missing": {0, false},
+	}
+	for key, want := range floats {
+		value, ok := feature.Float(key)
+		assert.Equal(t, want.ok, ok, key)
+		assert.Equal(t, want.value, value, key)
+	}
+
+	bools := map[string]struct {
+		value bool
+		ok    bool
+	}{
+		"flag_bool":    {true, true},
+		"flag_text":    {false, true},
+		"flag_bad":     {false, false},
+		"flag_missing": {false, false},
+	}
+	for key, want := range bools {
+		value, ok := feature.Bool(key)
+		assert.Equal(t, want.ok, ok, key)
+		assert.Equal(t, want.value, value, key)
+	}
+
+	lists := map[string]struct {
+		value []string
+		ok    bool
+	}{
+		"list_json":    {[]string{"shade", "toilet"}, true},
+		"list_mixed":   {nil, false},
+		"list_text":    {[]string{"shade", "toilet"}, true},
+		"list_blank":   {[]string{}, true},
+		"list_missing": {nil, false},
+	}
+	for key, want := range lists {
+		value, ok := feature.Strings(key)
+		assert.Equal(t, want.ok, ok, key)
+		assert.Equal(t, want.value, value, key)
+	}
+}
@@ -0,0 +1,127 @@
+package geoformat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   *geoJSONGeometry       `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type geoJSONDocument struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+
+	// Single feature documents
+	Geometry   *geoJSONGeometry       `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geoJSONEncoder struct {
+	w     io.Writer
+	count int
+}
+
+func (e *geoJSONEncoder) Begin() error {
+	_, err := io.WriteString(e.w, `{"type":"FeatureCollection","features":[`)
+	return err
+}
+
+func (e *geoJSONEncoder) Encode(feature Feature) error {
+	properties := make(map[string]interface{}, len(feature.Properties)+2)
+	for key, value := range feature.Properties {
+		if value != nil {
+			properties[key] = value
+		}
+	}
+	properties["name"] = feature.Name
+	if feature.Description != "" {
+		properties["description"] = feature.Description
+	}
+
+	coordinates, err := json.Marshal([]float64{feature.Longitude, feature.Latitude})
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(geoJSONFeature{
+		Type:       "Feature",
+		Geometry:   &geoJSONGeometry{Type: "Point", Coordinates: coordinates},
+		Properties: properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	if e.count > 0 {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+	e.count++
+
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *geoJSONEncoder) End() error {
+	_, err := io.WriteString(e.w, "]}")
+	return err
+}
+
+func decodeGeoJSON(data []byte) ([]Feature, error) {
+	var doc geoJSONDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+
+	var raw []geoJSONFeature
+	switch doc.Type {
+	case "FeatureCollection":
+		raw = doc.Features
+	case "Feature":
+		raw = []geoJSONFeature{{Type: doc.Type, Geometry: doc.Geometry, Properties: doc.Properties}}
+	default:
+		return nil, fmt.Errorf("invalid GeoJSON: unsupported type %q", doc.Type)
+	}
+
+	features := make([]Feature, len(raw))
+	for i, f := range raw {
+		feature := Feature{Properties: f.Properties}
+		if feature.Properties == nil {
+			feature.Properties = map[string]interface{}{}
+		}
+
+		if name, ok := feature.Properties["name"].(string); ok {
+			feature.Name = name
+		}
+		if description, ok := feature.Properties["description"].(string); ok {
+			feature.Description = description
+		}
+
+		if f.Geometry != nil {
+			feature.GeometryType = f.Geometry.Type
+
+			var coordinates []float64
+			if f.Geometry.Type == "Point" && json.Unmarshal(f.Geometry.Coordinates, &coordinates) == nil && len(coordinates) >= 2 {
+				feature.Longitude = coordinates[0]
+				feature.Latitude = coordinates[1]
+			} else if f.Geometry.Type == "Point" {
+				feature.GeometryType = "" // unreadable coordinates
+			}
+		}
+
+		features[i] = feature
+	}
+
+	return features, nil
+}
@@ -0,0 +1,103 @@
+package geoformat
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Namespace for the additional properties in <extensions>
+const gpxExtensionNamespace = "urn:hopspot:gpx:1"
+
+type gpxDocument struct {
+	Waypoints []gpxWaypoint `xml:"wpt"`
+}
+
+type gpxWaypoint struct {
+	XMLName    xml.Name       `xml:"wpt"`
+	Lat        float64        `xml:"lat,attr"`
+	Lon        float64        `xml:"lon,attr"`
+	Name       string         `xml:"name,omitempty"`
+	Desc       string         `xml:"desc,omitempty"`
+	Extensions *gpxExtensions `xml:"extensions,omitempty"`
+}
+
+type gpxExtensions struct {
+	Items []gpxExtension `xml:",any"`
+}
+
+type gpxExtension struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type gpxEncoder struct {
+	w io.Writer
+}
+
+func (e *gpxEncoder) Begin() error {
+	_, err := io.WriteString(e.w, xml.Header+
+		`<gpx version="1.1" creator="HopSpot" xmlns="http://www.topografix.com/GPX/1/1" xmlns:hopspot="`+gpxExtensionNamespace+`">`)
+	return err
+}
+
+func (e *gpxEncoder) Encode(feature Feature) error {
+	waypoint := gpxWaypoint{
+		Lat:  feature.Latitude,
+		Lon:  feature.Longitude,
+		Name: feature.Name,
+		Desc: feature.Description,
+	}
+
+	if keys := sortedKeys(feature.Properties); len(keys) > 0 {
+		waypoint.Extensions = &gpxExtensions{}
+		for _, key := range keys {
+			waypoint.Extensions.Items = append(waypoint.Extensions.Items, gpxExtension{
+				XMLName: xml.Name{Local: "hopspot:" + key},
+				Value:   formatValue(feature.Properties[key]),
+			})
+		}
+	}
+
+	data, err := xml.Marshal(waypoint)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *gpxEncoder) End() error {
+	_, err := io.WriteString(e.w, "</gpx>")
+	return err
+}
+
+func decodeGPX(data []byte) ([]Feature, error) {
+	var doc gpxDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid GPX: %w", err)
+	}
+
+	features := make([]Feature, len(doc.Waypoints))
+	for i, waypoint := range doc.Waypoints {
+		feature := Feature{
+			Name:         waypoint.Name,
+			Description:  waypoint.Desc,
+			Latitude:     waypoint.Lat,
+			Longitude:    waypoint.Lon,
+			GeometryType: "Point",
+			Properties:   map[string]interface{}{},
+		}
+
+		if waypoint.Extensions != nil {
+			for _, item := range waypoint.Extensions.Items {
+				feature.Properties[item.XMLName.Local] = item.Value
+			}
+		}
+
+		features[i] = feature
+	}
+
+	return features, nil
+}
@@ -0,0 +1,65 @@
+package geoformat
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+type kmlPlacemark struct {
+	XMLName      xml.Name         `xml:"Placemark"`
+	Name         string           `xml:"name"`
+	Description  string           `xml:"description,omitempty"`
+	ExtendedData *kmlExtendedData `xml:"ExtendedData,omitempty"`
+	Coordinates  string           `xml:"Point>coordinates"`
+}
+
+type kmlExtendedData struct {
+	Data []kmlData `xml:"Data"`
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type kmlEncoder struct {
+	w io.Writer
+}
+
+func (e *kmlEncoder) Begin() error {
+	_, err := io.WriteString(e.w, xml.Header+
+		`<kml xmlns="http://www.opengis.net/kml/2.2"><Document><name>HopSpot</name>`)
+	return err
+}
+
+func (e *kmlEncoder) Encode(feature Feature) error {
+	placemark := kmlPlacemark{
+		Name:        feature.Name,
+		Description: feature.Description,
+		Coordinates: fmt.Sprintf("%s,%s", formatValue(feature.Longitude), formatValue(feature.Latitude)),
+	}
+
+	if keys := sortedKeys(feature.Properties); len(keys) > 0 {
+		placemark.ExtendedData = &kmlExtendedData{}
+		for _, key := range keys {
+			placemark.ExtendedData.Data = append(placemark.ExtendedData.Data, kmlData{
+				Name:  key,
+				Value: formatValue(feature.Properties[key]),
+			})
+		}
+	}
+
+	data, err := xml.Marshal(placemark)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *kmlEncoder) End() error {
+	_, err := io.WriteString(e.w, "</Document></kml>")
+	return err
+}
@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"hopSpotAPI/pkg/logger"
+)
+
+// tokenParam matches the token of a mailed link, which must not end up in the logs
+var tokenParam = regexp.MustCompile(`([?&]token=)[^&\s]+`)
+
+// LogSender writes emails to the log instead of sending them (development).
+// Tokens in links are redacted, use the file driver to follow the links.
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	logger.Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Msg("Mail (not sent):\n" + tokenParam.ReplaceAllString(msg.Body, "${1}REDACTED"))
+	return nil
+}
+
+// FileSender stores every email as .eml file in a directory (development)
+type FileSender struct {
+	dir  string
+	from string
+}
+
+func NewFileSender(dir, from string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	return &FileSender{dir: dir, from: from}, nil
+}
+
+func (s *FileSender) Send(ctx context.Context, msg Message) error {
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(msg.To)
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405.000000000"), recipient)
+
+	if err := os.WriteFile(filepath.Join(s.dir, name), buildMessage(s.from, msg), 0o600); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+	return nil
+}
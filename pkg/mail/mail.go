@@ -0,0 +1,38 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hopSpotAPI/internal/config"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers emails
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewSender creates the sender selected by MAIL_DRIVER (smtp, file or log)
+func NewSender(cfg config.Config) (Sender, error) {
+	switch strings.ToLower(cfg.MailDriver) {
+	case "smtp":
+		if cfg.SMTPHost == "" {
+			return nil, fmt.Errorf("SMTP_HOST not set")
+		}
+		return NewSMTPSender(cfg), nil
+	case "file":
+		return NewFileSender(cfg.MailFileDir, cfg.MailFrom)
+	case "log":
+		return NewLogSender(), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.MailDriver)
+	}
+}
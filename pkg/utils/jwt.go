@@ -14,13 +14,22 @@ import (
 type JWTClaims struct {
 	Email string      `json:"email"`
 	Role  domain.Role `json:"role"`
+	// SessionID is the refresh token session the access token was issued for
+	SessionID uint `json:"sid,omitempty"`
+	// TwoFactor is set when the session passed the second factor at login
+	TwoFactor bool `json:"tfa,omitempty"`
+	// TokenVersion must match the version of the user, see TokenVersionService
+	TokenVersion uint `json:"ver"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(user *domain.User, cfg *config.Config) (string, error) {
+func GenerateJWT(user *domain.User, sessionID uint, twoFactor bool, keys *JWTKeys, cfg *config.Config) (string, error) {
 	claims := JWTClaims{
-		Email: user.Email,
-		Role:  user.Role,
+		Email:        user.Email,
+		Role:         user.Role,
+		SessionID:    sessionID,
+		TwoFactor:    twoFactor,
+		TokenVersion: user.TokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    cfg.JWTIssuer,
 			Subject:   strconv.Itoa(int(user.ID)),
@@ -31,10 +40,13 @@ func GenerateJWT(user *domain.User, cfg *config.Config) (string, error) {
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token := jwt.NewWithClaims(keys.active.method, claims)
+	if keys.active.id != "" {
+		token.Header["kid"] = keys.active.id
+	}
 
-	// Sign the token with the secret
-	signedToken, err := token.SignedString([]byte(cfg.JWTSecret))
+	// Sign the token with the active key
+	signedToken, err := token.SignedString(keys.active.signingKey)
 	if err != nil {
 		return "", err
 	}
@@ -42,13 +54,17 @@ func GenerateJWT(user *domain.User, cfg *config.Config) (string, error) {
 	return signedToken, nil
 }
 
-func ValidateJWT(tokenString, secret string) (*JWTClaims, error) {
+func ValidateJWT(tokenString string, keys *JWTKeys) (*JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Check the signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		key, ok := keys.verificationKey(token)
+		if !ok {
+			return nil, apperror.ErrInvalidToken
+		}
+		// Check the signing method, a key is only valid for its own algorithm
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, apperror.ErrInvalidToken
 		}
-		return []byte(secret), nil
+		return key.verifyKey, nil
 	})
 
 	if err != nil {
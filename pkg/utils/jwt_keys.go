@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"hopSpotAPI/internal/config"
+)
+
+// Supported JWT signing algorithms
+const (
+	JWTAlgorithmHS256 = "HS256" // shared secret, fallback mode
+	JWTAlgorithmRS256 = "RS256"
+	JWTAlgorithmEdDSA = "EdDSA"
+)
+
+// minRSAKeyBits is the smallest RSA key accepted for signing or verification
+const minRSAKeyBits = 2048
+
+type jwtKey struct {
+	id         string
+	method     jwt.SigningMethod
+	signingKey any // nil for verification-only keys
+	verifyKey  any
+}
+
+// JWTKeys holds the key new tokens are signed with and all keys tokens are verified with.
+// Asymmetric keys are identified by the kid header, retired keys stay valid for verification.
+type JWTKeys struct {
+	active *jwtKey
+	keys   map[string]*jwtKey // by kid, empty in HS256 mode
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n,omitempty"`   // RSA modulus
+	E         string `json:"e,omitempty"`   // RSA exponent
+	Curve     string `json:"crv,omitempty"` // OKP curve
+	X         string `json:"x,omitempty"`   // OKP public key
+}
+
+// JWKS is the published set of verification keys
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewHMACJWTKeys uses a shared secret for signing and verification.
+func NewHMACJWTKeys(secret string) *JWTKeys {
+	key := &jwtKey{
+		method:     jwt.SigningMethodHS256,
+		signingKey: []byte(secret),
+		verifyKey:  []byte(secret),
+	}
+	return &JWTKeys{active: key}
+}
+
+// LoadJWTKeys sets up the keys for the configured algorithm.
+// Asymmetric keys are read from JWT_KEYS_DIR, every "<kid>.pem" file is one key.
+// The active key needs a private key, all other keys may be public keys only.
+func LoadJWTKeys(cfg *config.Config) (*JWTKeys, error) {
+	switch cfg.JWTAlgorithm {
+	case "", JWTAlgorithmHS256:
+		return NewHMACJWTKeys(cfg.JWTSecret), nil
+	case JWTAlgorithmRS256, JWTAlgorithmEdDSA:
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", cfg.JWTAlgorithm)
+	}
+
+	entries, err := os.ReadDir(cfg.JWTKeysDir)
+	if err != nil {
+		return nil, fmt.Errorf("read JWT keys: %w", err)
+	}
+
+	keys := make(map[string]*jwtKey)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pem" {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(cfg.JWTKeysDir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read JWT key %s: %w", entry.Name(), err)
+		}
+
+		key, err := parseJWTKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse JWT key %s: %w", entry.Name(), err)
+		}
+		key.id = strings.TrimSuffix(entry.Name(), ".pem")
+		keys[key.id] = key
+	}
+
+	active, ok := keys[cfg.JWTActiveKeyID]
+	if !ok {
+		return nil, fmt.Errorf("active JWT key %q not found in %s", cfg.JWTActiveKeyID, cfg.JWTKeysDir)
+	}
+	if active.signingKey == nil {
+		return nil, fmt.Errorf("active JWT key %q has no private key", active.id)
+	}
+	if active.method.Alg() != cfg.JWTAlgorithm {
+		return nil, fmt.Errorf("active JWT key %q is a %s key, expected %s", active.id, active.method.Alg(), cfg.JWTAlgorithm)
+	}
+
+	return &JWTKeys{active: active, keys: keys}, nil
+}
+
+// ActiveKeyID returns the kid new tokens are signed with, empty in HS256 mode.
+func (k *JWTKeys) ActiveKeyID() string {
+	return k.active.id
+}
+
+// JWKS returns the public keys, the shared HS256 secret is never published.
+func (k *JWTKeys) JWKS() JWKS {
+	jwks := JWKS{Keys: make([]JWK, 0, len(k.keys))}
+	for _, key := range k.keys {
+		jwk := JWK{Use: "sig", Algorithm: key.method.Alg(), KeyID: key.id}
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].KeyID < jwks.Keys[j].KeyID
+	})
+	return jwks
+}
+
+// verificationKey selects the key for a token by its kid header
+func (k *JWTKeys) verificationKey(token *jwt.Token) (*jwtKey, bool) {
+	if k.keys == nil {
+		return k.active, true
+	}
+
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, false
+	}
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+// parseJWTKey reads a PEM encoded RSA or Ed25519 private or public key
+func parseJWTKey(data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA key must have at least %d bits", minRSAKeyBits)
+		}
+		return &jwtKey{method: jwt.SigningMethodRS256, signingKey: key, verifyKey: &key.PublicKey}, nil
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA key must have at least %d bits", minRSAKeyBits)
+		}
+		return &jwtKey{method: jwt.SigningMethodRS256, verifyKey: key}, nil
+	case ed25519.PrivateKey:
+		return &jwtKey{method: jwt.SigningMethodEdDSA, signingKey: key, verifyKey: key.Public()}, nil
+	case ed25519.PublicKey:
+		return &jwtKey{method: jwt.SigningMethodEdDSA, verifyKey: key}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
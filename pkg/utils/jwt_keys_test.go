@@ -0,0 +1,352 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"hopSpotAPI/internal/config"
+	"hopSpotAPI/internal/domain"
+)
+
+// The keys are generated once, RSA key generation is slow
+var (
+	testRSAKey    = mustRSAKey(2048)
+	testSmallRSA  = mustRSAKey(1024)
+	_, testEdKey  = mustEd25519Key()
+	testJWTUser   = &domain.User{Model: &gorm.Model{ID: 1}, Email: "test@example.com", Role: domain.RoleUser}
+	testJWTConfig = config.Config{JWTIssuer: "hopspot", JWTAudience: "hopspot-app", JWTExpire: time.Hour}
+)
+
+func mustRSAKey(bits int) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func mustEd25519Key() (ed25519.PublicKey, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return pub, priv
+}
+
+func encodePEM(blockType string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func pkcs8PEM(t *testing.T, key any) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	return encodePEM("PRIVATE KEY", der)
+}
+
+func publicPEM(t *testing.T, key any) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	assert.NoError(t, err)
+	return encodePEM("PUBLIC KEY", der)
+}
+
+// writeKeysDir stores every key as <kid>.pem in a new directory
+func writeKeysDir(t *testing.T, files map[string][]byte) string {
+	dir := t.TempDir()
+	for name, data := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0o600))
+	}
+	return dir
+}
+
+func TestParseJWTKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       []byte
+		wantAlg    string
+		canSign    bool
+		wantErrMsg string
+	}{
+		{name: "RSA PKCS1 private key", data: encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(testRSAKey)), wantAlg: JWTAlgorithmRS256, canSign: true},
+		{name: "RSA PKCS8 private key", data: pkcs8PEM(t, testRSAKey), wantAlg: JWTAlgorithmRS256, canSign: true},
+		{name: "RSA public key", data: publicPEM(t, &testRSAKey.PublicKey), wantAlg: JWTAlgorithmRS256},
+		{name: "Ed25519 private key", data: pkcs8PEM(t, testEdKey), wantAlg: JWTAlgorithmEdDSA, canSign: true},
+		{name: "Ed25519 public key", data: publicPEM(t, testEdKey.Public()), wantAlg: JWTAlgorithmEdDSA},
+		{name: "RSA key below minimum size", data: pkcs8PEM(t, testSmallRSA), wantErrMsg: "at least 2048 bits"},
+		{name: "RSA public key below minimum size", data: publicPEM(t, &testSmallRSA.PublicKey), wantErrMsg: "at least 2048 bits"},
+		{name: "no PEM block", data: []byte("not a key"), wantErrMsg: "no PEM block"},
+		{name: "unsupported block type", data: encodePEM("CERTIFICATE", []byte{1, 2, 3}), wantErrMsg: "unsupported PEM block"},
+		{name: "corrupt key data", data: encodePEM("PRIVATE KEY", []byte{1, 2, 3}), wantErrMsg: "asn1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			key, err := parseJWTKey(tt.data)
+
+			// Assert
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+				assert.Nil(t, key)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAlg, key.method.Alg())
+			assert.Equal(t, tt.canSign, key.signingKey != nil)
+			assert.NotNil(t, key.verifyKey)
+		})
+	}
+}
+
+func TestLoadJWTKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		algorithm  string
+		activeKey  string
+		files      map[string][]byte
+		wantKeyID  string
+		wantErrMsg string
+	}{
+		{name: "HS256 default", algorithm: "", wantKeyID: ""},
+		{name: "HS256", algorithm: JWTAlgorithmHS256, wantKeyID: ""},
+		{name: "unsupported algorithm", algorithm: "ES256", wantErrMsg: "unsupported JWT algorithm"},
+		{
+			name:      "RS256 with retired public key",
+			algorithm: JWTAlgorithmRS256,
+			activeKey: "2025-02",
+			files: map[string][]byte{
+				"2025-02.pem": pkcs8PEM(t, testRSAKey),
+				"2025-01.pem": publicPEM(t, testEdKey.Public()),
+				"README.md":   []byte("ignored"),
+			},
+			wantKeyID: "2025-02",
+		},
+		{
+			name:      "EdDSA",
+			algorithm: JWTAlgorithmEdDSA,
+			activeKey: "ed",
+			files:     map[string][]byte{"ed.pem": pkcs8PEM(t, testEdKey)},
+			wantKeyID: "ed",
+		},
+		{
+			name:       "active key missing",
+			algorithm:  JWTAlgorithmRS256,
+			activeKey:  "missing",
+			files:      map[string][]byte{"other.pem": pkcs8PEM(t, testRSAKey)},
+			wantErrMsg: "not found",
+		},
+		{
+			name:       "active key without private key",
+			algorithm:  JWTAlgorithmRS256,
+			activeKey:  "public",
+			files:      map[string][]byte{"public.pem": publicPEM(t, &testRSAKey.PublicKey)},
+			wantErrMsg: "has no private key",
+		},
+		{
+			name:       "active key of other algorithm",
+			algorithm:  JWTAlgorithmRS256,
+			activeKey:  "ed",
+			files:      map[string][]byte{"ed.pem": pkcs8PEM(t, testEdKey)},
+			wantErrMsg: "expected RS256",
+		},
+		{
+			name:       "invalid key file",
+			algorithm:  JWTAlgorithmEdDSA,
+			activeKey:  "ed",
+			files:      map[string][]byte{"ed.pem": pkcs8PEM(t, testEdKey), "broken.pem": []byte("garbage")},
+			wantErrMsg: "parse JWT key broken.pem",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			cfg := &config.Config{JWTAlgorithm: tt.algorithm, JWTSecret: "test-secret-min-32-characters-long", JWTActiveKeyID: tt.activeKey}
+			if tt.files != nil {
+				cfg.JWTKeysDir = writeKeysDir(t, tt.files)
+			}
+
+			// Act
+			keys, err := LoadJWTKeys(cfg)
+
+			// Assert
+			if tt.wantErrMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+				assert.Nil(t, keys)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKeyID, keys.ActiveKeyID())
+		})
+	}
+}
+
+func TestLoadJWTKeys_MissingDirectory(t *testing.T) {
+	// Arrange
+	cfg := &config.Config{JWTAlgorithm: JWTAlgorithmRS256, JWTKeysDir: filepath.Join(t.TempDir(), "missing"), JWTActiveKeyID: "key"}
+
+	// Act
+	keys, err := LoadJWTKeys(cfg)
+
+	// Assert
+	assert.ErrorContains(t, err, "read JWT keys")
+	assert.Nil(t, keys)
+}
+
+func TestValidateJWT_KeySelection(t *testing.T) {
+	// Arrange - "old" is retired, only its public key is left
+	_, oldKey := mustEd25519Key()
+	keys, err := LoadJWTKeys(&config.Config{
+		JWTAlgorithm:   JWTAlgorithmRS256,
+		JWTActiveKeyID: "new",
+		JWTKeysDir: writeKeysDir(t, map[string][]byte{
+			"new.pem": pkcs8PEM(t, testRSAKey),
+			"old.pem": publicPEM(t, oldKey.Public()),
+		}),
+	})
+	assert.NoError(t, err)
+
+	sign := func(method jwt.SigningMethod, kid string, key any) string {
+		token := jwt.NewWithClaims(method, JWTClaims{
+			Email: "test@example.com",
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "1",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err)
+		return signed
+	}
+
+	_, otherKey := mustEd25519Key()
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "active key", token: sign(jwt.SigningMethodRS256, "new", testRSAKey)},
+		{name: "retired key", token: sign(jwt.SigningMethodEdDSA, "old", oldKey)},
+		{name: "unknown kid", token: sign(jwt.SigningMethodRS256, "unknown", testRSAKey), wantErr: true},
+		{name: "missing kid", token: sign(jwt.SigningMethodRS256, "", testRSAKey), wantErr: true},
+		// The public key must not be accepted as HMAC secret
+		{name: "HS256 against RSA key", token: sign(jwt.SigningMethodHS256, "new", publicPEM(t, &testRSAKey.PublicKey)), wantErr: true},
+		{name: "algorithm of other key", token: sign(jwt.SigningMethodEdDSA, "new", otherKey), wantErr: true},
+		{name: "signed by other key", token: sign(jwt.SigningMethodEdDSA, "old", otherKey), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			claims, err := ValidateJWT(tt.token, keys)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, claims)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "test@example.com", claims.Email)
+		})
+	}
+}
+
+func TestGenerateJWT_NeverSignsWithRetiredKey(t *testing.T) {
+	// Arrange - the retired key still has its private key on disk
+	_, oldKey := mustEd25519Key()
+	keys, err := LoadJWTKeys(&config.Config{
+		JWTAlgorithm:   JWTAlgorithmEdDSA,
+		JWTActiveKeyID: "new",
+		JWTKeysDir: writeKeysDir(t, map[string][]byte{
+			"new.pem": pkcs8PEM(t, testEdKey),
+			"old.pem": pkcs8PEM(t, oldKey),
+		}),
+	})
+	assert.NoError(t, err)
+
+	// Act
+	signed, err := GenerateJWT(testJWTUser, 1, false, keys, &testJWTConfig)
+
+	// Assert
+	assert.NoError(t, err)
+	token, _, err := jwt.NewParser().ParseUnverified(signed, &JWTClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", token.Header["kid"])
+	assert.Equal(t, JWTAlgorithmEdDSA, token.Method.Alg())
+
+	// Only the active key verifies the signature
+	_, err = jwt.ParseWithClaims(signed, &JWTClaims{}, func(*jwt.Token) (any, error) { return oldKey.Public(), nil })
+	assert.Error(t, err)
+	_, err = jwt.ParseWithClaims(signed, &JWTClaims{}, func(*jwt.Token) (any, error) { return testEdKey.Public(), nil })
+	assert.NoError(t, err)
+}
+
+func TestJWKS(t *testing.T) {
+	// Arrange
+	keys, err := LoadJWTKeys(&config.Config{
+		JWTAlgorithm:   JWTAlgorithmRS256,
+		JWTActiveKeyID: "rsa",
+		JWTKeysDir: writeKeysDir(t, map[string][]byte{
+			"rsa.pem": encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(testRSAKey)),
+			"ed.pem":  pkcs8PEM(t, testEdKey),
+		}),
+	})
+	assert.NoError(t, err)
+
+	// Act
+	jwks := keys.JWKS()
+	body, err := json.Marshal(jwks)
+
+	// Assert - sorted by kid
+	assert.NoError(t, err)
+	assert.Len(t, jwks.Keys, 2)
+
+	ed := jwks.Keys[0]
+	assert.Equal(t, "ed", ed.KeyID)
+	assert.Equal(t, "OKP", ed.KeyType)
+	assert.Equal(t, "Ed25519", ed.Curve)
+	assert.Equal(t, JWTAlgorithmEdDSA, ed.Algorithm)
+	assert.Equal(t, "sig", ed.Use)
+	assert.Len(t, ed.X, 43) // 32 bytes base64url without padding
+	assert.Empty(t, ed.N)
+
+	rsaJWK := jwks.Keys[1]
+	assert.Equal(t, "rsa", rsaJWK.KeyID)
+	assert.Equal(t, "RSA", rsaJWK.KeyType)
+	assert.Equal(t, JWTAlgorithmRS256, rsaJWK.Algorithm)
+	assert.Equal(t, "sig", rsaJWK.Use)
+	assert.Equal(t, "AQAB", rsaJWK.E)
+	assert.Len(t, rsaJWK.N, 342) // 2048 bit modulus
+	assert.Empty(t, rsaJWK.X)
+
+	// No private parts are published
+	assert.NotContains(t, string(body), `"d"`)
+	assert.NotContains(t, string(body), `"p"`)
+}
+
+func TestJWKS_HMACPublishesNothing(t *testing.T) {
+	// Arrange
+	keys := NewHMACJWTKeys("test-secret-min-32-characters-long")
+
+	// Act
+	body, err := json.Marshal(keys.JWKS())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"keys":[]}`, string(body))
+}